@@ -16,20 +16,18 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
@@ -47,31 +45,30 @@ const (
 	EitherOrMessage        = "Either IpAddress or CidrBlock is required"
 )
 
-// validateModel to validate inputs to all actions
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-access-list-api-key",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-access-list-api-key")
-}
-
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	if errEvent := validateModel(CreateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	// Create atlas client
-	if currentModel.Profile == nil {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	orgID := *currentModel.OrgId
 	apiKeyID := *currentModel.APIUserId
 
@@ -117,74 +114,48 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validateModel(ReadRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	// Create atlas client
-	if currentModel.Profile == nil {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	orgID := *currentModel.OrgId
 	apiKeyID := *currentModel.APIUserId
-	if currentModel.CidrBlock == nil && currentModel.IpAddress == nil {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          EitherOrMessage,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, nil
-	}
+	// the Entry of the primary identifier is enough, e.g. in the Read of an import
+	if currentModel.Entry == nil {
+		if currentModel.CidrBlock == nil && currentModel.IpAddress == nil {
+			return handler.ProgressEvent{
+				OperationStatus:  handler.Failed,
+				Message:          EitherOrMessage,
+				HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, nil
+		}
 
-	if currentModel.CidrBlock != nil && currentModel.IpAddress != nil {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          MutualExclusiveMessage,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, nil
+		if currentModel.CidrBlock != nil && currentModel.IpAddress != nil {
+			return handler.ProgressEvent{
+				OperationStatus:  handler.Failed,
+				Message:          MutualExclusiveMessage,
+				HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, nil
+		}
+		setEntryInModel(currentModel)
 	}
 
-	entry := getEntryAddress(currentModel)
-	readAccessListAPIKey := client.Atlas20231115014.ProgrammaticAPIKeysApi.GetApiKeyAccessList(context.Background(), orgID, entry, apiKeyID)
-	_, response, err := readAccessListAPIKey.Execute()
+	readAccessListAPIKey := client.Atlas20231115014.ProgrammaticAPIKeysApi.GetApiKeyAccessList(context.Background(), orgID, *currentModel.Entry, apiKeyID)
+	entry, response, err := readAccessListAPIKey.Execute()
 	if err != nil {
 		_, _ = logger.Warnf("Execute error: %s", err.Error())
 		return handleError(response, READ, err)
 	}
+	// Atlas also returns the /32 CIDR block of an IP address
+	currentModel.CidrBlock = nil
+	currentModel.IpAddress = entry.IpAddress
+	if entry.IpAddress == nil {
+		currentModel.CidrBlock = entry.CidrBlock
+	}
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		Message:         "Read Completed",
 		ResourceModel:   currentModel}, nil
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validateModel(DeleteRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	// Create atlas client
-	if currentModel.Profile == nil {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	orgID := *currentModel.OrgId
 	apiKeyID := *currentModel.APIUserId
 	if currentModel.CidrBlock == nil && currentModel.IpAddress == nil {
@@ -239,35 +210,30 @@ func setEntryInModel(currentModel *Model) {
 	currentModel.Entry = &uniqueAddress
 }
 
-// List handles the List event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validateModel(ListRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	// Create atlas client
-	if currentModel.Profile == nil {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// list handles the List event from the Cloudformation service.
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	orgID := *currentModel.OrgId
 	apiKeyID := *currentModel.APIUserId
 
-	accessListResponse, response, err := client.Atlas20231115014.ProgrammaticAPIKeysApi.ListApiKeyAccessListsEntries(context.Background(), orgID, apiKeyID).Execute()
-
-	if err != nil {
-		_, _ = logger.Warnf("Execute error: %s", err.Error())
-		return handleError(response, LIST, err)
+	apiResults, nextToken, pe := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.UserAccessListResponse, int, *http.Response, error) {
+		accessListResponse, response, err := client.Atlas20231115014.ProgrammaticAPIKeysApi.ListApiKeyAccessListsEntriesWithParams(context.Background(), &admin.ListApiKeyAccessListsEntriesApiParams{
+			OrgId:        orgID,
+			ApiUserId:    apiKeyID,
+			IncludeCount: admin.PtrBool(true),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+		}).Execute()
+		if err != nil {
+			_, _ = logger.Warnf("Execute error: %s", err.Error())
+			return nil, 0, response, err
+		}
+		return accessListResponse.GetResults(), accessListResponse.GetTotalCount(), response, nil
+	})
+	if pe != nil {
+		return *pe, nil
 	}
 
 	accessListModels := make([]interface{}, 0)
-	apiResults := accessListResponse.GetResults()
 	for i := range apiResults {
 		l := apiResults[i]
 		label := Model{
@@ -284,24 +250,13 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "List Complete",
 		ResourceModels:  accessListModels,
+		NextToken:       nextToken,
 	}, nil
 }
 
 func handleError(response *http.Response, method string, err error) (handler.ProgressEvent, error) {
-	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
-	_, _ = logger.Warn(errMsg)
-	if response == nil {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          errMsg,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeInternalFailure}, nil
-	}
-
-	if response.StatusCode == http.StatusConflict {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          errMsg,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}, nil
-	}
-	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+	_, _ = logger.Warnf("%s error:%s", method, err.Error())
+	event := progress_events.GetFailedEventByError(err, response)
+	event.Message = fmt.Sprintf("%s error:%s", method, event.Message)
+	return event, nil
 }
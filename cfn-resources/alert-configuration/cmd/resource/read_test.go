@@ -0,0 +1,72 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/alert-configuration/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/alertConfigs/{alertConfigId}", admin.GroupAlertsConfig{
+		Id:            admin.PtrString("650000000000000000000002"),
+		GroupId:       admin.PtrString("650000000000000000000001"),
+		Created:       &created,
+		Updated:       &created,
+		Enabled:       admin.PtrBool(true),
+		EventTypeName: admin.PtrString("OUTSIDE_METRIC_THRESHOLD"),
+		Matchers:      &[]admin.StreamsMatcher{{FieldName: "HOSTNAME_AND_PORT", Operator: "EQUALS", Value: "host:27017"}},
+		MetricThreshold: &admin.ServerlessMetricThreshold{
+			MetricName: "ASSERT_REGULAR",
+			Operator:   admin.PtrString("LESS_THAN"),
+			Threshold:  admin.PtrFloat64(99),
+			Units:      admin.PtrString("RAW"),
+			Mode:       admin.PtrString("AVERAGE"),
+		},
+		Notifications: &[]admin.AlertsNotificationRootForGroup{{
+			TypeName:     admin.PtrString("GROUP"),
+			DelayMin:     admin.PtrInt(0),
+			IntervalMin:  admin.PtrInt(5),
+			EmailEnabled: admin.PtrBool(true),
+			SmsEnabled:   admin.PtrBool(false),
+			Roles:        &[]string{"GROUP_OWNER"},
+		}},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "alert configuration",
+		Schema:         "../../mongodb-atlas-alertconfiguration.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "Id": "650000000000000000000002",
+				"EventTypeName": "OUTSIDE_METRIC_THRESHOLD",
+				"Matchers": [{"FieldName": "HOSTNAME_AND_PORT", "Operator": "EQUALS", "Value": "host:27017"}],
+				"MetricThreshold": {"MetricName": "ASSERT_REGULAR", "Operator": "LESS_THAN", "Threshold": 99, "Units": "RAW",
+					"Mode": "AVERAGE"},
+				"Notifications": [{"TypeName": "GROUP", "DelayMin": 0, "IntervalMin": 5, "EmailEnabled": true,
+					"SmsEnabled": false, "Roles": ["GROUP_OWNER"]}]}`,
+		}},
+	})
+}
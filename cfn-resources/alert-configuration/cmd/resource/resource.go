@@ -21,15 +21,15 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"github.com/spf13/cast"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
@@ -37,30 +37,30 @@ import (
 var CreateRequiredFields = []string{constants.EventTypeName, constants.ProjectID}
 var RequiredFields = []string{constants.ID, constants.ProjectID}
 
-func validateRequest(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-alert-configuration",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: RequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: RequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: RequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, SkipClient: true},
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-alert-configuration")
-}
-
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	validationError := validateRequest(CreateRequiredFields, currentModel)
-	if validationError != nil {
-		return *validationError, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 
 	if currentModel.Id != nil && *currentModel.Id != "" {
@@ -91,7 +91,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	alertConfig, res, err := atlasV2.AlertConfigurationsApi.CreateAlertConfiguration(context.Background(), projectID, &alertConfigRequest).Execute()
 	defer res.Body.Close()
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	}
 
 	currentModel = convertToUIModel(alertConfig, currentModel)
@@ -102,22 +102,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	validationError := validateRequest(RequiredFields, currentModel)
-	if validationError != nil {
-		return *validationError, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 
 	if !isExist(currentModel, atlasV2) {
@@ -131,7 +116,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	alertConfig, resp, err := atlasV2.AlertConfigurationsApi.GetAlertConfiguration(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
 	defer resp.Body.Close()
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevents.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel = convertToUIModel(alertConfig, currentModel)
@@ -142,22 +127,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	validationError := validateRequest(RequiredFields, currentModel)
-	if validationError != nil {
-		return *validationError, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 
 	if !isExist(currentModel, atlasV2) {
@@ -174,7 +144,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	id := *currentModel.Id
 	alertReq, res, err := atlasV2.AlertConfigurationsApi.GetAlertConfiguration(context.Background(), projectID, id).Execute()
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	}
 
 	alertReq = convertToMongoModel(alertReq, currentModel)
@@ -195,7 +165,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	if err != nil {
 		_, _ = logger.Warnf("Update - error: %+v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	}
 	defer res.Body.Close()
 
@@ -206,22 +176,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		ResourceModel:   currentModel}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	validationError := validateRequest(RequiredFields, currentModel)
-	if validationError != nil {
-		return *validationError, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 
 	if !isExist(currentModel, atlasV2) {
@@ -236,7 +191,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	if err != nil {
 		_, _ = logger.Warnf("Delete - error: %+v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	}
 
 	return handler.ProgressEvent{
@@ -244,7 +199,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+func list(req handler.Request, _ *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	return handler.ProgressEvent{
 		OperationStatus:  handler.Failed,
 		Message:          "List operation is not supported",
@@ -375,5 +330,91 @@ func convertToUIModel(alertConfig *admin.GroupAlertsConfig, currentModel *Model)
 		currentModel.Enabled = alertConfig.Enabled
 	}
 
+	currentModel.EventTypeName = alertConfig.EventTypeName
+	currentModel.Matchers = flattenAlertConfigurationMatchers(alertConfig.GetMatchers())
+	currentModel.MetricThreshold = flattenAlertConfigurationMetricThresholdConfig(alertConfig.MetricThreshold)
+	currentModel.Threshold = flattenAlertConfigurationThreshold(alertConfig.Threshold)
+	currentModel.Notifications = flattenAlertConfigurationNotification(alertConfig.GetNotifications())
 	return currentModel
 }
+
+func flattenAlertConfigurationMatchers(matchers []admin.StreamsMatcher) []Matcher {
+	if len(matchers) == 0 {
+		return nil
+	}
+	mts := make([]Matcher, len(matchers))
+	for ind := range matchers {
+		mts[ind] = Matcher{
+			FieldName: util.Pointer(matchers[ind].FieldName),
+			Operator:  util.Pointer(matchers[ind].Operator),
+			Value:     util.Pointer(matchers[ind].Value),
+		}
+	}
+	return mts
+}
+
+func flattenAlertConfigurationMetricThresholdConfig(threshold *admin.ServerlessMetricThreshold) *MetricThresholdView {
+	if threshold == nil {
+		return nil
+	}
+	return &MetricThresholdView{
+		MetricName: util.Pointer(threshold.MetricName),
+		Operator:   threshold.Operator,
+		Threshold:  threshold.Threshold,
+		Units:      threshold.Units,
+		Mode:       threshold.Mode,
+	}
+}
+
+func flattenAlertConfigurationThreshold(threshold *admin.GreaterThanRawThreshold) *IntegerThresholdView {
+	if threshold == nil {
+		return nil
+	}
+	view := &IntegerThresholdView{
+		Operator: threshold.Operator,
+		Units:    threshold.Units,
+	}
+	if threshold.Threshold != nil {
+		view.Threshold = util.Pointer(float64(*threshold.Threshold))
+	}
+	return view
+}
+
+func flattenAlertConfigurationNotification(notifications []admin.AlertsNotificationRootForGroup) []NotificationView {
+	if len(notifications) == 0 {
+		return nil
+	}
+	notificationList := make([]NotificationView, len(notifications))
+	for ind := range notifications {
+		notificationList[ind] = NotificationView{
+			ApiToken:                 notifications[ind].ApiToken,
+			ChannelName:              notifications[ind].ChannelName,
+			DatadogApiKey:            notifications[ind].DatadogApiKey,
+			DatadogRegion:            notifications[ind].DatadogRegion,
+			DelayMin:                 notifications[ind].DelayMin,
+			EmailAddress:             notifications[ind].EmailAddress,
+			EmailEnabled:             notifications[ind].EmailEnabled,
+			MicrosoftTeamsWebhookUrl: notifications[ind].MicrosoftTeamsWebhookUrl,
+			MobileNumber:             notifications[ind].MobileNumber,
+			NotificationToken:        notifications[ind].NotificationToken,
+			OpsGenieApiKey:           notifications[ind].OpsGenieApiKey,
+			OpsGenieRegion:           notifications[ind].OpsGenieRegion,
+			Roles:                    notifications[ind].GetRoles(),
+			RoomName:                 notifications[ind].RoomName,
+			ServiceKey:               notifications[ind].ServiceKey,
+			SmsEnabled:               notifications[ind].SmsEnabled,
+			TeamId:                   notifications[ind].TeamId,
+			TeamName:                 notifications[ind].TeamName,
+			TypeName:                 notifications[ind].TypeName,
+			Username:                 notifications[ind].Username,
+			VictorOpsApiKey:          notifications[ind].VictorOpsApiKey,
+			VictorOpsRoutingKey:      notifications[ind].VictorOpsRoutingKey,
+			WebhookSecret:            notifications[ind].WebhookSecret,
+			WebhookUrl:               notifications[ind].WebhookUrl,
+		}
+		if notifications[ind].IntervalMin != nil {
+			notificationList[ind].IntervalMin = util.Pointer(float64(*notifications[ind].IntervalMin))
+		}
+	}
+	return notificationList
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"slices"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/plan"
+)
+
+// PlanUpdate returns the Atlas API calls made by Update for the change from prevModel to currentModel.
+// Unassigning a project or removing a role revokes an access of the key so it's disruptive.
+func PlanUpdate(prevModel, currentModel *Model) *plan.Plan {
+	p := new(plan.Plan)
+
+	removed := plan.RemovedRoles(prevModel.Roles, currentModel.Roles)
+	p.Add("ProgrammaticAPIKeysApi.UpdateApiKey", len(removed) > 0, "set the description and the organization roles %v%s",
+		currentModel.Roles, plan.Revoking(removed))
+
+	newAssignments, updateAssignments, removeAssignments := getChangesInProjectAssignments(currentModel.ProjectAssignments, prevModel.ProjectAssignments)
+	for _, assignment := range newAssignments {
+		p.Add("ProgrammaticAPIKeysApi.UpdateApiKeyRoles", false, "assign the project %s with the roles %v",
+			util.SafeString(assignment.ProjectId), assignment.Roles)
+	}
+	for _, assignment := range updateAssignments {
+		i := slices.IndexFunc(prevModel.ProjectAssignments, func(a ProjectAssignment) bool {
+			return util.AreStringPtrEqual(a.ProjectId, assignment.ProjectId)
+		})
+		removed := plan.RemovedRoles(prevModel.ProjectAssignments[i].Roles, assignment.Roles)
+		p.Add("ProgrammaticAPIKeysApi.UpdateApiKeyRoles", len(removed) > 0, "set the roles of the project %s to %v%s",
+			util.SafeString(assignment.ProjectId), assignment.Roles, plan.Revoking(removed))
+	}
+	for _, assignment := range removeAssignments {
+		p.Add("ProgrammaticAPIKeysApi.RemoveProjectApiKey", true, "unassign the project %s", util.SafeString(assignment.ProjectId))
+	}
+	return p
+}
+
+// planOnly returns the event of an Update in plan-only mode, reading the API key to know if Atlas already has the current model.
+func planOnly(req handler.Request, client *util.MongoDBClient, prevModel, currentModel *Model) handler.ProgressEvent {
+	atlasModel := *currentModel
+	event, _ := read(req, client, nil, &atlasModel)
+	applied := event.OperationStatus == handler.Success && plan.Applied(event.ResourceModel, prevModel, currentModel)
+	return PlanUpdate(prevModel, currentModel).OnlyEvent(applied, currentModel)
+}
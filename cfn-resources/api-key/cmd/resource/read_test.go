@@ -0,0 +1,53 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/api-key/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/orgs/{orgId}/apiKeys/{apiUserId}", admin.ApiKeyUserDetails{
+		Id:        admin.PtrString("650000000000000000000001"),
+		Desc:      admin.PtrString("key"),
+		PublicKey: admin.PtrString("public"),
+		Roles: &[]admin.CloudAccessRoleAssignment{
+			{OrgId: admin.PtrString(fakeatlas.OrgID), RoleName: admin.PtrString("ORG_MEMBER")},
+			{GroupId: admin.PtrString("650000000000000000000003"), RoleName: admin.PtrString("GROUP_READ_ONLY")},
+			{GroupId: admin.PtrString("650000000000000000000002"), RoleName: admin.PtrString("GROUP_OWNER")},
+		},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "api key",
+		Schema:         "../../mongodb-atlas-apikey.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "OrgId": "5f0000000000000000000001", "APIUserId": "650000000000000000000001",
+				"Description": "key", "Roles": ["ORG_MEMBER"],
+				"ProjectAssignments": [{"ProjectId": "650000000000000000000002", "Roles": ["GROUP_OWNER"]},
+					{"ProjectId": "650000000000000000000003", "Roles": ["GROUP_READ_ONLY"]}]}`,
+		}},
+	})
+}
@@ -16,20 +16,21 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"sort"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/idempotency"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/plan"
 	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/secrets"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
@@ -46,52 +47,80 @@ type APIKeySecret struct {
 	PrivateKey string
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-api-key")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-api-key",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(CreateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	binding := idempotency.New(&req, currentModel)
+	defer binding.Done()
 	apiKeyInput := admin.CreateAtlasOrganizationApiKey{
 		Desc:  util.SafeString(currentModel.Description),
 		Roles: currentModel.Roles,
 	}
-	apiKeyUserDetails, response, err := client.Atlas20231115014.ProgrammaticAPIKeysApi.CreateApiKey(
-		context.Background(),
-		*currentModel.OrgId,
-		&apiKeyInput,
-	).Execute()
+	var apiKeyUserDetails *admin.ApiKeyUserDetails
+	var response *http.Response
+	apiUserID, resumed, err := binding.Create(func(id string) (bool, error) {
+		var err error
+		_, response, err = client.Atlas20231115014.ProgrammaticAPIKeysApi.GetApiKey(context.Background(), *currentModel.OrgId, id).Execute()
+		if progress_events.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if isSavedInSecret(&req, *currentModel.AwsSecretName, id) {
+			return true, nil
+		}
+		// the private key is only returned on creation, a key whose secret wasn't saved is replaced by a new one
+		_, response, err = client.Atlas20231115014.ProgrammaticAPIKeysApi.DeleteApiKey(context.Background(), *currentModel.OrgId, id).Execute()
+		return false, err
+	}, func() (string, error) {
+		var err error
+		apiKeyUserDetails, response, err = client.Atlas20231115014.ProgrammaticAPIKeysApi.CreateApiKey(
+			context.Background(),
+			*currentModel.OrgId,
+			&apiKeyInput,
+		).Execute()
+		return apiKeyUserDetails.GetId(), err
+	})
 
 	if err != nil {
 		return handleError(response, constants.CREATE, err)
 	}
 
-	currentModel.APIUserId = apiKeyUserDetails.Id
+	currentModel.APIUserId = &apiUserID
 
-	// Save PrivateKey in AWS SecretManager
-	secret := APIKeySecret{APIUserID: *currentModel.APIUserId, PublicKey: *apiKeyUserDetails.PublicKey, PrivateKey: *apiKeyUserDetails.PrivateKey}
+	if !resumed {
+		// Save PrivateKey in AWS SecretManager
+		secret := APIKeySecret{APIUserID: *currentModel.APIUserId, PublicKey: *apiKeyUserDetails.PublicKey, PrivateKey: *apiKeyUserDetails.PrivateKey}
 
-	_, _, err = secrets.PutSecret(&req, *currentModel.AwsSecretName, secret, currentModel.Description)
-	if err != nil {
-		// Delete the APIKey from Atlas
-		_, _ = Delete(req, prevModel, currentModel)
-		response = &http.Response{StatusCode: http.StatusInternalServerError}
-		return handleError(response, constants.CREATE, err)
+		_, _, err = secrets.PutSecret(&req, *currentModel.AwsSecretName, secret, currentModel.Description)
+		if err != nil {
+			// Delete the APIKey from Atlas
+			_, _ = deleteResource(req, client, prevModel, currentModel)
+			response = &http.Response{StatusCode: http.StatusInternalServerError}
+			return handleError(response, constants.CREATE, err)
+		}
 	}
 	// Assign Org APIKey to given projects i.e. projectAssignments
 	if len(currentModel.ProjectAssignments) > 0 {
@@ -111,23 +140,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		ResourceModel:   currentModel}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(ReadRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
-	atlas, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func read(req handler.Request, atlas *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	apiKeyUserDetails, arn, response, err := getAPIkeyDetails(&req, atlas, currentModel)
 
 	if err != nil {
@@ -143,22 +156,11 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		ResourceModel:   currentModel}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(UpdateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	if plan.TypePlanOnly(&req) {
+		return planOnly(req, client, prevModel, currentModel), nil
 	}
 
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
 	apiKeyInput := admin.UpdateAtlasOrganizationApiKey{
 		Desc:  currentModel.Description,
 		Roles: &currentModel.Roles,
@@ -190,23 +192,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		ResourceModel:   currentModel}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(DeleteRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	_, response, err := client.Atlas20231115014.ProgrammaticAPIKeysApi.DeleteApiKey(
 		context.Background(),
 		*currentModel.OrgId,
@@ -216,6 +202,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	if err != nil {
 		return handleError(response, constants.DELETE, err)
 	}
+	idempotency.Clear(&req)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -223,45 +210,25 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		ResourceModel:   nil}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(ListRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	itemsPerPage := constants.DefaultListItemsPerPage
+	if currentModel.ListOptions != nil && currentModel.ListOptions.ItemsPerPage != nil {
+		itemsPerPage = *currentModel.ListOptions.ItemsPerPage
 	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	apiKeyList, nextToken, peErr := util.ListPage(&req, itemsPerPage, func(pageNum, itemsPerPage int) ([]admin.ApiKeyUserDetails, int, *http.Response, error) {
+		pagedAPIKeysList, response, err := client.Atlas20231115014.ProgrammaticAPIKeysApi.ListApiKeys(
+			context.Background(),
+			*currentModel.OrgId,
+		).PageNum(pageNum).ItemsPerPage(itemsPerPage).IncludeCount(true).Execute()
+		if err != nil {
+			return nil, 0, response, err
+		}
+		return pagedAPIKeysList.GetResults(), pagedAPIKeysList.GetTotalCount(), response, nil
+	})
 	if peErr != nil {
 		return *peErr, nil
 	}
-	apiKeyRequest := client.Atlas20231115014.ProgrammaticAPIKeysApi.ListApiKeys(
-		context.Background(),
-		*currentModel.OrgId,
-	)
-
-	if currentModel.ListOptions.PageNum != nil {
-		apiKeyRequest = apiKeyRequest.PageNum(*currentModel.ListOptions.PageNum)
-	}
-	// For CFN Test if the no.of keys are more we have to increase the ItemsPerPage value and test
-	// So that it fetches all the keys and passes create_list test case.
-	if currentModel.ListOptions.ItemsPerPage != nil {
-		apiKeyRequest = apiKeyRequest.ItemsPerPage(*currentModel.ListOptions.ItemsPerPage)
-	}
-	if currentModel.ListOptions.IncludeCount != nil {
-		apiKeyRequest = apiKeyRequest.IncludeCount(*currentModel.ListOptions.IncludeCount)
-	}
-	pagedAPIKeysList, response, err := apiKeyRequest.Execute()
-
-	if err != nil {
-		return handleError(response, constants.LIST, err)
-	}
 
-	apiKeyList := pagedAPIKeysList.GetResults()
 	apiKeys := make([]interface{}, len(apiKeyList))
 	for i := range apiKeyList {
 		var model Model
@@ -275,25 +242,15 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		Message:         "List Completed",
-		ResourceModels:  apiKeys}, nil
+		ResourceModels:  apiKeys,
+		NextToken:       nextToken}, nil
 }
 
 func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
-	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
-	_, _ = logger.Warn(errMsg)
-	if response.StatusCode == http.StatusConflict {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          errMsg,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}, nil
-	}
-	if response.StatusCode == http.StatusBadRequest {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          errMsg,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
-	}
-	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+	_, _ = logger.Warnf("%s error:%s", method, err.Error())
+	event := progress_events.GetFailedEventByError(err, response)
+	event.Message = fmt.Sprintf("%s error:%s", method, event.Message)
+	return event, nil
 }
 
 func assignProjects(client *util.MongoDBClient, project ProjectAssignment, apiUserID *string) (handler.ProgressEvent, error) {
@@ -304,6 +261,19 @@ func assignProjects(client *util.MongoDBClient, project ProjectAssignment, apiUs
 	return handler.ProgressEvent{}, err
 }
 
+// isSavedInSecret reports if the secret holds the keys of the API key.
+func isSavedInSecret(req *handler.Request, secretName, apiUserID string) bool {
+	value, _, err := secrets.Get(req, secretName)
+	if err != nil || value == nil {
+		return false
+	}
+	var secret APIKeySecret
+	if err := json.Unmarshal([]byte(*value), &secret); err != nil {
+		return false
+	}
+	return secret.APIUserID == apiUserID && secret.PrivateKey != ""
+}
+
 func getAPIkeyDetails(req *handler.Request, client *util.MongoDBClient, currentModel *Model) (*admin.ApiKeyUserDetails, *string, *http.Response, error) {
 	apiKeyRequest := client.Atlas20231115014.ProgrammaticAPIKeysApi.GetApiKey(
 		context.Background(),
@@ -396,7 +366,7 @@ func getChangesInProjectAssignments(
 			if *inputProjectAssignments[i].ProjectId == *existingProjectAssignments[e].ProjectId {
 				isExistingProject = true
 				// if Roles are not matching, then consider for update ProjectAssignment
-				if !areStringArraysEqualIgnoreOrder(inputProjectAssignments[i].Roles, existingProjectAssignments[i].Roles) {
+				if !areStringArraysEqualIgnoreOrder(inputProjectAssignments[i].Roles, existingProjectAssignments[e].Roles) {
 					updateAssignments = append(updateAssignments, inputProjectAssignments[i])
 				}
 				break
@@ -474,6 +444,10 @@ func (model *Model) readAPIKeyDetails(apikey admin.ApiKeyUserDetails) Model {
 		projectAssignment.ProjectId = &ID
 		projectAssignments = append(projectAssignments, *projectAssignment)
 	}
+	// the map has no order, every Read must return the same model
+	sort.Slice(projectAssignments, func(i, j int) bool {
+		return *projectAssignments[i].ProjectId < *projectAssignments[j].ProjectId
+	})
 	model.Roles = roles
 	model.ProjectAssignments = projectAssignments
 
@@ -1,85 +1,26 @@
-// Code generated by 'cfn generate', changes will be undone by the next invocation. DO NOT EDIT.
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
 package main
 
 import (
-	"errors"
-	"fmt"
-	"log"
-
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
-	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/auditing/cmd/resource"
 )
 
-// Handler is a container for the CRUDL actions exported by resources
-type Handler struct{}
-
-// Create wraps the related Create function exposed by the resource code
-func (r *Handler) Create(req handler.Request) handler.ProgressEvent {
-	return wrap(req, resource.Create)
-}
-
-// Read wraps the related Read function exposed by the resource code
-func (r *Handler) Read(req handler.Request) handler.ProgressEvent {
-	return wrap(req, resource.Read)
-}
-
-// Update wraps the related Update function exposed by the resource code
-func (r *Handler) Update(req handler.Request) handler.ProgressEvent {
-	return wrap(req, resource.Update)
-}
-
-// Delete wraps the related Delete function exposed by the resource code
-func (r *Handler) Delete(req handler.Request) handler.ProgressEvent {
-	return wrap(req, resource.Delete)
-}
-
-// List wraps the related List function exposed by the resource code
-func (r *Handler) List(req handler.Request) handler.ProgressEvent {
-	return wrap(req, resource.List)
-}
-
-// main is the entry point of the application.
+// main is the entry point of the application, cfn generate rewrites this file so the Makefile restores it.
 func main() {
-	cfn.Start(&Handler{})
-}
-
-type handlerFunc func(handler.Request, *resource.Model, *resource.Model) (handler.ProgressEvent, error)
-
-func wrap(req handler.Request, f handlerFunc) (response handler.ProgressEvent) {
-	defer func() {
-		// Catch any panics and return a failed ProgressEvent
-		if r := recover(); r != nil {
-			err, ok := r.(error)
-			if !ok {
-				err = errors.New(fmt.Sprint(r))
-			}
-
-			log.Printf("Trapped error in handler: %v", err)
-
-			response = handler.NewFailedEvent(err)
-		}
-	}()
-
-	// Populate the previous model
-	prevModel := &resource.Model{}
-	if err := req.UnmarshalPrevious(prevModel); err != nil {
-		log.Printf("Error unmarshaling prev model: %v", err)
-		return handler.NewFailedEvent(err)
-	}
-
-	// Populate the current model
-	currentModel := &resource.Model{}
-	if err := req.Unmarshal(currentModel); err != nil {
-		log.Printf("Error unmarshaling model: %v", err)
-		return handler.NewFailedEvent(err)
-	}
-
-	response, err := f(req, prevModel, currentModel)
-	if err != nil {
-		log.Printf("Error returned from handler function: %v", err)
-		return handler.NewFailedEvent(err)
-	}
-
-	return response
+	cfn.Start(resource.Handler())
 }
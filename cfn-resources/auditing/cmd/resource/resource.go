@@ -16,46 +16,50 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
-	log "github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
 var RequiredFields = []string{constants.ProjectID}
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-auditing")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-auditing",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: RequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: RequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: RequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: RequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	modelValidation := validator.ValidateModel(RequiredFields, currentModel)
-	if modelValidation != nil {
-		_, _ = log.Debugf("CREATE Validation Error")
-		return *modelValidation, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 	var res *http.Response
 
 	atlasAuditing, res, err := atlasV2.AuditingApi.GetAuditingConfiguration(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	if aws.BoolValue(atlasAuditing.Enabled) {
@@ -82,7 +86,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	atlasAuditing, res, err = atlasV2.AuditingApi.UpdateAuditingConfiguration(context.Background(), *currentModel.ProjectId, &auditingInput).Execute()
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	currentModel.ConfigurationType = atlasAuditing.ConfigurationType
@@ -93,27 +97,14 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	modelValidation := validator.ValidateModel(RequiredFields, currentModel)
-	if modelValidation != nil {
-		_, _ = log.Debugf("READ Validation Error")
-		return *modelValidation, nil
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 	var res *http.Response
 
 	atlasAuditing, res, err := atlasV2.AuditingApi.GetAuditingConfiguration(context.Background(), *currentModel.ProjectId).Execute()
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	if !aws.BoolValue(atlasAuditing.Enabled) {
@@ -134,20 +125,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	modelValidation := validator.ValidateModel(RequiredFields, currentModel)
-	if modelValidation != nil {
-		_, _ = log.Debugf("UPDATE Validation Error")
-		return *modelValidation, nil
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 
 	resourceEnabled, handlerEvent := isEnabled(*atlasV2, *currentModel)
@@ -187,7 +165,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	atlasAuditing, res, err := atlasV2.AuditingApi.UpdateAuditingConfiguration(context.Background(), *currentModel.ProjectId, &auditingInput).Execute()
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	currentModel.ConfigurationType = atlasAuditing.ConfigurationType
@@ -199,20 +177,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	modelValidation := validator.ValidateModel(RequiredFields, currentModel)
-	if modelValidation != nil {
-		_, _ = log.Debugf("DELETE Validation Error")
-		return *modelValidation, nil
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 
 	resourceEnabled, handlerEvent := isEnabled(*atlasV2, *currentModel)
@@ -238,7 +203,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	_, res, err := atlasV2.AuditingApi.UpdateAuditingConfiguration(context.Background(), *currentModel.ProjectId, &auditingInput).Execute()
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	return handler.ProgressEvent{
@@ -250,13 +215,9 @@ func isEnabled(client admin.APIClient, currentModel Model) (bool, *handler.Progr
 	atlasAuditing, res, err := client.AuditingApi.GetAuditingConfiguration(context.Background(), *currentModel.ProjectId).Execute()
 
 	if err != nil {
-		er := progressevent.GetFailedEventByResponse(err.Error(), res)
+		er := progressevent.GetFailedEventByError(err, res)
 		return false, &er
 	}
 
 	return aws.BoolValue(atlasAuditing.Enabled), nil
 }
-
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
@@ -0,0 +1,78 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/cloud-backup-restore-jobs/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	finished := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/clusters/{clusterName}/backup/restoreJobs/{restoreJobId}", admin.DiskBackupSnapshotRestoreJob{
+		Id:                    admin.PtrString("650000000000000000000002"),
+		DeliveryType:          "pointInTime",
+		SnapshotId:            admin.PtrString("650000000000000000000003"),
+		TargetClusterName:     admin.PtrString("target"),
+		TargetGroupId:         admin.PtrString("650000000000000000000001"),
+		PointInTimeUTCSeconds: admin.PtrInt(1704164645),
+		FinishedAt:            &finished,
+		Cancelled:             admin.PtrBool(false),
+		Failed:                admin.PtrBool(false),
+		Expired:               admin.PtrBool(false),
+	})
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/serverless/{clusterName}/backup/restoreJobs/{restoreJobId}", admin.ServerlessBackupRestoreJob{
+		Id:                admin.PtrString("650000000000000000000004"),
+		DeliveryType:      "automated",
+		SnapshotId:        admin.PtrString("650000000000000000000005"),
+		TargetClusterName: "target",
+		TargetGroupId:     "650000000000000000000001",
+		OplogTs:           admin.PtrInt(1704164645),
+		OplogInc:          admin.PtrInt(2),
+		Cancelled:         admin.PtrBool(false),
+		Failed:            admin.PtrBool(false),
+		Expired:           admin.PtrBool(false),
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "cloud backup restore jobs",
+		Schema:         "../../mongodb-atlas-cloudbackuprestorejobs.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{
+			{
+				Operation: testutil.OperationRead,
+				Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "InstanceType": "cluster",
+					"InstanceName": "cluster", "Id": "650000000000000000000002", "DeliveryType": "pointInTime",
+					"SnapshotId": "650000000000000000000003", "TargetClusterName": "target",
+					"TargetProjectId": "650000000000000000000001", "PointInTimeUtcSeconds": 1704164645}`,
+			},
+			{
+				Operation: testutil.OperationRead,
+				Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "InstanceType": "serverless",
+					"InstanceName": "serverless", "Id": "650000000000000000000004", "DeliveryType": "automated",
+					"SnapshotId": "650000000000000000000005", "TargetClusterName": "target",
+					"TargetProjectId": "650000000000000000000001", "OpLogTs": "1704164645", "OpLogInc": "2"}`,
+			},
+		},
+	})
+}
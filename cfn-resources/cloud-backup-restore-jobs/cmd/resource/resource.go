@@ -16,17 +16,18 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
@@ -42,15 +43,29 @@ const (
 	serverlessInstanceType        = "serverless"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-backup-restore-job")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-cloud-backup-restore-jobs",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadDeleteRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: ReadDeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
 }
 
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	if pe := validator.ValidateModel(fields, model); pe != nil {
-		return pe
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
+func validateInstanceType(model *Model) *handler.ProgressEvent {
 	if *model.InstanceType != clusterInstanceType && *model.InstanceType != serverlessInstanceType {
 		pe := progressevent.GetFailedEventByCode(fmt.Sprintf("InstanceType must be %s or %s", clusterInstanceType, serverlessInstanceType),
 			cloudformation.HandlerErrorCodeInvalidRequest)
@@ -60,15 +75,8 @@ func validateModel(fields []string, model *Model) *handler.ProgressEvent {
 	return nil
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validateModel(CreateRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	if pe := validateInstanceType(currentModel); pe != nil {
 		return *pe, nil
 	}
 
@@ -98,14 +106,14 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		params := paramsServerless(currentModel)
 		serverless, resp, err := client.Atlas20231115014.CloudBackupsApi.CreateServerlessBackupRestoreJob(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName, params).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+			return progressevent.GetFailedEventByError(err, resp), nil
 		}
 		currentModel.Id = serverless.Id
 	} else {
 		params := paramsServer(currentModel)
 		server, resp, err := client.Atlas20231115014.CloudBackupsApi.CreateBackupRestoreJob(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName, params).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+			return progressevent.GetFailedEventByError(err, resp), nil
 		}
 		currentModel.Id = server.Id
 	}
@@ -130,15 +138,8 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validateModel(ReadDeleteRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	if pe := validateInstanceType(currentModel); pe != nil {
 		return *pe, nil
 	}
 
@@ -160,19 +161,8 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validateModel(ReadDeleteRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	if pe := validateInstanceType(currentModel); pe != nil {
 		return *pe, nil
 	}
 
@@ -205,7 +195,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	_, resp, err := client.Atlas20231115014.CloudBackupsApi.CancelBackupRestoreJob(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName, *currentModel.Id).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -214,26 +204,33 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validateModel(ListRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	if pe := validateInstanceType(currentModel); pe != nil {
 		return *pe, nil
 	}
 
 	models := make([]interface{}, 0)
+	var nextToken string
+	var pe *handler.ProgressEvent
 	if *currentModel.InstanceType == serverlessInstanceType {
-		serverless, resp, err := client.Atlas20231115014.CloudBackupsApi.ListServerlessBackupRestoreJobs(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName).Execute()
-		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		var results []admin.ServerlessBackupRestoreJob
+		results, nextToken, pe = util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.ServerlessBackupRestoreJob, int, *http.Response, error) {
+			serverless, resp, err := client.Atlas20231115014.CloudBackupsApi.ListServerlessBackupRestoreJobsWithParams(context.Background(), &admin.ListServerlessBackupRestoreJobsApiParams{
+				GroupId:      *currentModel.ProjectId,
+				ClusterName:  *currentModel.InstanceName,
+				IncludeCount: admin.PtrBool(true),
+				ItemsPerPage: admin.PtrInt(itemsPerPage),
+				PageNum:      admin.PtrInt(pageNum),
+			}).Execute()
+			if err != nil {
+				return nil, 0, resp, err
+			}
+			return serverless.GetResults(), serverless.GetTotalCount(), resp, nil
+		})
+		if pe != nil {
+			return *pe, nil
 		}
 		instanceType := serverlessInstanceType
-		results := serverless.GetResults()
 		for i := range results {
 			job := &results[i]
 			model := &Model{
@@ -248,12 +245,24 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 			}
 		}
 	} else {
-		server, resp, err := client.Atlas20231115014.CloudBackupsApi.ListBackupRestoreJobs(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName).Execute()
-		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		var results []admin.DiskBackupSnapshotRestoreJob
+		results, nextToken, pe = util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.DiskBackupSnapshotRestoreJob, int, *http.Response, error) {
+			server, resp, err := client.Atlas20231115014.CloudBackupsApi.ListBackupRestoreJobsWithParams(context.Background(), &admin.ListBackupRestoreJobsApiParams{
+				GroupId:      *currentModel.ProjectId,
+				ClusterName:  *currentModel.InstanceName,
+				IncludeCount: admin.PtrBool(true),
+				ItemsPerPage: admin.PtrInt(itemsPerPage),
+				PageNum:      admin.PtrInt(pageNum),
+			}).Execute()
+			if err != nil {
+				return nil, 0, resp, err
+			}
+			return server.GetResults(), server.GetTotalCount(), resp, nil
+		})
+		if pe != nil {
+			return *pe, nil
 		}
 		instanceType := clusterInstanceType
-		results := server.GetResults()
 		for i := range results {
 			job := &results[i]
 			model := &Model{
@@ -273,6 +282,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "List complete",
 		ResourceModels:  models,
+		NextToken:       nextToken,
 	}, nil
 }
 
@@ -355,14 +365,14 @@ func updateModel(client *util.MongoDBClient, model *Model, checkFinish bool) *ha
 	if *model.InstanceType == serverlessInstanceType {
 		serverless, resp, err := client.Atlas20231115014.CloudBackupsApi.GetServerlessBackupRestoreJob(context.Background(), *model.ProjectId, *model.InstanceName, *model.Id).Execute()
 		if err != nil {
-			pe := progressevent.GetFailedEventByResponse(err.Error(), resp)
+			pe := progressevent.GetFailedEventByError(err, resp)
 			return &pe
 		}
 		updateModelServerless(model, serverless)
 	} else {
 		server, resp, err := client.Atlas20231115014.CloudBackupsApi.GetBackupRestoreJob(context.Background(), *model.ProjectId, *model.InstanceName, *model.Id).Execute()
 		if err != nil {
-			pe := progressevent.GetFailedEventByResponse(err.Error(), resp)
+			pe := progressevent.GetFailedEventByError(err, resp)
 			return &pe
 		}
 		updateModelServer(model, server)
@@ -380,9 +390,13 @@ func updateModelServerless(model *Model, job *admin.ServerlessBackupRestoreJob)
 	model.TargetProjectId = &job.TargetGroupId
 	model.Timestamp = util.TimePtrToStringPtr(job.Timestamp)
 	model.Cancelled = job.Cancelled
+	model.Failed = job.Failed
 	model.Expired = job.Expired
 	model.DeliveryUrl = job.GetDeliveryUrl()
 	model.Links = flattenLinks(job.GetLinks())
+	model.OpLogTs = util.IntPtrToStrPtr(job.OplogTs)
+	model.OpLogInc = util.IntPtrToStrPtr(job.OplogInc)
+	model.PointInTimeUtcSeconds = job.PointInTimeUTCSeconds
 }
 
 func updateModelServer(model *Model, job *admin.DiskBackupSnapshotRestoreJob) {
@@ -399,6 +413,9 @@ func updateModelServer(model *Model, job *admin.DiskBackupSnapshotRestoreJob) {
 	model.Expired = job.Expired
 	model.DeliveryUrl = job.GetDeliveryUrl()
 	model.Links = flattenLinks(job.GetLinks())
+	model.OpLogTs = util.IntPtrToStrPtr(job.OplogTs)
+	model.OpLogInc = util.IntPtrToStrPtr(job.OplogInc)
+	model.PointInTimeUtcSeconds = job.PointInTimeUTCSeconds
 }
 
 func flattenLinks(linksResult []admin.Link) []Links {
@@ -0,0 +1,59 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/cloud-backup-schedule/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestContract(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	s.AddCluster(projectID, "cluster")
+	testutil.Test(t, testutil.TestCase{
+		Name:           "cloud backup schedule",
+		Schema:         "../../mongodb-atlas-cloudbackupschedule.json",
+		RequestContext: s.RequestContext(),
+		TestHandler: handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{
+			Create: resource.Create,
+			Read:   resource.Read,
+			Update: resource.Update,
+			Delete: resource.Delete,
+			List:   resource.List,
+		}),
+		Steps: []testutil.TestStep{
+			{
+				Operation: testutil.OperationCreate,
+				Config: fmt.Sprintf(`{"Profile": "default", "ProjectId": %q, "ClusterName": "cluster",
+					"AutoExportEnabled": false, "RestoreWindowDays": 3, "Policies": [{"PolicyItems": [
+						{"FrequencyType": "daily", "FrequencyInterval": 1, "RetentionValue": 14, "RetentionUnit": "days"}]}]}`, projectID),
+			},
+			{
+				Operation: testutil.OperationUpdate,
+				Config: fmt.Sprintf(`{"Profile": "default", "ProjectId": %q, "ClusterName": "cluster",
+					"AutoExportEnabled": false, "RestoreWindowDays": 3, "Policies": [{"PolicyItems": [
+						{"FrequencyType": "daily", "FrequencyInterval": 1, "RetentionValue": 7, "RetentionUnit": "days"},
+						{"FrequencyType": "weekly", "FrequencyInterval": 6, "RetentionValue": 4, "RetentionUnit": "weeks"}]}]}`, projectID),
+			},
+			{Operation: testutil.OperationDelete},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/cloud-backup-schedule/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func policyItem(frequencyType string, frequencyInterval, retentionValue int, retentionUnit string) resource.ApiPolicyItemView {
+	return resource.ApiPolicyItemView{
+		FrequencyType:     aws.String(frequencyType),
+		FrequencyInterval: aws.Int(frequencyInterval),
+		RetentionValue:    aws.Int(retentionValue),
+		RetentionUnit:     aws.String(retentionUnit),
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	clusterID := s.AddCluster(projectID, "cluster")
+	model := &resource.Model{
+		ProjectId:         aws.String(projectID),
+		ClusterName:       aws.String("cluster"),
+		AutoExportEnabled: aws.Bool(false),
+		RestoreWindowDays: aws.Int(3),
+		Policies: []resource.ApiPolicyView{{
+			PolicyItems: []resource.ApiPolicyItemView{policyItem("daily", 1, 14, "days")},
+		}},
+	}
+
+	event, created := fakeatlas.Run(t, s, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, clusterID, aws.StringValue(created.ClusterId))
+
+	key := &resource.Model{ProjectId: aws.String(projectID), ClusterName: aws.String("cluster")}
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, 3, aws.IntValue(read.RestoreWindowDays))
+	require.Len(t, read.Policies, 1)
+	require.Len(t, read.Policies[0].PolicyItems, 1, "the default policy items are replaced")
+	assert.Equal(t, 14, aws.IntValue(read.Policies[0].PolicyItems[0].RetentionValue))
+
+	updated := *model
+	updated.Policies = []resource.ApiPolicyView{{
+		PolicyItems: []resource.ApiPolicyItemView{policyItem("daily", 1, 7, "days"), policyItem("weekly", 6, 4, "weeks")},
+	}}
+	event, _ = fakeatlas.Run(t, s, resource.Update, model, &updated)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, read = fakeatlas.Run(t, s, resource.Read, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.Len(t, read.Policies, 1)
+	assert.Len(t, read.Policies[0].PolicyItems, 2)
+
+	event, _ = fakeatlas.Run(t, s, resource.Delete, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, _ = fakeatlas.Run(t, s, resource.Read, nil, key)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, event.HandlerErrorCode)
+}
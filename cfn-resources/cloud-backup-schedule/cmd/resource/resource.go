@@ -18,47 +18,49 @@ import (
 	"context"
 	"errors"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
 var RequiredFields = []string{constants.ProjectID, constants.ClusterName}
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-cloud-backup-schedule")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-cloud-backup-schedule",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: RequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: RequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: RequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: RequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(RequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	return cloudBackupScheduleCreateOrUpdate(req, prevModel, currentModel)
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(RequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	return cloudBackupScheduleCreateOrUpdate(client, currentModel)
+}
 
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	backupPolicy, resp, err := client.Atlas20231115014.CloudBackupsApi.GetBackupSchedule(context.Background(), *currentModel.ProjectId, *currentModel.ClusterName).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	if pe := validateExist(backupPolicy); pe != nil {
@@ -72,14 +74,8 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(RequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	events, _ := Read(req, prevModel, currentModel)
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	events, _ := read(req, client, prevModel, currentModel)
 	if events.HandlerErrorCode == cloudformation.HandlerErrorCodeNotFound {
 		return handler.ProgressEvent{
 			Message:          "Not Found",
@@ -87,24 +83,13 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
 	}
 
-	return cloudBackupScheduleCreateOrUpdate(req, prevModel, currentModel)
+	return cloudBackupScheduleCreateOrUpdate(client, currentModel)
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(RequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	backupPolicy, resp, err := client.Atlas20231115014.CloudBackupsApi.GetBackupSchedule(context.Background(), *currentModel.ProjectId, *currentModel.ClusterName).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	if pe := validateExist(backupPolicy); pe != nil {
@@ -113,7 +98,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	_, resp, err = client.Atlas20231115014.CloudBackupsApi.DeleteAllBackupSchedules(context.Background(), *currentModel.ProjectId, *currentModel.ClusterName).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -123,11 +108,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-
-func cloudBackupScheduleCreateOrUpdate(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+func cloudBackupScheduleCreateOrUpdate(client *util.MongoDBClient, currentModel *Model) (handler.ProgressEvent, error) {
 	if event, err := validateExportDetails(currentModel); err != nil {
 		return event, nil
 	}
@@ -136,13 +117,9 @@ func cloudBackupScheduleCreateOrUpdate(req handler.Request, prevModel *Model, cu
 		return event, nil
 	}
 
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
 	_, resp, err := client.Atlas20231115014.CloudBackupsApi.DeleteAllBackupSchedules(context.Background(), *currentModel.ProjectId, *currentModel.ClusterName).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	params := currentModel.getParams()
@@ -152,7 +129,7 @@ func cloudBackupScheduleCreateOrUpdate(req handler.Request, prevModel *Model, cu
 	if len(params.GetPolicies()) == 1 && params.GetPolicies()[0].GetId() == "" {
 		backupSchedule, resp, err := client.Atlas20231115014.CloudBackupsApi.GetBackupSchedule(context.Background(), *currentModel.ProjectId, *currentModel.ClusterName).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+			return progressevent.GetFailedEventByError(err, resp), nil
 		} else if len(backupSchedule.GetPolicies()) == 1 {
 			params.GetPolicies()[0].Id = backupSchedule.GetPolicies()[0].Id
 		}
@@ -160,7 +137,7 @@ func cloudBackupScheduleCreateOrUpdate(req handler.Request, prevModel *Model, cu
 
 	backupPolicy, resp, err := client.Atlas20231115014.CloudBackupsApi.UpdateBackupSchedule(context.Background(), *currentModel.ProjectId, *currentModel.ClusterName, params).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
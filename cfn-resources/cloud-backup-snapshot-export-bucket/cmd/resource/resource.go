@@ -16,14 +16,15 @@ package resource
 
 import (
 	"context"
-	"errors"
+	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
@@ -37,21 +38,29 @@ var ReadRequiredFields = []string{constants.ProjectID, constants.ID}
 var DeleteRequiredFields = []string{constants.ProjectID, constants.ID}
 var ListRequiredFields = []string{constants.ProjectID}
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-cloud-backup-snapshot-export-bucket")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-cloud-backup-snapshot-export-bucket",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteBucket, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(CreateRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
+
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 
 	params := &admin.DiskBackupSnapshotAWSExportBucket{
 		BucketName:    currentModel.BucketName,
@@ -60,7 +69,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 	output, resp, err := client.Atlas20231115002.CloudBackupsApi.CreateExportBucket(context.Background(), *currentModel.ProjectId, params).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.Id = output.Id
@@ -72,21 +81,11 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(ReadRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 
 	output, resp, err := client.Atlas20231115002.CloudBackupsApi.GetExportBucket(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.updateModel(output)
@@ -98,25 +97,11 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(DeleteRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+func deleteBucket(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 
 	_, resp, err := client.Atlas20231115002.CloudBackupsApi.DeleteExportBucket(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -125,31 +110,32 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(ListRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+
+	buckets, nextToken, pe := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.DiskBackupSnapshotAWSExportBucket, int, *http.Response, error) {
+		output, resp, err := client.Atlas20231115002.CloudBackupsApi.ListExportBucketsWithParams(context.Background(), &admin.ListExportBucketsApiParams{
+			GroupId:      *currentModel.ProjectId,
+			IncludeCount: admin.PtrBool(true),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+		}).Execute()
+		if err != nil {
+			return nil, 0, resp, err
+		}
+		return output.GetResults(), output.GetTotalCount(), resp, nil
+	})
 	if pe != nil {
 		return *pe, nil
 	}
 
-	output, resp, err := client.Atlas20231115002.CloudBackupsApi.ListExportBuckets(context.Background(), *currentModel.ProjectId).Execute()
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
-	}
-
 	resultList := make([]interface{}, 0)
 
-	for i := range output.Results {
+	for i := range buckets {
 		model := Model{
 			ProjectId: currentModel.ProjectId,
 			Profile:   currentModel.Profile,
 		}
-		model.updateModel(&output.Results[i])
+		model.updateModel(&buckets[i])
 		resultList = append(resultList, model)
 	}
 
@@ -157,6 +143,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "List successful",
 		ResourceModels:  resultList,
+		NextToken:       nextToken,
 	}, nil
 }
 
@@ -31,6 +31,7 @@ type Model struct {
 	StorageSizeBytes *string                                              `json:",omitempty"`
 	TotalCount       *float64                                             `json:",omitempty"`
 	Type             *string                                              `json:",omitempty"`
+	TimeoutOptions   *TimeoutOptions                                      `json:",omitempty"`
 }
 
 // ApiAtlasDiskBackupShardedClusterSnapshotMemberView is autogenerated from the json schema
@@ -57,3 +58,10 @@ type ApiAtlasDiskBackupShardedClusterSnapshotView struct {
 	StorageSizeBytes *string                                              `json:",omitempty"`
 	Type             *string                                              `json:",omitempty"`
 }
+
+// TimeoutOptions is autogenerated from the json schema
+type TimeoutOptions struct {
+	TimeOutInSeconds       *int  `json:",omitempty"`
+	CallbackDelaySeconds   *int  `json:",omitempty"`
+	ReturnSuccessIfTimeOut *bool `json:",omitempty"`
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/cloud-backup-snapshot/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	snapshot := admin.DiskBackupReplicaSet{
+		Id:            admin.PtrString("650000000000000000000002"),
+		Description:   admin.PtrString("snapshot"),
+		CloudProvider: admin.PtrString("AWS"),
+		FrequencyType: admin.PtrString("daily"),
+		SnapshotType:  admin.PtrString("scheduled"),
+		PolicyItems:   []string{"650000000000000000000003"},
+		Status:        admin.PtrString("completed"),
+		Type:          admin.PtrString("replicaSet"),
+	}
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/clusters/{clusterName}/backup/snapshots", admin.PaginatedCloudBackupReplicaSet{
+		Results:    []admin.DiskBackupReplicaSet{snapshot},
+		TotalCount: admin.PtrInt(1),
+	})
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/clusters/{clusterName}/backup/snapshots/{snapshotId}", snapshot)
+	testutil.Test(t, testutil.TestCase{
+		Name:           "cloud backup snapshot",
+		Schema:         "../../mongodb-atlas-cloudbackupsnapshot.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "InstanceType": "cluster",
+				"InstanceName": "cluster", "SnapshotId": "650000000000000000000002", "Description": "snapshot",
+				"FrequencyType": "daily", "SnapshotType": "scheduled", "PolicyItems": ["650000000000000000000003"]}`,
+		}},
+	})
+}
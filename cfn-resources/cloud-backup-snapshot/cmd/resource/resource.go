@@ -18,14 +18,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
@@ -38,26 +40,33 @@ var DeleteRequiredFields = []string{constants.ProjectID, constants.SnapshotID, c
 var ReadRequiredFields = []string{constants.ProjectID, constants.SnapshotID, constants.InstanceName, constants.InstanceType}
 var ListRequiredFields = []string{constants.ProjectID}
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-cloud-backup-snapshot")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-cloud-backup-snapshot",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(CreateRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if _, ok := req.CallbackContext["status"]; ok {
 		sid := req.CallbackContext["snapshot_id"].(string)
 		currentModel.SnapshotId = &sid
-		return validateProgress(client, currentModel, "completed")
+		return validateProgress(&req, client, currentModel, "completed")
 	}
 
 	if *currentModel.InstanceType == clusterInstanceType {
@@ -67,7 +76,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		}
 		snapshot, resp, err := client.Atlas20231115002.CloudBackupsApi.TakeSnapshot(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName, &params).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+			return progressevent.GetFailedEventByError(err, resp), nil
 		}
 
 		currentModel.SnapshotId = snapshot.Id
@@ -86,18 +95,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return handler.ProgressEvent{}, errors.New("not implemented: Create for serverless snapshots, import an existing serverless snapshot instead")
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(ReadRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if pe := validateExist(client, currentModel); pe != nil {
 		return *pe, nil
 	}
@@ -105,13 +103,13 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	if *currentModel.InstanceType == clusterInstanceType {
 		server, resp, err := client.Atlas20231115002.CloudBackupsApi.GetReplicaSetBackup(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName, *currentModel.SnapshotId).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+			return progressevent.GetFailedEventByError(err, resp), nil
 		}
 		currentModel.updateModelServer(server)
 	} else {
 		serverless, resp, err := client.Atlas20231115002.CloudBackupsApi.GetServerlessBackup(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName, *currentModel.SnapshotId).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+			return progressevent.GetFailedEventByError(err, resp), nil
 		}
 		currentModel.updateModelServerless(serverless)
 	}
@@ -123,22 +121,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(DeleteRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if pe := validateExist(client, currentModel); pe != nil {
 		return *pe, nil
 	}
@@ -146,7 +129,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	if *currentModel.InstanceType == clusterInstanceType {
 		_, resp, err := client.Atlas20231115002.CloudBackupsApi.DeleteReplicaSetBackup(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName, *currentModel.SnapshotId).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+			return progressevent.GetFailedEventByError(err, resp), nil
 		}
 	}
 
@@ -156,48 +139,65 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(ListRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	models := make([]interface{}, 0)
+	var nextToken string
+	var pe *handler.ProgressEvent
 
 	if *currentModel.InstanceType == clusterInstanceType {
-		server, resp, err := client.Atlas20231115002.CloudBackupsApi.ListReplicaSetBackups(aws.BackgroundContext(), *currentModel.ProjectId, *currentModel.InstanceName).Execute()
-		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		var snapshots []admin.DiskBackupReplicaSet
+		snapshots, nextToken, pe = util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.DiskBackupReplicaSet, int, *http.Response, error) {
+			server, resp, err := client.Atlas20231115002.CloudBackupsApi.ListReplicaSetBackupsWithParams(aws.BackgroundContext(), &admin.ListReplicaSetBackupsApiParams{
+				GroupId:      *currentModel.ProjectId,
+				ClusterName:  *currentModel.InstanceName,
+				IncludeCount: admin.PtrBool(true),
+				ItemsPerPage: admin.PtrInt(itemsPerPage),
+				PageNum:      admin.PtrInt(pageNum),
+			}).Execute()
+			if err != nil {
+				return nil, 0, resp, err
+			}
+			return server.GetResults(), server.GetTotalCount(), resp, nil
+		})
+		if pe != nil {
+			return *pe, nil
 		}
-		for i := range server.Results {
+		for i := range snapshots {
 			model := Model{
 				ProjectId:    currentModel.ProjectId,
 				Profile:      currentModel.Profile,
 				InstanceName: currentModel.InstanceName,
 				InstanceType: currentModel.InstanceType,
 			}
-			model.updateModelServer(&server.Results[i])
+			model.updateModelServer(&snapshots[i])
 			models = append(models, &model)
 		}
 	} else {
-		serverless, resp, err := client.Atlas20231115002.CloudBackupsApi.ListServerlessBackups(aws.BackgroundContext(), *currentModel.ProjectId, *currentModel.InstanceName).Execute()
-		if err != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		var snapshots []admin.ServerlessBackupSnapshot
+		snapshots, nextToken, pe = util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.ServerlessBackupSnapshot, int, *http.Response, error) {
+			serverless, resp, err := client.Atlas20231115002.CloudBackupsApi.ListServerlessBackupsWithParams(aws.BackgroundContext(), &admin.ListServerlessBackupsApiParams{
+				GroupId:      *currentModel.ProjectId,
+				ClusterName:  *currentModel.InstanceName,
+				IncludeCount: admin.PtrBool(true),
+				ItemsPerPage: admin.PtrInt(itemsPerPage),
+				PageNum:      admin.PtrInt(pageNum),
+			}).Execute()
+			if err != nil {
+				return nil, 0, resp, err
+			}
+			return serverless.GetResults(), serverless.GetTotalCount(), resp, nil
+		})
+		if pe != nil {
+			return *pe, nil
 		}
-		for i := range serverless.Results {
+		for i := range snapshots {
 			model := Model{
 				ProjectId:    currentModel.ProjectId,
 				Profile:      currentModel.Profile,
 				InstanceName: currentModel.InstanceName,
 				InstanceType: currentModel.InstanceType,
 			}
-			model.updateModelServerless(&serverless.Results[i])
+			model.updateModelServerless(&snapshots[i])
 			models = append(models, &model)
 		}
 	}
@@ -206,6 +206,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "List Complete",
 		ResourceModels:  models,
+		NextToken:       nextToken,
 	}, nil
 }
 
@@ -213,7 +214,7 @@ func validateExist(client *util.MongoDBClient, model *Model) *handler.ProgressEv
 	if *model.InstanceType == clusterInstanceType {
 		server, resp, err := client.Atlas20231115002.CloudBackupsApi.ListReplicaSetBackups(aws.BackgroundContext(), *model.ProjectId, *model.InstanceName).Execute()
 		if err != nil {
-			pe := progressevent.GetFailedEventByResponse(err.Error(), resp)
+			pe := progressevent.GetFailedEventByError(err, resp)
 			return &pe
 		}
 		for i := range server.Results {
@@ -224,7 +225,7 @@ func validateExist(client *util.MongoDBClient, model *Model) *handler.ProgressEv
 	} else {
 		serverless, resp, err := client.Atlas20231115002.CloudBackupsApi.ListServerlessBackups(aws.BackgroundContext(), *model.ProjectId, *model.InstanceName).Execute()
 		if err != nil {
-			pe := progressevent.GetFailedEventByResponse(err.Error(), resp)
+			pe := progressevent.GetFailedEventByError(err, resp)
 			return &pe
 		}
 		for i := range serverless.Results {
@@ -240,35 +241,28 @@ func validateExist(client *util.MongoDBClient, model *Model) *handler.ProgressEv
 		HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}
 }
 
-func validateProgress(client *util.MongoDBClient, currentModel *Model, targetState string) (handler.ProgressEvent, error) {
+func validateProgress(req *handler.Request, client *util.MongoDBClient, currentModel *Model, targetState string) (handler.ProgressEvent, error) {
 	snapshotID := *currentModel.SnapshotId
 	projectID := *currentModel.ProjectId
 	clusterName := *currentModel.InstanceName
-	snapshot, _, err := client.Atlas20231115002.CloudBackupsApi.GetReplicaSetBackup(context.Background(), projectID, clusterName, snapshotID).Execute()
+	snapshot, resp, err := client.Atlas20231115002.CloudBackupsApi.GetReplicaSetBackup(context.Background(), projectID, clusterName, snapshotID).Execute()
 	if err != nil {
-		return handler.ProgressEvent{}, err
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 	status := util.SafeString(snapshot.Status)
-
 	if status == targetState {
 		currentModel.updateModelServer(snapshot)
-		p := handler.NewProgressEvent()
-		p.ResourceModel = currentModel
-		p.OperationStatus = handler.Success
-		p.Message = "Complete"
-		return p, nil
 	}
 
-	p := handler.NewProgressEvent()
-	p.ResourceModel = currentModel
-	p.OperationStatus = handler.InProgress
-	p.CallbackDelaySeconds = 35
-	p.Message = "Pending"
-	p.CallbackContext = map[string]interface{}{
-		"status":      status,
-		"snapshot_id": *currentModel.SnapshotId,
+	poller := progressevent.Poller{
+		Resource:        "Snapshot " + snapshotID,
+		TargetStates:    []string{targetState},
+		FailureStates:   []string{"failed"},
+		MinDelaySeconds: 35,
+		MaxDelaySeconds: 4 * 35,
 	}
-	return p, nil
+	timeoutOptions := (*progressevent.TimeoutOptions)(currentModel.TimeoutOptions)
+	return poller.WithOptions(timeoutOptions, progressevent.TypeTimeoutOptions(req)).Poll(req.CallbackContext, progressevent.PollState{Name: status, Model: currentModel}), nil
 }
 
 func (m *Model) updateModelServer(snapShot *admin.DiskBackupReplicaSet) {
@@ -283,6 +277,9 @@ func (m *Model) updateModelServer(snapShot *admin.DiskBackupReplicaSet) {
 	m.MongodVersion = snapShot.MongodVersion
 	m.StorageSizeBytes = util.IntPtrToStrPtr(util.Int64PtrToIntPtr(snapShot.StorageSizeBytes))
 	m.CloudProvider = snapShot.CloudProvider
+	m.FrequencyType = snapShot.FrequencyType
+	m.SnapshotType = snapShot.SnapshotType
+	m.PolicyItems = snapShot.PolicyItems
 }
 
 func (m *Model) updateModelServerless(snapShot *admin.ServerlessBackupSnapshot) {
@@ -293,4 +290,6 @@ func (m *Model) updateModelServerless(snapShot *admin.ServerlessBackupSnapshot)
 	m.MongodVersion = snapShot.MongodVersion
 	m.StorageSizeBytes = util.IntPtrToStrPtr(util.Int64PtrToIntPtr(snapShot.StorageSizeBytes))
 	m.CloudProvider = aws.String(constants.AWS)
+	m.FrequencyType = snapShot.FrequencyType
+	m.SnapshotType = snapShot.SnapshotType
 }
@@ -15,20 +15,18 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"net/http"
+	"time"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"github.com/spf13/cast"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
@@ -43,25 +41,9 @@ const (
 var RequiredFields = []string{constants.ClusterName, constants.ProjectID}
 var SimulationStatus = []string{Simulating, Starting, StartingRequested}
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == constants.EmptyString {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", *pe)
-		return *pe, nil
-	}
-
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if req.CallbackContext != nil {
-		return validateProgress(client, currentModel, Simulating)
+		return validateProgress(client, currentModel, req.CallbackContext, Simulating)
 	}
 
 	clusterName := cast.ToString(currentModel.ClusterName)
@@ -84,7 +66,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	simulationObject, res, err := client.Atlas20231115014.ClusterOutageSimulationApi.StartOutageSimulation(context.Background(), projectID, clusterName, &requestBody).Execute()
 	if err != nil {
 		_, _ = logger.Warnf("create Outage - error: %+v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	}
 	_, _ = logger.Debugf("currentModel - error: %+v", currentModel)
 
@@ -105,26 +87,12 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == constants.EmptyString {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	clusterName := cast.ToString(currentModel.ClusterName)
 	projectID := cast.ToString(currentModel.ProjectId)
 	outageSimulation, resp, err := client.Atlas20231115014.ClusterOutageSimulationApi.GetOutageSimulation(context.Background(), projectID, clusterName).Execute()
 	if err != nil || outageSimulation == nil {
-		return progressevents.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevents.GetFailedEventByError(err, resp), nil
 	}
 	// check if simulation is in active state
 	if !util.Contains(SimulationStatus, *outageSimulation.State) {
@@ -146,22 +114,9 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == constants.EmptyString {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if req.CallbackContext != nil {
-		return validateProgress(client, currentModel, Complete)
+		return validateProgress(client, currentModel, req.CallbackContext, Complete)
 	}
 
 	clusterName := cast.ToString(currentModel.ClusterName)
@@ -178,7 +133,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	simulationObject, res, err := client.Atlas20231115014.ClusterOutageSimulationApi.EndOutageSimulation(context.Background(), projectID, clusterName).Execute()
 	if err != nil {
 		_, _ = logger.Warnf("Delete - error: %+v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	}
 
 	if res.Body != nil {
@@ -198,14 +153,6 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-
 func newOutageFilters(currentModel *Model) *[]admin.AtlasClusterOutageSimulationOutageFilter {
 	outageFilters := make([]admin.AtlasClusterOutageSimulationOutageFilter, 0)
 
@@ -220,59 +167,44 @@ func newOutageFilters(currentModel *Model) *[]admin.AtlasClusterOutageSimulation
 	return &outageFilters
 }
 
-// function to track snapshot creation status
-func validateProgress(client *util.MongoDBClient, currentModel *Model, targetState string) (handler.ProgressEvent, error) {
-	projectID := *currentModel.ProjectId
-	clusterName := *currentModel.ClusterName
-	isReady, state, err := isCompleted(client, projectID, clusterName, targetState)
+// validateProgress tracks the state of the outage simulation until it reaches the target state
+func validateProgress(client *util.MongoDBClient, currentModel *Model, callbackContext map[string]any, targetState string) (handler.ProgressEvent, error) {
+	state, err := getSimulationState(client, *currentModel.ProjectId, *currentModel.ClusterName)
 	if err != nil {
 		_, _ = logger.Debugf("ERROR Cluster outage validateProgress() err:%+v", err)
-		return handler.ProgressEvent{
-			Message:          err.Error(),
-			OperationStatus:  handler.Failed,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeServiceInternalError}, nil
+		return progressevents.GetFailedEventByError(err, nil), nil
 	}
 
-	if !isReady {
-		p := handler.NewProgressEvent()
-		p.ResourceModel = currentModel
-		p.OperationStatus = handler.InProgress
-		p.CallbackDelaySeconds = 65
-		p.Message = constants.Pending
-		p.CallbackContext = map[string]interface{}{
-			"status":       state,
-			"cluster_name": *currentModel.ClusterName,
-			"project_id":   *currentModel.ProjectId,
-		}
-		return p, nil
+	poller := progressevents.Poller{
+		Resource:        "Outage simulation of cluster " + *currentModel.ClusterName,
+		TargetStates:    []string{targetState},
+		Timeout:         time.Hour,
+		MinDelaySeconds: 60,
+		MaxDelaySeconds: 180,
 	}
-
-	p := handler.NewProgressEvent()
-	if targetState != Complete {
-		p.ResourceModel = currentModel
+	pollState := progressevents.PollState{Name: state, Model: currentModel}
+	if targetState == Complete {
+		pollState.Model = nil
 	} else {
-		p.ResourceModel = nil
+		// the simulation ended before reaching the target state
+		poller.FailureStates = []string{Complete}
 	}
-	p.OperationStatus = handler.Success
-	p.Message = constants.Complete
-	return p, nil
+	return poller.Poll(callbackContext, pollState), nil
 }
 
-func isCompleted(client *util.MongoDBClient, projectID, clusterName, targetState string) (isExist bool, status string, err error) {
+func getSimulationState(client *util.MongoDBClient, projectID, clusterName string) (string, error) {
 	outageSimulation, resp, err := client.Atlas20231115014.ClusterOutageSimulationApi.GetOutageSimulation(context.Background(), projectID, clusterName).Execute()
 	if err != nil {
-		if resp.StatusCode == http.StatusNotFound {
-			return true, Complete, nil
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return Complete, nil
 		}
-		return false, constants.EmptyString, err
-	}
-	if *outageSimulation.State != constants.EmptyString {
-		log.Printf("[DEBUG] status for MongoDB cluster outage simulation: %s: %s", clusterName, *outageSimulation.State)
+		return constants.EmptyString, err
 	}
 	if resp.Body != nil {
 		defer resp.Body.Close()
 	}
-	return *outageSimulation.State == targetState, *outageSimulation.State, nil
+	_, _ = logger.Debugf("status for MongoDB cluster outage simulation: %s: %s", clusterName, outageSimulation.GetState())
+	return outageSimulation.GetState(), nil
 }
 
 func isActive(client *util.MongoDBClient, projectID, clusterName, targetState string) (isExist bool, status string, err error) {
@@ -292,13 +224,27 @@ func isActive(client *util.MongoDBClient, projectID, clusterName, targetState st
 	return true, Complete, nil
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-cloud-outage")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-cluster-outage-simulation",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: RequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: RequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: RequiredFields},
 }
 
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
+
 func convertToUIModel(outageSimulation admin.ClusterOutageSimulation, currentModel *Model) *Model {
 	currentModel.SimulationId = outageSimulation.Id
 
@@ -0,0 +1,118 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/cluster/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/test/e2e/utility"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/cassette"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func replicationSpec(nodeCount int, region, zoneName string) resource.AdvancedReplicationSpec {
+	return resource.AdvancedReplicationSpec{
+		NumShards: aws.Int(1),
+		ZoneName:  aws.String(zoneName),
+		AdvancedRegionConfigs: []resource.AdvancedRegionConfig{{
+			RegionName:   aws.String(region),
+			Priority:     aws.Int(7),
+			ProviderName: aws.String("AWS"),
+			ElectableSpecs: &resource.Specs{
+				EbsVolumeType: aws.String("STANDARD"),
+				InstanceSize:  aws.String("M10"),
+				NodeCount:     aws.Int(nodeCount),
+			},
+		}},
+	}
+}
+
+// TestCassette records the scenario of the cluster e2e test: the update adds a zone to a global cluster and must
+// keep the ID of the replication spec of the existing zone.
+func TestCassette(t *testing.T) {
+	// the cassette isn't recorded against Atlas yet, commit it and remove this skip to replay the scenario
+	if !cassette.Recording() {
+		t.Skipf("cassette not recorded, run the test with %s=%s", cassette.ModeEnv, cassette.ModeRecord)
+	}
+	r := cassette.New(t, "cluster")
+	orgID := r.Env("MONGODB_ATLAS_ORG_ID", "5f0000000000000000000001")
+	client := r.AtlasClient()
+	projectID := utility.CreateProject(t, client, orgID, "cfn-cassette-cluster")
+	t.Cleanup(func() {
+		utility.DeleteProject(t, client, projectID)
+	})
+	reqCtx := handler.RequestContext{AccountID: "cassette", Region: "us-east-1"}
+	name := "cfn-cassette-cluster"
+	model := resource.Model{
+		Profile:   aws.String("default"),
+		ProjectId: aws.String(projectID),
+		Name:      aws.String(name),
+		AdvancedSettings: &resource.ProcessArgs{
+			DefaultReadConcern:               aws.String("available"),
+			DefaultWriteConcern:              aws.String("1"),
+			JavascriptEnabled:                aws.Bool(true),
+			MinimumEnabledTLSProtocol:        aws.String("TLS1_2"),
+			NoTableScan:                      aws.Bool(false),
+			OplogSizeMB:                      aws.Int(2000),
+			SampleSizeBIConnector:            aws.Int(110),
+			SampleRefreshIntervalBIConnector: aws.Int(310),
+		},
+		BackupEnabled:    aws.Bool(false),
+		ClusterType:      aws.String("GEOSHARDED"),
+		Paused:           aws.Bool(false),
+		PitEnabled:       aws.Bool(false),
+		BiConnector:      &resource.BiConnector{ReadPreference: aws.String("secondary"), Enabled: aws.Bool(false)},
+		ReplicationSpecs: []resource.AdvancedReplicationSpec{replicationSpec(3, "US_EAST_1", "zone1")},
+		Tags:             []resource.Tag{{Key: aws.String("env"), Value: aws.String("development")}},
+	}
+
+	event, created := testutil.Run(t, reqCtx, r.Recording(), resource.Create, nil, &model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	cluster, _, err := client.ClustersApi.GetCluster(context.Background(), projectID, name).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "IDLE", cluster.GetStateName())
+	specs := cluster.GetReplicationSpecs()
+	require.Len(t, specs, 1)
+	assert.Equal(t, 3, specs[0].GetRegionConfigs()[0].ElectableSpecs.GetNodeCount())
+	zone1ID := specs[0].GetId()
+	assert.NotEmpty(t, zone1ID)
+
+	updated := model
+	updated.ReplicationSpecs = []resource.AdvancedReplicationSpec{replicationSpec(5, "US_EAST_1", "zone1"), replicationSpec(5, "EU_WEST_1", "zone2")}
+	event, _ = testutil.Run(t, reqCtx, r.Recording(), resource.Update, created, &updated)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	cluster, _, err = client.ClustersApi.GetCluster(context.Background(), projectID, name).Execute()
+	require.NoError(t, err)
+	specs = cluster.GetReplicationSpecs()
+	require.Len(t, specs, 2)
+	assert.Equal(t, zone1ID, specs[0].GetId(), "the replication spec of the existing zone must keep its ID")
+	for i, zone := range []string{"zone1", "zone2"} {
+		assert.Equal(t, zone, specs[i].GetZoneName())
+		assert.Equal(t, 5, specs[i].GetRegionConfigs()[0].ElectableSpecs.GetNodeCount())
+	}
+
+	event, _ = testutil.Run(t, reqCtx, r.Recording(), resource.Delete, nil, created)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	_, resp, _ := client.ClustersApi.GetCluster(context.Background(), projectID, name).Execute()
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+// ClustersAPI is the part of the Atlas ClustersApi used by the cluster handlers. The SDK clients and the mocks of
+// testutil/mocksvc/mockadmin20231115014 implement it.
+type ClustersAPI interface {
+	CreateCluster(ctx context.Context, groupID string, advancedClusterDescription *admin.AdvancedClusterDescription) admin.CreateClusterApiRequest
+	GetCluster(ctx context.Context, groupID string, clusterName string) admin.GetClusterApiRequest
+	UpdateCluster(ctx context.Context, groupID string, clusterName string, advancedClusterDescription *admin.AdvancedClusterDescription) admin.UpdateClusterApiRequest
+	DeleteClusterWithParams(ctx context.Context, args *admin.DeleteClusterApiParams) admin.DeleteClusterApiRequest
+	ListClustersWithParams(ctx context.Context, args *admin.ListClustersApiParams) admin.ListClustersApiRequest
+	GetClusterAdvancedConfiguration(ctx context.Context, groupID string, clusterName string) admin.GetClusterAdvancedConfigurationApiRequest
+	UpdateClusterAdvancedConfiguration(ctx context.Context, groupID string, clusterName string,
+		clusterDescriptionProcessArgs *admin.ClusterDescriptionProcessArgs) admin.UpdateClusterAdvancedConfigurationApiRequest
+}
+
+var _ ClustersAPI = admin.ClustersApi(nil)
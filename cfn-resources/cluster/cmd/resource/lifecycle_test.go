@@ -0,0 +1,144 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/cluster/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+func TestLifecycle(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.TransitionReads = 3
+	projectID := s.AddProject("project")
+	model := &resource.Model{
+		ProjectId:   aws.String(projectID),
+		Name:        aws.String("cluster"),
+		ClusterType: aws.String("REPLICASET"),
+		ReplicationSpecs: []resource.AdvancedReplicationSpec{{
+			NumShards: aws.Int(1),
+			AdvancedRegionConfigs: []resource.AdvancedRegionConfig{{
+				ProviderName:   aws.String("AWS"),
+				RegionName:     aws.String("US_EAST_1"),
+				Priority:       aws.Int(7),
+				ElectableSpecs: &resource.Specs{InstanceSize: aws.String("M10"), NodeCount: aws.Int(3)},
+			}},
+		}},
+		AdvancedSettings: &resource.ProcessArgs{JavascriptEnabled: aws.Bool(false)},
+	}
+
+	event, created := fakeatlas.Run(t, s, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, "IDLE", aws.StringValue(created.StateName))
+	assert.Equal(t, "mongodb+srv://cluster.fake.mongodb.net", aws.StringValue(created.ConnectionStrings.StandardSrv))
+
+	key := &resource.Model{ProjectId: aws.String(projectID), Name: aws.String("cluster")}
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.NotEmpty(t, aws.StringValue(read.Id))
+	assert.False(t, aws.BoolValue(read.AdvancedSettings.JavascriptEnabled))
+	require.Len(t, read.ReplicationSpecs, 1)
+	specID := aws.StringValue(read.ReplicationSpecs[0].ID)
+	assert.NotEmpty(t, specID)
+
+	updated := *model
+	updated.ReplicationSpecs = []resource.AdvancedReplicationSpec{model.ReplicationSpecs[0]}
+	updated.ReplicationSpecs[0].AdvancedRegionConfigs = []resource.AdvancedRegionConfig{model.ReplicationSpecs[0].AdvancedRegionConfigs[0]}
+	updated.ReplicationSpecs[0].AdvancedRegionConfigs[0].ElectableSpecs = &resource.Specs{InstanceSize: aws.String("M20"), NodeCount: aws.Int(3)}
+	updated.AdvancedSettings = &resource.ProcessArgs{JavascriptEnabled: aws.Bool(true)}
+	event, _ = fakeatlas.Run(t, s, resource.Update, model, &updated)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, read = fakeatlas.Run(t, s, resource.Read, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.True(t, aws.BoolValue(read.AdvancedSettings.JavascriptEnabled))
+	require.Len(t, read.ReplicationSpecs, 1)
+	assert.Equal(t, specID, aws.StringValue(read.ReplicationSpecs[0].ID), "the update keeps the replication spec")
+	assert.Equal(t, "M20", aws.StringValue(read.ReplicationSpecs[0].AdvancedRegionConfigs[0].ElectableSpecs.InstanceSize))
+
+	event, _ = fakeatlas.Run(t, s, resource.List, nil, &resource.Model{ProjectId: aws.String(projectID)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Len(t, event.ResourceModels, 1)
+
+	event, _ = fakeatlas.Run(t, s, resource.Delete, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, _ = fakeatlas.Run(t, s, resource.Read, nil, key)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, event.HandlerErrorCode)
+}
+
+func TestLifecycleAdoptsExistingCluster(t *testing.T) {
+	ctx := context.Background()
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	client, err := s.AtlasClient()
+	require.NoError(t, err)
+	existing, _, err := client.ClustersApi.CreateCluster(ctx, projectID, &admin.AdvancedClusterDescription{
+		Name:        admin.PtrString("cluster"),
+		ClusterType: admin.PtrString("REPLICASET"),
+		ReplicationSpecs: &[]admin.ReplicationSpec{{
+			NumShards: admin.PtrInt(1),
+			RegionConfigs: &[]admin.CloudRegionConfig{{
+				ProviderName:   admin.PtrString("AWS"),
+				RegionName:     admin.PtrString("US_EAST_1"),
+				Priority:       admin.PtrInt(7),
+				ElectableSpecs: &admin.HardwareSpec{InstanceSize: admin.PtrString("M10"), NodeCount: admin.PtrInt(3)},
+			}},
+		}},
+	}).Execute()
+	require.NoError(t, err)
+	_, _, err = client.ClustersApi.UpdateClusterAdvancedConfiguration(ctx, projectID, "cluster",
+		&admin.ClusterDescriptionProcessArgs{JavascriptEnabled: admin.PtrBool(true)}).Execute()
+	require.NoError(t, err)
+
+	model := &resource.Model{
+		ProjectId:   aws.String(projectID),
+		Name:        aws.String("cluster"),
+		ClusterType: aws.String("REPLICASET"),
+		ReplicationSpecs: []resource.AdvancedReplicationSpec{{
+			NumShards: aws.Int(1),
+			AdvancedRegionConfigs: []resource.AdvancedRegionConfig{{
+				ProviderName:   aws.String("AWS"),
+				RegionName:     aws.String("US_EAST_1"),
+				Priority:       aws.Int(7),
+				ElectableSpecs: &resource.Specs{InstanceSize: aws.String("M20"), NodeCount: aws.Int(3)},
+			}},
+		}},
+		AdvancedSettings: &resource.ProcessArgs{JavascriptEnabled: aws.Bool(false)},
+	}
+	event, created := fakeatlas.RunWithTypeConfig(t, s, `{"AdoptIfExists": true}`, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, progressevent.AdoptedMessage("Cluster", "cluster"), event.Message)
+	assert.Equal(t, "IDLE", aws.StringValue(created.StateName))
+
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{ProjectId: aws.String(projectID), Name: aws.String("cluster")})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, existing.GetId(), aws.StringValue(read.Id), "the existing cluster is kept")
+	require.Len(t, read.ReplicationSpecs, 1)
+	assert.Equal(t, existing.GetReplicationSpecs()[0].GetId(), aws.StringValue(read.ReplicationSpecs[0].ID))
+	assert.Equal(t, "M20", aws.StringValue(read.ReplicationSpecs[0].AdvancedRegionConfigs[0].ElectableSpecs.InstanceSize))
+	assert.False(t, aws.BoolValue(read.AdvancedSettings.JavascriptEnabled))
+}
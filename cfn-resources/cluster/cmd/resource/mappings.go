@@ -23,6 +23,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	"github.com/spf13/cast"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
@@ -94,7 +95,7 @@ func expandReplicationSpecs(replicationSpecs []AdvancedReplicationSpec) []admin.
 		rSpecs = append(rSpecs, rSpec)
 	}
 
-	fmt.Printf("specs: len %d %+v", len(replicationSpecs), rSpecs)
+	_, _ = logger.Debugf("specs: len %d %+v", len(replicationSpecs), rSpecs)
 	return rSpecs
 }
 
@@ -251,7 +252,7 @@ func flattenReplicationSpecs(replicationSpecs []admin.ReplicationSpec) []Advance
 		}
 		rSpecs = append(rSpecs, rSpec)
 	}
-	fmt.Printf("specs: len %d %+v", len(replicationSpecs), rSpecs)
+	_, _ = logger.Debugf("specs: len %d %+v", len(replicationSpecs), rSpecs)
 	return rSpecs
 }
 
@@ -275,6 +276,8 @@ func flattenRegionConfig(regionCfg *admin.CloudRegionConfig) AdvancedRegionConfi
 	advRegConfig := AdvancedRegionConfig{
 		AutoScaling:          flattenAutoScaling(regionCfg.AutoScaling),
 		AnalyticsAutoScaling: flattenAutoScaling(regionCfg.AnalyticsAutoScaling),
+		ProviderName:         regionCfg.ProviderName,
+		BackingProviderName:  regionCfg.BackingProviderName,
 		RegionName:           regionCfg.RegionName,
 		Priority:             regionCfg.Priority,
 	}
@@ -475,57 +478,23 @@ func setClusterData(currentModel *Model, cluster *admin.AdvancedClusterDescripti
 	currentModel.ProjectId = cluster.GroupId
 	currentModel.Name = cluster.Name
 	currentModel.Id = cluster.Id
-
-	if currentModel.BackupEnabled != nil {
-		currentModel.BackupEnabled = cluster.BackupEnabled
-	}
-	if currentModel.BiConnector != nil {
-		currentModel.BiConnector = flattenBiConnectorConfig(cluster.BiConnector)
-	}
-	// Readonly
+	currentModel.BackupEnabled = cluster.BackupEnabled
+	currentModel.BiConnector = flattenBiConnectorConfig(cluster.BiConnector)
 	currentModel.ConnectionStrings = flattenConnectionStrings(cluster.ConnectionStrings)
-	if currentModel.ClusterType != nil {
-		currentModel.ClusterType = cluster.ClusterType
-	}
-	// Readonly
+	currentModel.ClusterType = cluster.ClusterType
 	currentModel.CreatedDate = util.TimePtrToStringPtr(cluster.CreateDate)
-	if currentModel.DiskSizeGB != nil {
-		currentModel.DiskSizeGB = cluster.DiskSizeGB
-	}
-	if currentModel.EncryptionAtRestProvider != nil {
-		currentModel.EncryptionAtRestProvider = cluster.EncryptionAtRestProvider
-	}
-	if currentModel.Labels != nil {
-		currentModel.Labels = flattenLabels(cluster.GetLabels())
-	}
-	if currentModel.MongoDBMajorVersion != nil {
-		currentModel.MongoDBMajorVersion = cluster.MongoDBMajorVersion
-	}
-	// Readonly
+	currentModel.DiskSizeGB = cluster.DiskSizeGB
+	currentModel.EncryptionAtRestProvider = cluster.EncryptionAtRestProvider
+	currentModel.Labels = flattenLabels(cluster.GetLabels())
+	currentModel.MongoDBMajorVersion = cluster.MongoDBMajorVersion
 	currentModel.MongoDBVersion = cluster.MongoDBVersion
-
-	if currentModel.Paused != nil {
-		currentModel.Paused = cluster.Paused
-	}
-	if currentModel.PitEnabled != nil {
-		currentModel.PitEnabled = cluster.PitEnabled
-	}
-	if currentModel.RootCertType != nil {
-		currentModel.RootCertType = cluster.RootCertType
-	}
-	if currentModel.ReplicationSpecs != nil {
-		currentModel.ReplicationSpecs = flattenReplicationSpecs(cluster.GetReplicationSpecs())
-	}
-	// Readonly
-	if currentModel.GlobalClusterSelfManagedSharding == nil {
-		currentModel.GlobalClusterSelfManagedSharding = cluster.GlobalClusterSelfManagedSharding
-	}
-	// Readonly
+	currentModel.Paused = cluster.Paused
+	currentModel.PitEnabled = cluster.PitEnabled
+	currentModel.RootCertType = cluster.RootCertType
+	currentModel.ReplicationSpecs = flattenReplicationSpecs(cluster.GetReplicationSpecs())
+	currentModel.GlobalClusterSelfManagedSharding = cluster.GlobalClusterSelfManagedSharding
 	currentModel.StateName = cluster.StateName
-	if currentModel.VersionReleaseSystem != nil {
-		currentModel.VersionReleaseSystem = cluster.VersionReleaseSystem
-	}
-
+	currentModel.VersionReleaseSystem = cluster.VersionReleaseSystem
 	currentModel.TerminationProtectionEnabled = cluster.TerminationProtectionEnabled
 	currentModel.Tags = flattenTags(cluster.GetTags())
 }
@@ -28,6 +28,7 @@ type Model struct {
 	VersionReleaseSystem             *string                   `json:",omitempty"`
 	TerminationProtectionEnabled     *bool                     `json:",omitempty"`
 	Tags                             []Tag                     `json:",omitempty"`
+	TimeoutOptions                   *TimeoutOptions           `json:",omitempty"`
 }
 
 // ProcessArgs is autogenerated from the json schema
@@ -121,3 +122,10 @@ type Tag struct {
 	Key   *string `json:",omitempty"`
 	Value *string `json:",omitempty"`
 }
+
+// TimeoutOptions is autogenerated from the json schema
+type TimeoutOptions struct {
+	TimeOutInSeconds       *int  `json:",omitempty"`
+	CallbackDelaySeconds   *int  `json:",omitempty"`
+	ReturnSuccessIfTimeOut *bool `json:",omitempty"`
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/plan"
+)
+
+// disruptiveProperties change the topology or the version of the cluster, Atlas applies them with a rolling restart.
+var disruptiveProperties = []string{"ClusterType", "EncryptionAtRestProvider", "MongoDBMajorVersion", "ReplicationSpecs"}
+
+// clusterChanges are the Atlas calls of an Update, computed by diffCluster for both Update and PlanUpdate.
+type clusterChanges struct {
+	// changed are the changed properties of the cluster, which is sent by ClustersApi.UpdateCluster in any case.
+	changed []string
+	// advancedSettings are the changed advanced settings, which are sent when applyAdvancedSettings is set.
+	advancedSettings      []string
+	applyAdvancedSettings bool
+	// pause is the pause state to apply once the cluster is IDLE, nil if it doesn't change.
+	pause *bool
+}
+
+// diffCluster returns the changes from prevModel to currentModel, paused is the pause state of the cluster before the Update.
+func diffCluster(prevModel, currentModel *Model, paused bool) *clusterChanges {
+	c := &clusterChanges{
+		changed: plan.ChangedProperties(prevModel, currentModel, "AdvancedSettings", "Paused", "Profile", "TimeoutOptions",
+			"ConnectionStrings", "CreatedDate", "Id", "MongoDBVersion", "StateName"),
+		applyAdvancedSettings: currentModel.AdvancedSettings != nil,
+	}
+	if c.applyAdvancedSettings {
+		c.advancedSettings = plan.ChangedProperties(prevModel.AdvancedSettings, currentModel.AdvancedSettings)
+	}
+	if currentModel.Paused != nil && *currentModel.Paused != paused {
+		c.pause = currentModel.Paused
+	}
+	return c
+}
+
+// PlanUpdate returns the Atlas API calls made by Update for the change from prevModel to currentModel.
+func PlanUpdate(prevModel, currentModel *Model) *plan.Plan {
+	p := new(plan.Plan)
+	changes := diffCluster(prevModel, currentModel, aws.BoolValue(prevModel.Paused))
+
+	// Update always sends the cluster, the advanced settings and the pause are applied once it's IDLE
+	if len(changes.changed) == 0 {
+		p.Add("ClustersApi.UpdateCluster", false, "apply the template, no property changed")
+	} else {
+		disruptive := slices.ContainsFunc(changes.changed, func(name string) bool { return slices.Contains(disruptiveProperties, name) })
+		description := "update " + strings.Join(changes.changed, ", ")
+		if disruptive {
+			description += ", with a rolling restart of the nodes"
+		}
+		p.Add("ClustersApi.UpdateCluster", disruptive, "%s", description)
+	}
+
+	if changes.applyAdvancedSettings {
+		if len(changes.advancedSettings) == 0 {
+			p.Add("ClustersApi.UpdateClusterAdvancedConfiguration", false, "apply the advanced settings, no setting changed")
+		} else {
+			p.Add("ClustersApi.UpdateClusterAdvancedConfiguration", true, "update the advanced settings %s, which can restart the nodes",
+				strings.Join(changes.advancedSettings, ", "))
+		}
+	}
+
+	if changes.pause != nil {
+		if *changes.pause {
+			p.Add("ClustersApi.UpdateCluster", true, "pause the cluster, it stops serving the clients")
+		} else {
+			p.Add("ClustersApi.UpdateCluster", false, "resume the cluster")
+		}
+	}
+	return p
+}
+
+// planOnly returns the event of an Update in plan-only mode, reading the cluster to know if Atlas already has the current model.
+func planOnly(req handler.Request, client *util.MongoDBClient, prevModel, currentModel *Model) handler.ProgressEvent {
+	atlasModel := *currentModel
+	event, _ := read(req, client, nil, &atlasModel)
+	// Update adds the default label, which is read with the others
+	desired := *currentModel
+	desired.Labels = slices.Clone(currentModel.Labels)
+	desired.validateDefaultLabel()
+	applied := event.OperationStatus == handler.Success && plan.Applied(event.ResourceModel, prevModel, &desired)
+	return PlanUpdate(prevModel, currentModel).OnlyEvent(applied, currentModel)
+}
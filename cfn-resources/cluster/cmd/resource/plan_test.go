@@ -0,0 +1,118 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/cluster/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanUpdate(t *testing.T) {
+	prev := resource.Model{
+		Name:                util.StringPtr("cluster"),
+		MongoDBMajorVersion: util.StringPtr("6.0"),
+		Paused:              util.Pointer(false),
+		Profile:             util.StringPtr("default"),
+	}
+	testCases := map[string]struct {
+		current  resource.Model
+		expected []plan.Call
+	}{
+		"noChange": {
+			current: prev,
+			expected: []plan.Call{
+				{Operation: "ClustersApi.UpdateCluster", Description: "apply the template, no property changed"},
+			},
+		},
+		"profileChangeIsIgnored": {
+			current: resource.Model{Name: prev.Name, MongoDBMajorVersion: prev.MongoDBMajorVersion, Paused: prev.Paused, Profile: util.StringPtr("other")},
+			expected: []plan.Call{
+				{Operation: "ClustersApi.UpdateCluster", Description: "apply the template, no property changed"},
+			},
+		},
+		"versionUpgradeIsDisruptive": {
+			current: resource.Model{Name: prev.Name, MongoDBMajorVersion: util.StringPtr("7.0"), Paused: prev.Paused, Profile: prev.Profile},
+			expected: []plan.Call{
+				{Operation: "ClustersApi.UpdateCluster", Description: "update MongoDBMajorVersion, with a rolling restart of the nodes", Disruptive: true},
+			},
+		},
+		"pauseIsDisruptive": {
+			current: resource.Model{Name: prev.Name, MongoDBMajorVersion: prev.MongoDBMajorVersion, Paused: util.Pointer(true), Profile: prev.Profile},
+			expected: []plan.Call{
+				{Operation: "ClustersApi.UpdateCluster", Description: "apply the template, no property changed"},
+				{Operation: "ClustersApi.UpdateCluster", Description: "pause the cluster, it stops serving the clients", Disruptive: true},
+			},
+		},
+		"advancedSettings": {
+			current: resource.Model{Name: prev.Name, MongoDBMajorVersion: prev.MongoDBMajorVersion, Paused: prev.Paused, Profile: prev.Profile,
+				AdvancedSettings: &resource.ProcessArgs{JavascriptEnabled: util.Pointer(false)}},
+			expected: []plan.Call{
+				{Operation: "ClustersApi.UpdateCluster", Description: "apply the template, no property changed"},
+				{Operation: "ClustersApi.UpdateClusterAdvancedConfiguration", Description: "update the advanced settings JavascriptEnabled, which can restart the nodes", Disruptive: true},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.PlanUpdate(&prev, &tc.current).Calls)
+		})
+	}
+}
+
+func TestPlanOnlyUpdate(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	// the handlers set the read-only properties of their model, each request gets the template properties
+	template := func() *resource.Model {
+		return &resource.Model{
+			ProjectId:   aws.String(projectID),
+			Name:        aws.String("cluster"),
+			ClusterType: aws.String("REPLICASET"),
+			ReplicationSpecs: []resource.AdvancedReplicationSpec{{
+				NumShards: aws.Int(1),
+				AdvancedRegionConfigs: []resource.AdvancedRegionConfig{{
+					ProviderName:   aws.String("AWS"),
+					RegionName:     aws.String("US_EAST_1"),
+					Priority:       aws.Int(7),
+					ElectableSpecs: &resource.Specs{InstanceSize: aws.String("M10"), NodeCount: aws.Int(3)},
+				}},
+			}},
+			AdvancedSettings: &resource.ProcessArgs{JavascriptEnabled: aws.Bool(false)},
+		}
+	}
+	event, _ := fakeatlas.Run(t, s, resource.Create, nil, template())
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	req := handler.NewRequest("", nil, s.RequestContext(), nil, nil, nil, []byte(`{"PlanOnly": true}`))
+	paused := template()
+	paused.Paused = aws.Bool(true)
+	event, err := resource.Update(req, template(), paused)
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Contains(t, event.Message, "pause the cluster")
+
+	// the rollback restores the template Atlas still has
+	event, err = resource.Update(req, paused, template())
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus, event.Message)
+}
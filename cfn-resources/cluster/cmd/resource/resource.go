@@ -21,14 +21,16 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	log "github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/plan"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"github.com/spf13/cast"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
@@ -45,8 +47,27 @@ var UpdateRequiredFields = []string{constants.ProjectID, constants.Name}
 var DeleteRequiredFields = []string{constants.ProjectID, constants.Name}
 var ListRequiredFields = []string{constants.ProjectID}
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-cluster")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-cluster",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
+}
+
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
 func cast64(i *int) *int64 {
@@ -54,29 +75,13 @@ func cast64(i *int) *int64 {
 	return &x
 }
 
-// validateModel inputs based on the method
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
-}
-
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, _ *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validateModel(CreateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	clusters := client.Atlas20231115014.ClustersApi
 
 	// Callback
 	if _, idExists := req.CallbackContext[constants.StateName]; idExists {
-		return clusterCallback(client, currentModel, *currentModel.ProjectId)
+		return clusterCallback(clusters, currentModel, &req, *currentModel.ProjectId)
 	}
 	currentModel.validateDefaultLabel()
 	clusterRequest, errEvent := setClusterRequest(currentModel)
@@ -85,16 +90,12 @@ func Create(req handler.Request, _ *Model, currentModel *Model) (handler.Progres
 	}
 
 	var err error
-	cluster, res, err := client.Atlas20231115014.ClustersApi.CreateCluster(context.Background(), *currentModel.ProjectId, clusterRequest).Execute()
+	cluster, res, err := clusters.CreateCluster(context.Background(), *currentModel.ProjectId, clusterRequest).Execute()
 	if err != nil {
-		if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusBadRequest && strings.Contains(*apiError.ErrorCode, constants.Duplicate) {
-			return handler.ProgressEvent{
-				OperationStatus:  handler.Failed,
-				Message:          err.Error(),
-				HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}, nil
+		if progressevent.IsAlreadyExists(err) && progressevent.TypeAdoptIfExists(&req) {
+			return adoptCluster(clusters, currentModel, clusterRequest)
 		}
-
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	currentModel.StateName = cluster.StateName
@@ -110,31 +111,44 @@ func Create(req handler.Request, _ *Model, currentModel *Model) (handler.Progres
 	}, nil
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	_, _ = log.Debugf("Read() currentModel:%+v", currentModel)
+// adoptCluster updates the existing cluster with the name of the model to match the template, the Create callbacks
+// then wait for the update like for a new cluster.
+func adoptCluster(clusters ClustersAPI, currentModel *Model, clusterRequest *admin.AdvancedClusterDescription) (handler.ProgressEvent, error) {
+	_, _ = log.Debugf("Adopting the existing cluster:%s", *currentModel.Name)
+	currentCluster, res, err := clusters.GetCluster(context.Background(), *currentModel.ProjectId, *currentModel.Name).Execute()
+	if err != nil {
+		return progressevent.GetFailedEventByError(err, res), nil
+	}
+	if len(clusterRequest.GetReplicationSpecs()) > 0 {
+		clusterRequest.ReplicationSpecs = AddReplicationSpecIDs(currentCluster.GetReplicationSpecs(), clusterRequest.GetReplicationSpecs())
+	}
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
+	model, res, err := updateCluster(context.Background(), clusters, currentModel, clusterRequest)
+	if err != nil {
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
+	state := aws.StringValue(model.StateName)
+	return handler.ProgressEvent{
+		OperationStatus:      handler.InProgress,
+		Message:              fmt.Sprintf("Adopt Cluster `%s`", state),
+		ResourceModel:        model,
+		CallbackDelaySeconds: CallBackSeconds,
+		CallbackContext: map[string]interface{}{
+			constants.StateName:      state,
+			progressevent.AdoptedKey: true,
+		},
+	}, nil
+}
+
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	clusters := client.Atlas20231115014.ClustersApi
+
 	// Read call
-	model, resp, err := readCluster(context.Background(), client, currentModel)
+	model, resp, err := readCluster(context.Background(), clusters, currentModel)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return handler.ProgressEvent{
-				Message:          err.Error(),
-				OperationStatus:  handler.Failed,
-				HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
-		}
-
-		return handler.ProgressEvent{
-			Message:          err.Error(),
-			OperationStatus:  handler.Failed,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeServiceInternalError}, nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -143,31 +157,22 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	_, _ = log.Debugf("Update() currentModel:%+v", currentModel)
-
-	modelValidation := validateModel(UpdateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// update handles the Update event from the Cloudformation service.
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	clusters := client.Atlas20231115014.ClustersApi
 
 	// Update callback
 	if _, ok := req.CallbackContext[constants.StateName]; ok {
-		return updateClusterCallback(client, currentModel, *currentModel.ProjectId)
+		return updateClusterCallback(clusters, prevModel, currentModel, &req, *currentModel.ProjectId)
+	}
+	if plan.TypePlanOnly(&req) {
+		return planOnly(req, client, prevModel, currentModel), nil
 	}
 
 	currentModel.validateDefaultLabel()
 	adminCluster, errEvent := setClusterRequest(currentModel)
 	if len(adminCluster.GetReplicationSpecs()) > 0 {
-		currentCluster, _, _ := client.Atlas20231115014.ClustersApi.GetCluster(context.Background(), *currentModel.ProjectId, *currentModel.Name).Execute()
+		currentCluster, _, _ := clusters.GetCluster(context.Background(), *currentModel.ProjectId, *currentModel.Name).Execute()
 		if currentCluster != nil {
 			adminCluster.ReplicationSpecs = AddReplicationSpecIDs(currentCluster.GetReplicationSpecs(), adminCluster.GetReplicationSpecs())
 		}
@@ -177,27 +182,10 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 
 	// Update Cluster
-	model, _, err := updateCluster(context.Background(), client, currentModel, adminCluster)
+	model, res, err := updateCluster(context.Background(), clusters, currentModel, adminCluster)
 	if err != nil {
-		if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusNotFound {
-			return handler.ProgressEvent{
-				Message:          err.Error(),
-				OperationStatus:  handler.Failed,
-				HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
-		}
-
 		_, _ = log.Warnf("update err: %+v", err)
-		code := cloudformation.HandlerErrorCodeServiceInternalError
-		if strings.Contains(err.Error(), "not exist") { // cfn test needs 404
-			code = cloudformation.HandlerErrorCodeNotFound
-		}
-		if strings.Contains(err.Error(), "being deleted") {
-			code = cloudformation.HandlerErrorCodeNotFound // cfn test needs 404
-		}
-		return handler.ProgressEvent{
-			Message:          err.Error(),
-			OperationStatus:  handler.Failed,
-			HandlerErrorCode: code}, nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	var state string
@@ -218,25 +206,13 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return event, nil
 }
 
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	_, _ = log.Debugf("Delete() currentModel:%+v", currentModel)
-
-	modelValidation := validateModel(DeleteRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	clusters := client.Atlas20231115014.ClustersApi
 	ctx := context.Background()
 
 	if _, ok := req.CallbackContext[constants.StateName]; ok {
-		return validateProgress(client, currentModel, constants.DeletedState)
+		return validateProgress(clusters, currentModel, &req, constants.DeletedState)
 	}
 
 	params := &admin.DeleteClusterApiParams{
@@ -245,19 +221,9 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		ClusterName:   *currentModel.Name,
 	}
 
-	_, err := client.Atlas20231115014.ClustersApi.DeleteClusterWithParams(ctx, params).Execute()
+	res, err := clusters.DeleteClusterWithParams(ctx, params).Execute()
 	if err != nil {
-		if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusNotFound {
-			return handler.ProgressEvent{
-				Message:          err.Error(),
-				OperationStatus:  handler.Failed,
-				HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
-		}
-
-		return handler.ProgressEvent{
-			Message:          err.Error(),
-			OperationStatus:  handler.Failed,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeServiceInternalError}, nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	return handler.ProgressEvent{
@@ -270,81 +236,106 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		}}, nil
 }
 
-// List handles the List event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	_, _ = log.Debugf("List() currentModel:%+v", currentModel)
-
-	modelValidation := validateModel(ListRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
+// list handles the List event from the Cloudformation service.
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	clusters := client.Atlas20231115014.ClustersApi
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	clusterResults, nextToken, peErr := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.AdvancedClusterDescription, int, *http.Response, error) {
+		listOptions := &admin.ListClustersApiParams{
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+			GroupId:      *currentModel.ProjectId,
+			IncludeCount: admin.PtrBool(true),
+		}
+		clustersResponse, res, err := clusters.ListClustersWithParams(context.Background(), listOptions).Execute()
+		if err != nil {
+			return nil, 0, res, fmt.Errorf("error listing resource : %w", err)
+		}
+		return clustersResponse.GetResults(), clustersResponse.GetTotalCount(), res, nil
+	})
 	if peErr != nil {
 		return *peErr, nil
 	}
 
-	listOptions := &admin.ListClustersApiParams{
-		ItemsPerPage: admin.PtrInt(100),
-		PageNum:      admin.PtrInt(1),
-		GroupId:      *currentModel.ProjectId,
-		IncludeCount: admin.PtrBool(true),
-	}
-	// List call
-	clustersResponse, res, err := client.Atlas20231115014.ClustersApi.ListClustersWithParams(context.Background(), listOptions).Execute()
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error creating resource : %s", err.Error()),
-			res), nil
-	}
-	models := make([]*Model, *clustersResponse.TotalCount)
-	clusterResults := clustersResponse.GetResults()
+	models := make([]interface{}, 0, len(clusterResults))
 	for i := range clusterResults {
 		model := &Model{}
 		mapClusterToModel(model, &clusterResults[i])
 		// Call AdvancedSettings
-		processArgs, res, err := client.Atlas20231115014.ClustersApi.GetClusterAdvancedConfiguration(context.Background(), *model.ProjectId, *model.Name).Execute()
+		processArgs, res, err := clusters.GetClusterAdvancedConfiguration(context.Background(), *model.ProjectId, *model.Name).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error creating resource : %s", err.Error()),
-				res), nil
+			return progressevent.GetFailedEventByError(err, res), nil
 		}
 		model.AdvancedSettings = flattenProcessArgs(processArgs)
-		models[i] = model
+		model.Profile = currentModel.Profile
+		models = append(models, model)
 	}
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		Message:         "List",
-		ResourceModel:   models}, nil
+		ResourceModels:  models,
+		NextToken:       nextToken}, nil
 }
 
-func clusterCallback(client *util.MongoDBClient, currentModel *Model, projectID string) (handler.ProgressEvent, error) {
-	progressEvent, err := validateProgress(client, currentModel, constants.IdleState)
-	if err != nil {
+func clusterCallback(clusters ClustersAPI, currentModel *Model, req *handler.Request, projectID string) (handler.ProgressEvent, error) {
+	progressEvent, err := validateProgress(clusters, currentModel, req, constants.IdleState)
+	if err != nil || progressEvent.OperationStatus != handler.Success {
 		return progressEvent, nil
 	}
 
-	if progressEvent.Message == constants.Complete {
-		if !currentModel.HasAdvanceSettings() {
-			return handler.ProgressEvent{
-				OperationStatus: handler.Success,
-				Message:         "Create Success",
-				ResourceModel:   currentModel}, nil
+	if !currentModel.HasAdvanceSettings() {
+		message := "Create Success"
+		if !isIdle(currentModel) {
+			message = progressEvent.Message
 		}
+		return handler.ProgressEvent{
+			OperationStatus: handler.Success,
+			Message:         createMessage(req, currentModel, message),
+			ResourceModel:   currentModel}, nil
+	}
 
-		_, _ = log.Debugf("Cluster Creation completed:%s", *currentModel.Name)
+	_, _ = log.Debugf("Cluster Creation completed:%s", *currentModel.Name)
 
-		cluster, res, err := client.Atlas20231115014.ClustersApi.GetCluster(context.Background(), projectID, *currentModel.Name).Execute()
-		if err != nil {
-			return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error creating resource : %s", err.Error()),
-				res), nil
-		}
+	cluster, res, err := clusters.GetCluster(context.Background(), projectID, *currentModel.Name).Execute()
+	if err != nil {
+		return progressevent.GetFailedEventByError(err, res), nil
+	}
+
+	changes := diffCluster(&Model{}, currentModel, cluster.GetPaused())
+	if errEvent := settingsNotApplied(changes, currentModel); errEvent != nil {
+		return *errEvent, nil
+	}
+	_, _ = log.Debugf("Updating cluster settings:%s", *currentModel.Name)
+	progressEvent.Message = createMessage(req, currentModel, progressEvent.Message)
+	return updateClusterSettings(changes, currentModel, clusters, projectID, &progressEvent)
+}
+
+func isIdle(currentModel *Model) bool {
+	return aws.StringValue(currentModel.StateName) == constants.IdleState
+}
+
+// settingsNotApplied returns a failed event if the cluster isn't IDLE while there are advanced settings or a pause
+// to apply, which happens when the poller returns Success on timeout with ReturnSuccessIfTimeOut. The settings are
+// applied to an IDLE cluster only, so the Create or Update fails instead of succeeding without them.
+func settingsNotApplied(changes *clusterChanges, currentModel *Model) *handler.ProgressEvent {
+	if isIdle(currentModel) || (!changes.applyAdvancedSettings && changes.pause == nil) {
+		return nil
+	}
+	return &handler.ProgressEvent{
+		OperationStatus:  handler.Failed,
+		HandlerErrorCode: cloudformation.HandlerErrorCodeNotStabilized,
+		Message: fmt.Sprintf("Cluster %s is still %s after the timeout, its AdvancedSettings and Paused are applied once it's %s",
+			*currentModel.Name, aws.StringValue(currentModel.StateName), constants.IdleState),
+	}
+}
 
-		_, _ = log.Debugf("Updating cluster settings:%s", *currentModel.Name)
-		return updateClusterSettings(currentModel, client, projectID, cluster, &progressEvent)
+// createMessage returns the message of the Success event of the Create, which notes if an existing cluster was adopted.
+func createMessage(req *handler.Request, currentModel *Model, message string) string {
+	if cast.ToBool(req.CallbackContext[progressevent.AdoptedKey]) {
+		return progressevent.AdoptedMessage("Cluster", *currentModel.Name)
 	}
-	return progressEvent, nil
+	return message
 }
 
 func (m *Model) HasAdvanceSettings() bool {
@@ -370,39 +361,37 @@ func formatMongoDBMajorVersion(val interface{}) string {
 	return fmt.Sprintf("%.1f", cast.ToFloat32(val))
 }
 
-func isClusterInTargetState(client *util.MongoDBClient, projectID, clusterName, targetState string) (isReady bool, stateName string, mongoCluster *admin.AdvancedClusterDescription, err error) {
-	cluster, resp, err := client.Atlas20231115014.ClustersApi.GetCluster(context.Background(), projectID, clusterName).Execute()
+func getClusterState(clusters ClustersAPI, projectID, clusterName string) (stateName string, mongoCluster *admin.AdvancedClusterDescription, err error) {
+	cluster, resp, err := clusters.GetCluster(context.Background(), projectID, clusterName).Execute()
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
-			return constants.DeletedState == targetState, constants.DeletedState, nil, nil
+			return constants.DeletedState, nil, nil
 		}
-		return false, constants.Error, nil, fmt.Errorf("error fetching cluster info (%s): %s", clusterName, err)
+		return constants.Error, nil, fmt.Errorf("error fetching cluster info (%s): %w", clusterName, err)
 	}
-	_, _ = log.Debugf("Cluster state: %s, targetState : %s", *cluster.StateName, targetState)
-	return *cluster.StateName == targetState, *cluster.StateName, cluster, nil
+	_, _ = log.Debugf("Cluster state: %s", *cluster.StateName)
+	return *cluster.StateName, cluster, nil
 }
 
-func readCluster(ctx context.Context, client *util.MongoDBClient, currentModel *Model) (*Model, *http.Response, error) {
-	cluster, res, err := client.Atlas20231115014.ClustersApi.GetCluster(ctx, *currentModel.ProjectId, *currentModel.Name).Execute()
+func readCluster(ctx context.Context, clusters ClustersAPI, currentModel *Model) (*Model, *http.Response, error) {
+	cluster, res, err := clusters.GetCluster(ctx, *currentModel.ProjectId, *currentModel.Name).Execute()
 	if err != nil || res.StatusCode != http.StatusOK {
 		return currentModel, res, err
 	}
 
 	setClusterData(currentModel, cluster)
 
-	if currentModel.AdvancedSettings != nil {
-		processArgs, resp, errr := client.Atlas20231115014.ClustersApi.GetClusterAdvancedConfiguration(ctx, *currentModel.ProjectId, *currentModel.Name).Execute()
-		if errr != nil || resp.StatusCode != http.StatusOK {
-			return currentModel, resp, errr
-		}
-		currentModel.AdvancedSettings = flattenProcessArgs(processArgs)
+	processArgs, resp, errr := clusters.GetClusterAdvancedConfiguration(ctx, *currentModel.ProjectId, *currentModel.Name).Execute()
+	if errr != nil || resp.StatusCode != http.StatusOK {
+		return currentModel, resp, errr
 	}
+	currentModel.AdvancedSettings = flattenProcessArgs(processArgs)
 	return currentModel, res, err
 }
 
-func updateCluster(ctx context.Context, client *util.MongoDBClient, currentModel *Model, clusterRequest *admin.AdvancedClusterDescription) (*Model, *http.Response, error) {
-	_, _ = log.Debugf("params : %+v %+v %+v", ctx, client, clusterRequest)
-	cluster, resp, err := client.Atlas20231115014.ClustersApi.UpdateCluster(ctx, *currentModel.ProjectId, *currentModel.Name, clusterRequest).Execute()
+func updateCluster(ctx context.Context, clusters ClustersAPI, currentModel *Model, clusterRequest *admin.AdvancedClusterDescription) (*Model, *http.Response, error) {
+	_, _ = log.Debugf("params : %+v %+v", ctx, clusterRequest)
+	cluster, resp, err := clusters.UpdateCluster(ctx, *currentModel.ProjectId, *currentModel.Name, clusterRequest).Execute()
 
 	if cluster != nil {
 		currentModel.StateName = cluster.StateName
@@ -411,53 +400,51 @@ func updateCluster(ctx context.Context, client *util.MongoDBClient, currentModel
 	return currentModel, resp, err
 }
 
-func updateAdvancedCluster(ctx context.Context, client *util.MongoDBClient,
+func updateAdvancedCluster(ctx context.Context, clusters ClustersAPI,
 	request *admin.AdvancedClusterDescription, projectID, name string) (*admin.AdvancedClusterDescription, *http.Response, error) {
-	return client.Atlas20231115014.ClustersApi.UpdateCluster(ctx, projectID, name, request).Execute()
+	return clusters.UpdateCluster(ctx, projectID, name, request).Execute()
 }
 
-func updateClusterCallback(client *util.MongoDBClient, currentModel *Model, projectID string) (handler.ProgressEvent, error) {
-	progressEvent, err := validateProgress(client, currentModel, constants.IdleState)
-	if err != nil {
+func updateClusterCallback(clusters ClustersAPI, prevModel, currentModel *Model, req *handler.Request, projectID string) (handler.ProgressEvent, error) {
+	progressEvent, err := validateProgress(clusters, currentModel, req, constants.IdleState)
+	if err != nil || progressEvent.OperationStatus != handler.Success {
 		return progressEvent, nil
 	}
 
-	if progressEvent.Message == constants.Complete {
-		_, _ = log.Debugf("compelted updation:%s", *currentModel.Name)
-		cluster, res, err := client.Atlas20231115014.ClustersApi.GetCluster(context.Background(), projectID, *currentModel.Name).Execute()
-		if err != nil {
-			return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error in Get Cluster : %s", err.Error()),
-				res), nil
-		}
-
-		_, _ = log.Debugf("Updating cluster :%s", *currentModel.Name)
+	_, _ = log.Debugf("compelted updation:%s", *currentModel.Name)
+	cluster, res, err := clusters.GetCluster(context.Background(), projectID, *currentModel.Name).Execute()
+	if err != nil {
+		return progressevent.GetFailedEventByError(err, res), nil
+	}
 
-		return updateClusterSettings(currentModel, client, projectID, cluster, &progressEvent)
+	changes := diffCluster(prevModel, currentModel, cluster.GetPaused())
+	if errEvent := settingsNotApplied(changes, currentModel); errEvent != nil {
+		return *errEvent, nil
 	}
-	return progressEvent, nil
+	_, _ = log.Debugf("Updating cluster :%s", *currentModel.Name)
+
+	return updateClusterSettings(changes, currentModel, clusters, projectID, &progressEvent)
 }
 
-func updateClusterSettings(currentModel *Model, client *util.MongoDBClient,
-	projectID string, cluster *admin.AdvancedClusterDescription, pe *handler.ProgressEvent) (handler.ProgressEvent, error) {
+func updateClusterSettings(changes *clusterChanges, currentModel *Model, clusters ClustersAPI,
+	projectID string, pe *handler.ProgressEvent) (handler.ProgressEvent, error) {
 	// Update advanced configuration
-	if currentModel.AdvancedSettings != nil {
+	if changes.applyAdvancedSettings {
 		_, _ = log.Debugf("AdvancedSettings: %+v", *currentModel.AdvancedSettings)
 
 		advancedConfig := expandAdvancedSettings(*currentModel.AdvancedSettings)
-		_, res, err := client.Atlas20231115014.ClustersApi.UpdateClusterAdvancedConfiguration(context.Background(), projectID, *cluster.Name, advancedConfig).Execute()
+		_, res, err := clusters.UpdateClusterAdvancedConfiguration(context.Background(), projectID, *currentModel.Name, advancedConfig).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error creating resource : %s", err.Error()),
-				res), err
+			return progressevent.GetFailedEventByError(err, res), err
 		}
 	}
 
 	// Update pause
-	if (currentModel.Paused != nil) && (*currentModel.Paused != *cluster.Paused) {
-		_, res, err := updateAdvancedCluster(context.Background(), client, &admin.AdvancedClusterDescription{Paused: currentModel.Paused}, projectID, *currentModel.Name)
+	if changes.pause != nil {
+		_, res, err := updateAdvancedCluster(context.Background(), clusters, &admin.AdvancedClusterDescription{Paused: changes.pause}, projectID, *currentModel.Name)
 		if err != nil {
 			_, _ = log.Warnf("Cluster Pause - error: %+v", err)
-			return progressevent.GetFailedEventByResponse(fmt.Sprintf("Cluster Pause error : %s", err.Error()),
-				res), err
+			return progressevent.GetFailedEventByError(err, res), err
 		}
 	}
 
@@ -466,41 +453,36 @@ func updateClusterSettings(currentModel *Model, client *util.MongoDBClient,
 	return *pe, nil
 }
 
-func validateProgress(client *util.MongoDBClient, currentModel *Model, targetState string) (handler.ProgressEvent, error) {
+func validateProgress(clusters ClustersAPI, currentModel *Model, req *handler.Request, targetState string) (handler.ProgressEvent, error) {
 	_, _ = log.Debugf(" Cluster validateProgress() currentModel:%+v", currentModel)
 
-	isReady, state, cluster, err := isClusterInTargetState(client, *currentModel.ProjectId, *currentModel.Name, targetState)
+	state, cluster, err := getClusterState(clusters, *currentModel.ProjectId, *currentModel.Name)
 	if err != nil {
 		_, _ = log.Debugf("ERROR Cluster validateProgress() err:%+v", err)
-		return handler.ProgressEvent{
-			Message:          err.Error(),
-			OperationStatus:  handler.Failed,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeServiceInternalError}, nil
-	}
-
-	if !isReady {
-		p := handler.NewProgressEvent()
-		p.ResourceModel = currentModel
-		p.OperationStatus = handler.InProgress
-		p.CallbackDelaySeconds = CallBackSeconds
-		p.Message = constants.Pending
-		p.CallbackContext = map[string]interface{}{
-			constants.StateName: state,
+		return progressevent.GetFailedEventByError(err, nil), nil
+	}
+
+	poller := progressevent.Poller{
+		Resource:        "Cluster " + *currentModel.Name,
+		TargetStates:    []string{targetState},
+		MinDelaySeconds: CallBackSeconds,
+		MaxDelaySeconds: 4 * CallBackSeconds,
+	}
+	pollState := progressevent.PollState{Name: state, Model: currentModel}
+	switch {
+	case targetState == constants.IdleState:
+		poller.FailureStates = []string{constants.DeletingState, constants.DeletedState}
+		if cluster != nil {
+			currentModel.StateName = cluster.StateName
+			currentModel.ConnectionStrings = flattenConnectionStrings(cluster.ConnectionStrings)
 		}
-		return p, nil
-	}
-
-	p := handler.NewProgressEvent()
-	p.OperationStatus = handler.Success
-	p.Message = constants.Complete
-	// Delete event shouldn't have model in the response
-	if targetState == constants.IdleState {
-		currentModel.StateName = cluster.StateName
-		currentModel.ConnectionStrings = flattenConnectionStrings(cluster.ConnectionStrings)
-		p.ResourceModel = currentModel
+	case state == constants.DeletedState:
+		// Delete event shouldn't have model in the response
+		pollState.Model = nil
 	}
 
-	return p, nil
+	timeoutOptions := (*progressevent.TimeoutOptions)(currentModel.TimeoutOptions)
+	return poller.WithOptions(timeoutOptions, progressevent.TypeTimeoutOptions(req)).Poll(req.CallbackContext, pollState), nil
 }
 
 func (m *Model) validateDefaultLabel() {
@@ -0,0 +1,221 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/cluster/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/mocksvc/mockadmin20231115014"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+const (
+	projectID     = "65aaaaaaaaaaaaaaaaaaaaaa"
+	clusterName   = "Cluster0"
+	updatingState = "UPDATING"
+)
+
+// mockClusters makes the handlers use a mock of the ClustersApi.
+func mockClusters(t *testing.T) *mockadmin20231115014.ClustersApi {
+	t.Helper()
+	clusters := mockadmin20231115014.NewClustersApi(t)
+	t.Cleanup(util.UseAtlasClient(&util.MongoDBClient{Atlas20231115014: &admin.APIClient{ClustersApi: clusters}}))
+	return clusters
+}
+
+func newRequest(callbackContext map[string]any, typeConfig string) handler.Request {
+	return handler.NewRequest("", callbackContext, handler.RequestContext{}, nil, nil, nil, json.RawMessage(typeConfig))
+}
+
+func atlasError(status int, errorCode string) error {
+	err := new(admin.GenericOpenAPIError)
+	err.SetModel(admin.ApiError{Error: admin.PtrInt(status), ErrorCode: admin.PtrString(errorCode)})
+	return err
+}
+
+func newModel(specs ...resource.AdvancedReplicationSpec) *resource.Model {
+	return &resource.Model{
+		Profile:          aws.String("default"),
+		ProjectId:        aws.String(projectID),
+		Name:             aws.String(clusterName),
+		ClusterType:      aws.String("GEOSHARDED"),
+		ReplicationSpecs: specs,
+	}
+}
+
+// expectGetCluster returns the cluster on GetCluster, any number of times.
+func expectGetCluster(clusters *mockadmin20231115014.ClustersApi, cluster *admin.AdvancedClusterDescription) {
+	clusters.EXPECT().GetCluster(mock.Anything, projectID, clusterName).Return(admin.GetClusterApiRequest{ApiService: clusters})
+	clusters.EXPECT().GetClusterExecute(mock.Anything).Return(cluster, &http.Response{StatusCode: http.StatusOK}, nil)
+}
+
+// expectUpdateCluster records the request of UpdateCluster.
+func expectUpdateCluster(clusters *mockadmin20231115014.ClustersApi, request **admin.AdvancedClusterDescription) {
+	clusters.EXPECT().UpdateCluster(mock.Anything, projectID, clusterName, mock.Anything).RunAndReturn(
+		func(_ context.Context, _, _ string, r *admin.AdvancedClusterDescription) admin.UpdateClusterApiRequest {
+			*request = r
+			return admin.UpdateClusterApiRequest{ApiService: clusters}
+		})
+	clusters.EXPECT().UpdateClusterExecute(mock.Anything).Return(&admin.AdvancedClusterDescription{StateName: admin.PtrString(updatingState)},
+		&http.Response{StatusCode: http.StatusOK}, nil)
+}
+
+func existingCluster() *admin.AdvancedClusterDescription {
+	return &admin.AdvancedClusterDescription{
+		Name:      admin.PtrString(clusterName),
+		StateName: admin.PtrString(constants.IdleState),
+		Paused:    admin.PtrBool(false),
+		ReplicationSpecs: &[]admin.ReplicationSpec{
+			{Id: admin.PtrString("65bbbbbbbbbbbbbbbbbbbbbb"), ZoneName: admin.PtrString("zone1")},
+		},
+	}
+}
+
+func TestUpdateKeepsReplicationSpecIDs(t *testing.T) {
+	clusters := mockClusters(t)
+	expectGetCluster(clusters, existingCluster())
+	var request *admin.AdvancedClusterDescription
+	expectUpdateCluster(clusters, &request)
+
+	model := newModel(replicationSpec(3, "US_EAST_1", "zone1"), replicationSpec(3, "EU_WEST_1", "zone2"))
+	event, err := resource.Update(newRequest(nil, ""), newModel(replicationSpec(3, "US_EAST_1", "zone1")), model)
+	require.NoError(t, err)
+	require.Equal(t, handler.InProgress, event.OperationStatus, event.Message)
+	assert.Equal(t, updatingState, event.CallbackContext[constants.StateName])
+
+	require.NotNil(t, request)
+	specs := request.GetReplicationSpecs()
+	require.Len(t, specs, 2)
+	assert.Equal(t, "65bbbbbbbbbbbbbbbbbbbbbb", specs[0].GetId(), "the existing zone keeps its replication spec")
+	assert.Empty(t, specs[1].GetId(), "the new zone gets a new replication spec")
+}
+
+func TestUpdateCallbackAppliesSettings(t *testing.T) {
+	clusters := mockClusters(t)
+	expectGetCluster(clusters, existingCluster())
+	clusters.EXPECT().UpdateClusterAdvancedConfiguration(mock.Anything, projectID, clusterName, mock.Anything).
+		Return(admin.UpdateClusterAdvancedConfigurationApiRequest{ApiService: clusters})
+	clusters.EXPECT().UpdateClusterAdvancedConfigurationExecute(mock.Anything).
+		Return(&admin.ClusterDescriptionProcessArgs{}, &http.Response{StatusCode: http.StatusOK}, nil)
+	var request *admin.AdvancedClusterDescription
+	expectUpdateCluster(clusters, &request)
+
+	model := newModel()
+	model.Paused = aws.Bool(true)
+	model.AdvancedSettings = &resource.ProcessArgs{JavascriptEnabled: aws.Bool(false)}
+	event, err := resource.Update(newRequest(map[string]any{constants.StateName: updatingState}, ""), newModel(), model)
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, &admin.AdvancedClusterDescription{Paused: admin.PtrBool(true)}, request, "only the pause is updated")
+}
+
+// timedOutCallback returns the callback context of an Update whose poll deadline passed.
+func timedOutCallback() map[string]any {
+	return map[string]any{constants.StateName: updatingState, progressevent.PollDeadlineKey: "2024-01-02T03:04:05Z"}
+}
+
+func TestUpdateCallbackTimeOutWithSettingsFails(t *testing.T) {
+	clusters := mockClusters(t)
+	cluster := existingCluster()
+	cluster.StateName = admin.PtrString(updatingState)
+	expectGetCluster(clusters, cluster)
+
+	model := newModel()
+	model.Paused = aws.Bool(true)
+	model.AdvancedSettings = &resource.ProcessArgs{JavascriptEnabled: aws.Bool(false)}
+	event, err := resource.Update(newRequest(timedOutCallback(), `{"TimeoutOptions": {"ReturnSuccessIfTimeOut": true}}`), newModel(), model)
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus, "the settings aren't applied to a cluster that isn't IDLE")
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotStabilized, event.HandlerErrorCode)
+	assert.Contains(t, event.Message, "still UPDATING")
+}
+
+func TestUpdateCallbackTimeOutWithoutSettings(t *testing.T) {
+	clusters := mockClusters(t)
+	cluster := existingCluster()
+	cluster.StateName = admin.PtrString(updatingState)
+	expectGetCluster(clusters, cluster)
+
+	event, err := resource.Update(newRequest(timedOutCallback(), `{"TimeoutOptions": {"ReturnSuccessIfTimeOut": true}}`), newModel(), newModel())
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Contains(t, event.Message, "with timeout")
+}
+
+func TestCreateAdoptsExistingCluster(t *testing.T) {
+	clusters := mockClusters(t)
+	clusters.EXPECT().CreateCluster(mock.Anything, projectID, mock.Anything).Return(admin.CreateClusterApiRequest{ApiService: clusters})
+	clusters.EXPECT().CreateClusterExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusConflict},
+		atlasError(http.StatusConflict, "DUPLICATE_CLUSTER_NAME"))
+	expectGetCluster(clusters, existingCluster())
+	var request *admin.AdvancedClusterDescription
+	expectUpdateCluster(clusters, &request)
+
+	event, err := resource.Create(newRequest(nil, `{"AdoptIfExists": true}`), nil, newModel(replicationSpec(3, "US_EAST_1", "zone1")))
+	require.NoError(t, err)
+	require.Equal(t, handler.InProgress, event.OperationStatus, event.Message)
+	assert.Equal(t, true, event.CallbackContext[progressevent.AdoptedKey])
+	require.NotNil(t, request)
+	assert.Equal(t, "65bbbbbbbbbbbbbbbbbbbbbb", request.GetReplicationSpecs()[0].GetId())
+}
+
+func TestCreateExistingClusterFails(t *testing.T) {
+	clusters := mockClusters(t)
+	clusters.EXPECT().CreateCluster(mock.Anything, projectID, mock.Anything).Return(admin.CreateClusterApiRequest{ApiService: clusters})
+	clusters.EXPECT().CreateClusterExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusConflict},
+		atlasError(http.StatusConflict, "DUPLICATE_CLUSTER_NAME"))
+
+	event, err := resource.Create(newRequest(nil, ""), nil, newModel(replicationSpec(3, "US_EAST_1", "zone1")))
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeAlreadyExists, event.HandlerErrorCode)
+}
+
+func TestDeleteCallbackCompletes(t *testing.T) {
+	clusters := mockClusters(t)
+	clusters.EXPECT().GetCluster(mock.Anything, projectID, clusterName).Return(admin.GetClusterApiRequest{ApiService: clusters})
+	clusters.EXPECT().GetClusterExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusNotFound},
+		atlasError(http.StatusNotFound, "CLUSTER_NOT_FOUND"))
+
+	event, err := resource.Delete(newRequest(map[string]any{constants.StateName: constants.DeletingState}, ""), nil, newModel())
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Nil(t, event.ResourceModel)
+}
+
+func TestReadNotFound(t *testing.T) {
+	clusters := mockClusters(t)
+	clusters.EXPECT().GetCluster(mock.Anything, projectID, clusterName).Return(admin.GetClusterApiRequest{ApiService: clusters})
+	clusters.EXPECT().GetClusterExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusNotFound},
+		atlasError(http.StatusNotFound, "CLUSTER_NOT_FOUND"))
+
+	event, err := resource.Read(newRequest(nil, ""), nil, newModel())
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, event.HandlerErrorCode)
+}
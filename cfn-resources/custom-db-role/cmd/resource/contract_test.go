@@ -0,0 +1,57 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/custom-db-role/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestContract(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	testutil.Test(t, testutil.TestCase{
+		Name:           "custom database role",
+		Schema:         "../../mongodb-atlas-customdbrole.json",
+		RequestContext: s.RequestContext(),
+		TestHandler: handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{
+			Create: resource.Create,
+			Read:   resource.Read,
+			Update: resource.Update,
+			Delete: resource.Delete,
+			List:   resource.List,
+		}),
+		Steps: []testutil.TestStep{
+			{
+				Operation: testutil.OperationCreate,
+				Config: fmt.Sprintf(`{"Profile": "default", "ProjectId": %q, "RoleName": "role",
+					"InheritedRoles": [{"Db": "admin", "Role": "read"}]}`, projectID),
+			},
+			{
+				Operation: testutil.OperationUpdate,
+				Config: fmt.Sprintf(`{"Profile": "default", "ProjectId": %q, "RoleName": "role",
+					"InheritedRoles": [{"Db": "admin", "Role": "read"}],
+					"Actions": [{"Action": "FIND", "Resources": [{"DB": "sample", "Collection": "movies"}]}]}`, projectID),
+			},
+			{Operation: testutil.OperationList, Config: fmt.Sprintf(`{"Profile": "default", "ProjectId": %q}`, projectID)},
+			{Operation: testutil.OperationDelete},
+		},
+	})
+}
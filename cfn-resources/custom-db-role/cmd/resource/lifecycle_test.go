@@ -0,0 +1,67 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/custom-db-role/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+func TestCreateAdoptsExistingRole(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	client, err := s.AtlasClient()
+	require.NoError(t, err)
+	_, _, err = client.CustomDatabaseRolesApi.CreateCustomDatabaseRole(context.Background(), projectID, &admin.UserCustomDBRole{
+		RoleName:       "role",
+		InheritedRoles: &[]admin.DatabaseInheritedRole{{Db: "admin", Role: "read"}},
+	}).Execute()
+	require.NoError(t, err)
+
+	model := &resource.Model{
+		ProjectId:      aws.String(projectID),
+		RoleName:       aws.String("role"),
+		InheritedRoles: []resource.InheritedRole{{Db: aws.String("admin"), Role: aws.String("readWrite")}},
+		Actions: []resource.Action{{
+			Action:    aws.String("FIND"),
+			Resources: []resource.Resource{{DB: aws.String("sample"), Collection: aws.String("movies")}},
+		}},
+	}
+	event, _ := fakeatlas.Run(t, s, resource.Create, nil, model)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeAlreadyExists, event.HandlerErrorCode)
+
+	event, _ = fakeatlas.RunWithTypeConfig(t, s, `{"AdoptIfExists": true}`, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, progressevent.AdoptedMessage("custom database role", "role"), event.Message)
+
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{ProjectId: aws.String(projectID), RoleName: aws.String("role")})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.Len(t, read.InheritedRoles, 1)
+	assert.Equal(t, "readWrite", aws.StringValue(read.InheritedRoles[0].Role))
+	require.Len(t, read.Actions, 1)
+	assert.Equal(t, "FIND", aws.StringValue(read.Actions[0].Action))
+	assert.Equal(t, "movies", aws.StringValue(read.Actions[0].Resources[0].Collection))
+}
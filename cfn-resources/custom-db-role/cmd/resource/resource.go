@@ -16,20 +16,39 @@ package resource
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-custom-db-role")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-custom-db-role",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
+}
+
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
 var CreateRequiredFields = []string{constants.ProjectID, constants.RoleName}
@@ -38,58 +57,50 @@ var UpdateRequiredFields = []string{constants.ProjectID, constants.RoleName}
 var DeleteRequiredFields = []string{constants.ProjectID, constants.RoleName}
 var ListRequiredFields = []string{constants.ProjectID}
 
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(CreateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasCustomDBRole := currentModel.ToCustomDBRole()
 	customDBRole, response, err := client.Atlas20231115002.CustomDatabaseRolesApi.CreateCustomDatabaseRole(context.Background(), *currentModel.ProjectId, atlasCustomDBRole).Execute()
+	adopted := false
 	if err != nil {
-		if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusConflict {
+		apiError, ok := admin.AsError(err)
+		if !ok || *apiError.Error != http.StatusConflict {
+			return progress_events.GetFailedEventByError(err, response), nil
+		}
+		if !progress_events.TypeAdoptIfExists(&req) {
 			return progress_events.GetFailedEventByCode("Resource already exists",
 				cloudformation.HandlerErrorCodeAlreadyExists), nil
 		}
 
-		return progress_events.GetFailedEventByResponse(fmt.Sprintf("Error creating resource : %s", err.Error()),
-			response), nil
+		inputCustomDBRole := admin.UpdateCustomDBRole{
+			Actions:        atlasCustomDBRole.Actions,
+			InheritedRoles: atlasCustomDBRole.InheritedRoles,
+		}
+		customDBRole, response, err = client.Atlas20231115002.CustomDatabaseRolesApi.UpdateCustomDatabaseRole(context.Background(), *currentModel.ProjectId,
+			*currentModel.RoleName, &inputCustomDBRole).Execute()
+		if err != nil {
+			return progress_events.GetFailedEventByError(err, response), nil
+		}
+		adopted = true
+	}
+
+	message := "Create Completed"
+	if adopted {
+		message = progress_events.AdoptedMessage("custom database role", *currentModel.RoleName)
 	}
 
 	currentModel.completeByAtlasRole(*customDBRole)
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		Message:         "Create Completed",
+		Message:         message,
 		ResourceModel:   currentModel}, nil
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	modelValidation := validator.ValidateModel(ReadRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasCustomDdRole, response, err := client.Atlas20231115002.CustomDatabaseRolesApi.GetCustomDatabaseRole(context.Background(), *currentModel.ProjectId, *currentModel.RoleName).Execute()
 	if err != nil {
-		return progress_events.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			response), nil
+		return progress_events.GetFailedEventByError(err, response), nil
 	}
 
 	currentModel.completeByAtlasRole(*atlasCustomDdRole)
@@ -100,21 +111,8 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		ResourceModel:   currentModel}, nil
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(UpdateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// update handles the Update event from the Cloudformation service.
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	var actions []admin.DatabasePrivilegeAction
 	for _, a := range currentModel.Actions {
 		actions = append(actions, a.toAtlasAction())
@@ -133,8 +131,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	atlasCustomDdRole, response, err := client.Atlas20231115002.CustomDatabaseRolesApi.UpdateCustomDatabaseRole(context.Background(), *currentModel.ProjectId,
 		*currentModel.RoleName, &inputCustomDBRole).Execute()
 	if err != nil {
-		return progress_events.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			response), nil
+		return progress_events.GetFailedEventByError(err, response), nil
 	}
 
 	currentModel.completeByAtlasRole(*atlasCustomDdRole)
@@ -145,25 +142,11 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		ResourceModel:   currentModel}, nil
 }
 
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(DeleteRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	response, err := client.Atlas20231115002.CustomDatabaseRolesApi.DeleteCustomDatabaseRole(context.Background(), *currentModel.ProjectId, *currentModel.RoleName).Execute()
 	if err != nil {
-		return progress_events.GetFailedEventByResponse(fmt.Sprintf("Error deleting resource : %s", err.Error()),
-			response), nil
+		return progress_events.GetFailedEventByError(err, response), nil
 	}
 
 	return handler.ProgressEvent{
@@ -171,26 +154,12 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		Message:         "Delete success"}, nil
 }
 
-// List handles the List event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(ListRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// list handles the List event from the Cloudformation service.
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	customDBRoleResponse, response, err := client.Atlas20231115002.CustomDatabaseRolesApi.ListCustomDatabaseRoles(context.Background(),
 		*currentModel.ProjectId).Execute()
 	if err != nil {
-		return progress_events.GetFailedEventByResponse(fmt.Sprintf("Error listing resource : %s", err.Error()),
-			response), nil
+		return progress_events.GetFailedEventByError(err, response), nil
 	}
 
 	mm := make([]interface{}, 0)
@@ -16,38 +16,43 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
 var RequiredFields = []string{constants.ProjectID}
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-custom-dns-configuration-cluster-aws")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-custom-dns-configuration-cluster-aws",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: RequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: RequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: RequiredFields},
 }
 
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(RequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if isCustomAWSDNSSettingExists(currentModel, client) {
 		return progressevent.GetFailedEventByCode(fmt.Sprintf("Custom AWS dns settings already enabled for : %s", *currentModel.ProjectId),
 			cloudformation.HandlerErrorCodeAlreadyExists), nil
@@ -58,23 +63,11 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return resourceCustomAWSDNSUpdate(req, prevModel, currentModel, client)
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(RequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	customAWSDNSSetting, response, err := client.Atlas20231115002.AWSClustersDNSApi.GetAWSCustomDNS(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error reading  : %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 	enabled := customAWSDNSSetting.Enabled
 	if !enabled {
@@ -89,27 +82,8 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	// Not implemented, return an empty handler.ProgressEvent
-	// and an error
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(RequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if isCustomAWSDNSSettingExists(currentModel, client) {
 		enabled := false
 		currentModel.Enabled = &enabled
@@ -128,22 +102,13 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		cloudformation.HandlerErrorCodeNotFound), nil
 }
 
-// List handles the List event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	// Not implemented, return an empty handler.ProgressEvent
-	// and an error
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-
 func resourceCustomAWSDNSUpdate(req handler.Request, prevModel *Model, currentModel *Model, client *util.MongoDBClient) (handler.ProgressEvent, error) {
 	customAWSDNSRequest := &admin.AWSCustomDNSEnabled{
 		Enabled: *currentModel.Enabled,
 	}
 	customAWSDNSModel, response, err := client.Atlas20231115002.AWSClustersDNSApi.ToggleAWSCustomDNS(context.Background(), *currentModel.ProjectId, customAWSDNSRequest).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(
-			fmt.Sprintf("Error in enabling Custom AWS DNS settings : %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 	currentModel.Enabled = &customAWSDNSModel.Enabled
 
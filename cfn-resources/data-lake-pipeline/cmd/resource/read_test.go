@@ -0,0 +1,63 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/data-lake-pipeline/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/pipelines/{pipelineName}", admin.DataLakeIngestionPipeline{
+		Id:              admin.PtrString("650000000000000000000002"),
+		GroupId:         admin.PtrString("650000000000000000000001"),
+		Name:            admin.PtrString("pipeline"),
+		State:           admin.PtrString("ACTIVE"),
+		CreatedDate:     &created,
+		LastUpdatedDate: &created,
+		Sink:            &admin.IngestionSink{Type: admin.PtrString("DLS"), MetadataProvider: admin.PtrString("AWS"), MetadataRegion: admin.PtrString("US_EAST_1")},
+		Source: &admin.IngestionSource{
+			Type:           admin.PtrString("ON_DEMAND_CPS"),
+			ClusterName:    admin.PtrString("cluster"),
+			CollectionName: admin.PtrString("collection"),
+			DatabaseName:   admin.PtrString("database"),
+			GroupId:        admin.PtrString("650000000000000000000001"),
+		},
+		Transformations: &[]admin.FieldTransformation{{Field: admin.PtrString("secret"), Type: admin.PtrString("EXCLUDE")}},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "data lake pipeline",
+		Schema:         "../../mongodb-atlas-datalakepipeline.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "Name": "pipeline", "State": "ACTIVE",
+				"Sink": {"Type": "DLS", "MetadataProvider": "AWS", "MetadataRegion": "US_EAST_1"},
+				"Source": {"Type": "ON_DEMAND_CPS", "ClusterName": "cluster", "CollectionName": "collection",
+					"DatabaseName": "database", "GroupId": "650000000000000000000001"},
+				"Transformations": [{"Field": "secret", "Type": "EXCLUDE"}]}`,
+		}},
+	})
+}
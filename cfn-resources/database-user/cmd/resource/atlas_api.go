@@ -0,0 +1,43 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+// DatabaseUsersAPI is the part of the Atlas DatabaseUsersApi used by the database user handlers. The SDK clients and
+// the mocks of testutil/mocksvc/mockadmin20231115014 implement it.
+type DatabaseUsersAPI interface {
+	CreateDatabaseUser(ctx context.Context, groupID string, cloudDatabaseUser *admin.CloudDatabaseUser) admin.CreateDatabaseUserApiRequest
+	GetDatabaseUser(ctx context.Context, groupID string, databaseName string, username string) admin.GetDatabaseUserApiRequest
+	UpdateDatabaseUser(ctx context.Context, groupID string, databaseName string, username string,
+		cloudDatabaseUser *admin.CloudDatabaseUser) admin.UpdateDatabaseUserApiRequest
+	DeleteDatabaseUser(ctx context.Context, groupID string, databaseName string, username string) admin.DeleteDatabaseUserApiRequest
+	ListDatabaseUsersWithParams(ctx context.Context, args *admin.ListDatabaseUsersApiParams) admin.ListDatabaseUsersApiRequest
+}
+
+// ClustersAPI is the part of the Atlas ClustersApi used to save the connection strings of the clusters in the
+// password secret.
+type ClustersAPI interface {
+	ListClusters(ctx context.Context, groupID string) admin.ListClustersApiRequest
+}
+
+var (
+	_ DatabaseUsersAPI = admin.DatabaseUsersApi(nil)
+	_ ClustersAPI      = admin.ClustersApi(nil)
+)
@@ -6,6 +6,9 @@ import "github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 
 // TypeConfiguration is autogenerated from the json schema
 type TypeConfiguration struct {
+	AdoptIfExists  *bool   `json:",omitempty"`
+	ProfileBackend *string `json:",omitempty"`
+	ProfileFile    *string `json:",omitempty"`
 }
 
 // Configuration returns a resource's configuration.
@@ -0,0 +1,58 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/database-user/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestContract(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	testutil.Test(t, testutil.TestCase{
+		Name:           "database user",
+		Schema:         "../../mongodb-atlas-databaseuser.json",
+		RequestContext: s.RequestContext(),
+		TestHandler: handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{
+			Create: resource.Create,
+			Read:   resource.Read,
+			Update: resource.Update,
+			Delete: resource.Delete,
+			List:   resource.List,
+		}),
+		Steps: []testutil.TestStep{
+			{
+				Operation: testutil.OperationCreate,
+				Config: fmt.Sprintf(`{"Profile": "default", "ProjectId": %q, "DatabaseName": "admin", "Username": "user",
+					"Password": "password1", "Roles": [{"DatabaseName": "admin", "RoleName": "readAnyDatabase"}],
+					"Scopes": [{"Name": "cluster", "Type": "CLUSTER"}]}`, projectID),
+			},
+			{
+				Operation: testutil.OperationUpdate,
+				Config: fmt.Sprintf(`{"Profile": "default", "ProjectId": %q, "DatabaseName": "admin", "Username": "user",
+					"Password": "password2", "Roles": [{"DatabaseName": "admin", "RoleName": "readWriteAnyDatabase"}],
+					"Labels": [{"Key": "team", "Value": "payments"}]}`, projectID),
+			},
+			{Operation: testutil.OperationList, Config: fmt.Sprintf(`{"Profile": "default", "ProjectId": %q}`, projectID)},
+			{Operation: testutil.OperationDelete},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/database-user/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+func TestLifecycle(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	model := &resource.Model{
+		ProjectId:    aws.String(projectID),
+		DatabaseName: aws.String("admin"),
+		Username:     aws.String("user"),
+		Password:     aws.String("password1"),
+		Roles:        []resource.RoleDefinition{{DatabaseName: aws.String("admin"), RoleName: aws.String("readAnyDatabase")}},
+		Scopes:       []resource.ScopeDefinition{{Name: aws.String("cluster"), Type: aws.String("CLUSTER")}},
+	}
+
+	event, _ := fakeatlas.Run(t, s, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	password, _ := s.DatabaseUserPassword(projectID, "admin", "user")
+	assert.Equal(t, "password1", password)
+
+	event, _ = fakeatlas.Run(t, s, resource.Create, nil, model)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeAlreadyExists, event.HandlerErrorCode)
+
+	key := &resource.Model{ProjectId: aws.String(projectID), DatabaseName: aws.String("admin"), Username: aws.String("user")}
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.Len(t, read.Roles, 1)
+	assert.Equal(t, "readAnyDatabase", aws.StringValue(read.Roles[0].RoleName))
+	assert.Nil(t, read.Password)
+
+	updated := *model
+	updated.Password = aws.String("password2")
+	updated.Roles = []resource.RoleDefinition{{DatabaseName: aws.String("admin"), RoleName: aws.String("readWriteAnyDatabase")}}
+	event, _ = fakeatlas.Run(t, s, resource.Update, model, &updated)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	password, _ = s.DatabaseUserPassword(projectID, "admin", "user")
+	assert.Equal(t, "password2", password)
+
+	event, read = fakeatlas.Run(t, s, resource.Read, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.Len(t, read.Roles, 1)
+	assert.Equal(t, "readWriteAnyDatabase", aws.StringValue(read.Roles[0].RoleName))
+
+	event, _ = fakeatlas.Run(t, s, resource.List, nil, &resource.Model{ProjectId: aws.String(projectID)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Len(t, event.ResourceModels, 1)
+
+	event, _ = fakeatlas.Run(t, s, resource.Delete, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, _ = fakeatlas.Run(t, s, resource.Read, nil, key)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, event.HandlerErrorCode)
+}
+
+func TestLifecycleAdoptsExistingUser(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	client, err := s.AtlasClient()
+	require.NoError(t, err)
+	_, _, err = client.DatabaseUsersApi.CreateDatabaseUser(context.Background(), projectID, &admin.CloudDatabaseUser{
+		GroupId:      projectID,
+		DatabaseName: "admin",
+		Username:     "user",
+		Password:     admin.PtrString("password0"),
+		Roles:        &[]admin.DatabaseUserRole{{DatabaseName: "admin", RoleName: "read"}},
+	}).Execute()
+	require.NoError(t, err)
+
+	model := &resource.Model{
+		ProjectId:    aws.String(projectID),
+		DatabaseName: aws.String("admin"),
+		Username:     aws.String("user"),
+		Password:     aws.String("password1"),
+		Roles:        []resource.RoleDefinition{{DatabaseName: aws.String("admin"), RoleName: aws.String("readAnyDatabase")}},
+	}
+	event, _ := fakeatlas.RunWithTypeConfig(t, s, `{"AdoptIfExists": true}`, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, progressevent.AdoptedMessage("database user", "admin/user"), event.Message)
+	password, _ := s.DatabaseUserPassword(projectID, "admin", "user")
+	assert.Equal(t, "password1", password)
+
+	key := &resource.Model{ProjectId: aws.String(projectID), DatabaseName: aws.String("admin"), Username: aws.String("user")}
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.Len(t, read.Roles, 1)
+	assert.Equal(t, "readAnyDatabase", aws.StringValue(read.Roles[0].RoleName))
+}
+
+func TestIdentifiesUserByURN(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	model := &resource.Model{
+		ProjectId:    aws.String(projectID),
+		DatabaseName: aws.String("$external"),
+		Username:     aws.String("CN=user,OU=ops/eu"),
+		X509Type:     aws.String("CUSTOMER"),
+		Roles:        []resource.RoleDefinition{{DatabaseName: aws.String("admin"), RoleName: aws.String("readAnyDatabase")}},
+	}
+	event, created := fakeatlas.Run(t, s, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	urn := aws.StringValue(created.UserCFNIdentifier)
+	assert.Equal(t, util.DatabaseUserURN(projectID, "$external", "CN=user,OU=ops/eu").String(), urn)
+
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{UserCFNIdentifier: aws.String(urn)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, projectID, aws.StringValue(read.ProjectId))
+	assert.Equal(t, "CN=user,OU=ops/eu", aws.StringValue(read.Username))
+	assert.Equal(t, "CUSTOMER", aws.StringValue(read.X509Type))
+
+	event, _ = fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{UserCFNIdentifier: aws.String(util.ClusterURN(projectID, "cluster").String())})
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeInvalidRequest, event.HandlerErrorCode)
+
+	event, _ = fakeatlas.Run(t, s, resource.Delete, nil, &resource.Model{UserCFNIdentifier: aws.String(urn)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, _ = fakeatlas.Run(t, s, resource.Read, nil, created)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, event.HandlerErrorCode)
+}
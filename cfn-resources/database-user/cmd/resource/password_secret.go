@@ -0,0 +1,116 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/secrets"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+// managedPassword reports if the password of the user is generated by the handlers and kept in the PasswordSecretName
+// secret instead of being set in the template.
+func managedPassword(model *Model) bool {
+	return util.IsStringPresent(model.PasswordSecretName)
+}
+
+// validatePasswordSecret checks that the password isn't both set in the template and managed.
+func validatePasswordSecret(model *Model) *handler.ProgressEvent {
+	if managedPassword(model) && model.Password != nil {
+		return &handler.ProgressEvent{
+			OperationStatus:  handler.Failed,
+			Message:          "Password and PasswordSecretName can't be both set, the password of the user is generated with PasswordSecretName",
+			HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}
+	}
+	return nil
+}
+
+// savePasswordSecret saves the password of the user in the PasswordSecretName secret, with the connection strings of
+// the clusters the user can access, and sets the PasswordSecretArn of the model.
+func savePasswordSecret(req *handler.Request, clustersAPI ClustersAPI, model *Model, password string) *handler.ProgressEvent {
+	secret := secrets.DatabaseUserSecret{
+		ProjectID:    *model.ProjectId,
+		DatabaseName: *model.DatabaseName,
+		Username:     *model.Username,
+		Password:     password,
+		Profile:      util.SafeString(model.Profile),
+	}
+	var clusterScopes []string
+	for _, scope := range model.Scopes {
+		if util.SafeString(scope.Type) == "CLUSTER" {
+			clusterScopes = append(clusterScopes, util.SafeString(scope.Name))
+		}
+	}
+	clusters, _, err := util.ListAllPages(0, func(pageNum, itemsPerPage int) ([]admin.AdvancedClusterDescription, int, *http.Response, error) {
+		page, resp, err := clustersAPI.ListClusters(context.Background(), *model.ProjectId).
+			PageNum(pageNum).ItemsPerPage(itemsPerPage).IncludeCount(true).Execute()
+		return page.GetResults(), page.GetTotalCount(), resp, err
+	})
+	if err != nil {
+		return passwordSecretError(model, err)
+	}
+	for _, cluster := range clusters {
+		standardSrv := cluster.ConnectionStrings.GetStandardSrv()
+		if standardSrv == "" || (len(clusterScopes) > 0 && !slices.Contains(clusterScopes, cluster.GetName())) {
+			continue
+		}
+		secret.AddConnectionString(cluster.GetName(), standardSrv)
+	}
+
+	description := fmt.Sprintf("Password of the MongoDB Atlas database user %s of the project %s", secret.Username, secret.ProjectID)
+	arn, err := secrets.Save(req, *model.PasswordSecretName, secret, &description)
+	if err != nil {
+		return passwordSecretError(model, err)
+	}
+	model.PasswordSecretArn = arn
+	return nil
+}
+
+// readPasswordSecretArn sets the PasswordSecretArn of the model from its PasswordSecretName.
+func readPasswordSecretArn(req *handler.Request, model *Model) {
+	model.PasswordSecretArn = nil
+	if !managedPassword(model) {
+		return
+	}
+	if arn, err := secrets.Describe(req, *model.PasswordSecretName); err == nil {
+		model.PasswordSecretArn = arn
+	}
+}
+
+// deletePasswordSecret deletes the PasswordSecretName secret of a managed password, e.g. with the user or once the
+// password of the user is kept in another secret.
+func deletePasswordSecret(req *handler.Request, model *Model) *handler.ProgressEvent {
+	if !managedPassword(model) {
+		return nil
+	}
+	if err := secrets.Delete(req, *model.PasswordSecretName); err != nil && !secrets.IsNotFound(err) {
+		return passwordSecretError(model, err)
+	}
+	return nil
+}
+
+func passwordSecretError(model *Model, err error) *handler.ProgressEvent {
+	return &handler.ProgressEvent{
+		OperationStatus:  handler.Failed,
+		Message:          fmt.Sprintf("Error managing the password secret %s: %s", *model.PasswordSecretName, err.Error()),
+		HandlerErrorCode: cloudformation.HandlerErrorCodeGeneralServiceException}
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/database-user/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSecretsManager serves the Secrets Manager operations of the password secrets, keeping the secrets by name.
+type fakeSecretsManager struct {
+	// onCreate is called with the secret created, e.g. to check the state of Atlas at that time.
+	onCreate   func(name, value string)
+	secrets    map[string]string
+	operations []string
+	mu         sync.Mutex
+}
+
+func newFakeSecretsManager(t *testing.T) (*fakeSecretsManager, *session.Session) {
+	t.Helper()
+	f := &fakeSecretsManager{secrets: map[string]string{}}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Endpoint:    aws.String(srv.URL),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	})
+	require.NoError(t, err)
+	return f, sess
+}
+
+func (f *fakeSecretsManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	operation := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "secretsmanager.")
+	f.operations = append(f.operations, operation)
+	var input struct {
+		Name         string `json:"Name"`
+		SecretID     string `json:"SecretId"`
+		SecretString string `json:"SecretString"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&input)
+	name := input.Name + input.SecretID
+	_, exists := f.secrets[name]
+	switch {
+	case operation == "CreateSecret" && exists:
+		writeSecretsManagerError(w, "ResourceExistsException")
+		return
+	case operation != "CreateSecret" && !exists:
+		writeSecretsManagerError(w, "ResourceNotFoundException")
+		return
+	}
+	switch operation {
+	case "CreateSecret", "PutSecretValue":
+		f.secrets[name] = input.SecretString
+		if f.onCreate != nil {
+			f.onCreate(name, input.SecretString)
+		}
+	case "DeleteSecret":
+		delete(f.secrets, name)
+	}
+	_ = json.NewEncoder(w).Encode(map[string]string{"ARN": "arn:aws:secretsmanager:us-east-1:123456789012:secret:" + name, "Name": name})
+}
+
+func writeSecretsManagerError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{"__type": code, "message": code})
+}
+
+func managedUser(projectID, secretName string) *resource.Model {
+	return &resource.Model{
+		ProjectId:          aws.String(projectID),
+		DatabaseName:       aws.String("admin"),
+		Username:           aws.String("user"),
+		PasswordSecretName: aws.String(secretName),
+		Roles:              []resource.RoleDefinition{{DatabaseName: aws.String("admin"), RoleName: aws.String("readAnyDatabase")}},
+	}
+}
+
+func TestUpdatePasswordSecretName(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	sm, sess := newFakeSecretsManager(t)
+	req := handler.NewRequest("", nil, s.RequestContext(), sess, nil, nil, nil)
+
+	event, err := resource.Create(req, nil, managedUser(projectID, "old"))
+	require.NoError(t, err)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.Contains(t, sm.secrets, "old")
+	oldPassword, _ := s.DatabaseUserPassword(projectID, "admin", "user")
+
+	sm.onCreate = func(string, string) {
+		password, _ := s.DatabaseUserPassword(projectID, "admin", "user")
+		assert.Equal(t, oldPassword, password, "the new password must be saved before it's set in Atlas")
+	}
+	event, err = resource.Update(req, managedUser(projectID, "old"), managedUser(projectID, "new"))
+	require.NoError(t, err)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.NotContains(t, sm.secrets, "old", "the previous secret holds a password that isn't live anymore")
+	require.Contains(t, sm.secrets, "new")
+	newPassword, _ := s.DatabaseUserPassword(projectID, "admin", "user")
+	assert.NotEqual(t, oldPassword, newPassword)
+	assert.Contains(t, sm.secrets["new"], newPassword)
+
+	event, err = resource.Update(req, managedUser(projectID, "new"), managedUser(projectID, "new"))
+	require.NoError(t, err)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, "arn:aws:secretsmanager:us-east-1:123456789012:secret:new", aws.StringValue(event.ResourceModel.(*resource.Model).PasswordSecretArn))
+	assert.Contains(t, sm.operations, "DescribeSecret")
+
+	// the password is set in the template again
+	unmanaged := managedUser(projectID, "")
+	unmanaged.PasswordSecretName = nil
+	unmanaged.Password = aws.String("password1")
+	event, err = resource.Update(req, managedUser(projectID, "new"), unmanaged)
+	require.NoError(t, err)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Empty(t, sm.secrets)
+	assert.NotContains(t, sm.operations, "GetSecretValue", "the handlers don't read the passwords")
+}
+
+func TestUpdatePasswordSecretAtlasError(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	sm, sess := newFakeSecretsManager(t)
+	req := handler.NewRequest("", nil, s.RequestContext(), sess, nil, nil, nil)
+
+	// the user doesn't exist in Atlas, the new secret isn't kept
+	event, err := resource.Update(req, managedUser(projectID, "old"), managedUser(projectID, "new"))
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Contains(t, sm.operations, "CreateSecret")
+	assert.Empty(t, sm.secrets)
+}
@@ -17,15 +17,19 @@ package resource
 import (
 	"context"
 	"fmt"
+	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/idempotency"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/secrets"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
@@ -35,28 +39,53 @@ var UpdateRequiredFields = []string{constants.DatabaseName, constants.ProjectID,
 var DeleteRequiredFields = []string{constants.ProjectID, constants.DatabaseName, constants.Username}
 var ListRequiredFields = []string{constants.ProjectID}
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-database-user")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-database-user",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Identify: identify,
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
 }
 
-// validateModel to validate inputs to all actions
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
-}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
-	if errEvent := validateModel(CreateRequiredFields, currentModel); errEvent != nil {
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	if errEvent := validatePasswordSecret(currentModel); errEvent != nil {
 		return *errEvent, nil
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
+	users := client.Atlas20231115014.DatabaseUsersApi
+	var password string
+	if managedPassword(currentModel) {
+		var err error
+		if password, err = secrets.GeneratePassword(); err != nil {
+			return handler.ProgressEvent{
+				OperationStatus:  handler.Failed,
+				Message:          fmt.Sprintf("Error generating the password: %s", err.Error()),
+				HandlerErrorCode: cloudformation.HandlerErrorCodeInternalFailure}, nil
+		}
+		currentModel.Password = &password
 	}
+	// the password isn't part of the token, it would be in the name of the binding
+	bindingModel := *currentModel
+	bindingModel.Password = nil
+	binding := idempotency.New(&req, &bindingModel)
+	defer binding.Done()
 
 	dbUser, err := setModel(currentModel)
 	if err != nil {
@@ -68,101 +97,137 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	groupID := *currentModel.ProjectId
 
-	_, resp, err := client.Atlas20231115014.DatabaseUsersApi.CreateDatabaseUser(context.Background(), groupID, dbUser).Execute()
+	var resp *http.Response
+	_, resumed, err := binding.Create(func(string) (bool, error) {
+		var err error
+		_, resp, err = users.GetDatabaseUser(context.Background(), groupID, dbUser.DatabaseName, dbUser.Username).Execute()
+		if progressevent.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}, func() (string, error) {
+		var err error
+		_, resp, err = users.CreateDatabaseUser(context.Background(), groupID, dbUser).Execute()
+		return dbUser.DatabaseName + "/" + dbUser.Username, err
+	})
+	adopted := false
+	if progressevent.IsAlreadyExists(err) && progressevent.TypeAdoptIfExists(&req) {
+		_, resp, err = users.UpdateDatabaseUser(context.Background(), groupID, dbUser.DatabaseName, dbUser.Username, dbUser).Execute()
+		adopted = err == nil
+	}
+	if err == nil && resumed && managedPassword(currentModel) {
+		// the password generated by the previous attempt is lost
+		_, resp, err = users.UpdateDatabaseUser(context.Background(), groupID, dbUser.DatabaseName, dbUser.Username, dbUser).Execute()
+	}
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
+	}
+	if managedPassword(currentModel) {
+		currentModel.Password = nil
+		if errEvent := savePasswordSecret(&req, client.Atlas20231115014.ClustersApi, currentModel, password); errEvent != nil {
+			return *errEvent, nil
+		}
 	}
 
 	updateUserCFNIdentifier(currentModel)
 
+	message := "Create Complete"
+	if adopted {
+		message = progressevent.AdoptedMessage("database user", dbUser.DatabaseName+"/"+dbUser.Username)
+	}
+
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		Message:         "Create Complete",
+		Message:         message,
 		ResourceModel:   currentModel,
 	}, nil
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if errEvent := validateModel(ReadRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	users := client.Atlas20231115014.DatabaseUsersApi
 
 	groupID := *currentModel.ProjectId
 	username := *currentModel.Username
 	dbName := *currentModel.DatabaseName
-	databaseUser, resp, err := client.Atlas20231115014.DatabaseUsersApi.GetDatabaseUser(context.Background(), groupID, dbName, username).Execute()
+	databaseUser, resp, err := users.GetDatabaseUser(context.Background(), groupID, dbName, username).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
-	currentModel.DatabaseName = &databaseUser.DatabaseName
+	_, _ = logger.Debugf("databaseUser:%+v", databaseUser)
+	setUserData(currentModel, databaseUser)
+	readPasswordSecretArn(&req, currentModel)
 
-	if currentModel.LdapAuthType != nil {
-		currentModel.LdapAuthType = databaseUser.LdapAuthType
-	}
-	if currentModel.AWSIAMType != nil {
-		currentModel.AWSIAMType = databaseUser.AwsIAMType
-	}
-	if currentModel.X509Type != nil {
-		currentModel.X509Type = databaseUser.X509Type
-	}
+	updateUserCFNIdentifier(currentModel)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.ReadComplete,
+		ResourceModel:   currentModel,
+	}, nil
+}
+
+// setUserData sets the properties of the model from the database user, the password is write-only.
+func setUserData(currentModel *Model, databaseUser *admin.CloudDatabaseUser) {
+	currentModel.ProjectId = &databaseUser.GroupId
+	currentModel.DatabaseName = &databaseUser.DatabaseName
 	currentModel.Username = &databaseUser.Username
-	_, _ = logger.Debugf("databaseUser:%+v", databaseUser)
-	var roles []RoleDefinition
+	currentModel.Password = nil
+	currentModel.LdapAuthType = databaseUser.LdapAuthType
+	currentModel.AWSIAMType = databaseUser.AwsIAMType
+	currentModel.X509Type = databaseUser.X509Type
+	currentModel.DeleteAfterDate = util.TimePtrToStringPtr(databaseUser.DeleteAfterDate)
 
+	var roles []RoleDefinition
 	for _, r := range databaseUser.GetRoles() {
-		role := RoleDefinition{
+		roles = append(roles, RoleDefinition{
 			CollectionName: r.CollectionName,
 			DatabaseName:   &r.DatabaseName,
 			RoleName:       &r.RoleName,
-		}
-
-		roles = append(roles, role)
+		})
 	}
 	currentModel.Roles = roles
 
 	var labels []LabelDefinition
-
 	for _, l := range databaseUser.GetLabels() {
-		label := LabelDefinition{
+		labels = append(labels, LabelDefinition{
 			Key:   l.Key,
 			Value: l.Value,
-		}
-
-		labels = append(labels, label)
+		})
 	}
 	currentModel.Labels = labels
 
-	updateUserCFNIdentifier(currentModel)
-
-	return handler.ProgressEvent{
-		OperationStatus: handler.Success,
-		Message:         constants.ReadComplete,
-		ResourceModel:   currentModel,
-	}, nil
+	var scopes []ScopeDefinition
+	for _, sc := range databaseUser.GetScopes() {
+		scopes = append(scopes, ScopeDefinition{
+			Name: &sc.Name,
+			Type: &sc.Type,
+		})
+	}
+	currentModel.Scopes = scopes
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if errEvent := validateModel(UpdateRequiredFields, currentModel); errEvent != nil {
+// update handles the Update event from the Cloudformation service.
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	if errEvent := validatePasswordSecret(currentModel); errEvent != nil {
 		return *errEvent, nil
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
+	users := client.Atlas20231115014.DatabaseUsersApi
+
+	// the password of a managed secret is changed by its rotation, it's only generated for a new secret
+	var password string
+	newSecret := managedPassword(currentModel) && !util.AreStringPtrEqual(prevModel.PasswordSecretName, currentModel.PasswordSecretName)
+	if newSecret {
+		var err error
+		if password, err = secrets.GeneratePassword(); err != nil {
+			return handler.ProgressEvent{
+				OperationStatus:  handler.Failed,
+				Message:          fmt.Sprintf("Error generating the password: %s", err.Error()),
+				HandlerErrorCode: cloudformation.HandlerErrorCodeInternalFailure}, nil
+		}
+		currentModel.Password = &password
 	}
 
 	dbUser, err := setModel(currentModel)
@@ -175,9 +240,30 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	groupID := *currentModel.ProjectId
 
-	_, resp, err := client.Atlas20231115014.DatabaseUsersApi.UpdateDatabaseUser(context.Background(), groupID, *currentModel.DatabaseName, *currentModel.Username, dbUser).Execute()
+	// the new password is saved before it's set in Atlas, so that it's never live without being stored
+	if newSecret {
+		currentModel.Password = nil
+		if errEvent := savePasswordSecret(&req, client.Atlas20231115014.ClustersApi, currentModel, password); errEvent != nil {
+			return *errEvent, nil
+		}
+	}
+	_, resp, err := users.UpdateDatabaseUser(context.Background(), groupID, *currentModel.DatabaseName, *currentModel.Username, dbUser).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		if newSecret {
+			// the secret holds a password that Atlas didn't set
+			_ = deletePasswordSecret(&req, currentModel)
+		}
+		return progressevent.GetFailedEventByError(err, resp), nil
+	}
+	if managedPassword(currentModel) && !newSecret {
+		currentModel.Password = nil
+		readPasswordSecretArn(&req, currentModel)
+	}
+	// the previous secret holds a password that isn't the one of the user anymore
+	if managedPassword(prevModel) && !util.AreStringPtrEqual(prevModel.PasswordSecretName, currentModel.PasswordSecretName) {
+		if errEvent := deletePasswordSecret(&req, prevModel); errEvent != nil {
+			return *errEvent, nil
+		}
 	}
 
 	updateUserCFNIdentifier(currentModel)
@@ -189,28 +275,21 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if errEvent := validateModel(DeleteRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	// Create atlas client
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	users := client.Atlas20231115014.DatabaseUsersApi
 
 	groupID := *currentModel.ProjectId
 	databaseName := *currentModel.DatabaseName
 	username := *currentModel.Username
-	_, resp, err := client.Atlas20231115014.DatabaseUsersApi.DeleteDatabaseUser(context.Background(), groupID, databaseName, username).Execute()
+	_, resp, err := users.DeleteDatabaseUser(context.Background(), groupID, databaseName, username).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
+	}
+	if errEvent := deletePasswordSecret(&req, currentModel); errEvent != nil {
+		return *errEvent, nil
 	}
+	idempotency.Clear(&req)
 
 	updateUserCFNIdentifier(currentModel)
 
@@ -220,30 +299,30 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-// List handles listing database users
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if errEvent := validateModel(ListRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// list handles listing database users
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	users := client.Atlas20231115014.DatabaseUsersApi
 
 	groupID := *currentModel.ProjectId
 
 	dbUserModels := make([]interface{}, 0)
 
-	databaseUsers, resp, err := client.Atlas20231115014.DatabaseUsersApi.ListDatabaseUsers(context.Background(), groupID).Execute()
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+	dbUserResults, nextToken, peErr := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.CloudDatabaseUser, int, *http.Response, error) {
+		databaseUsers, resp, err := users.ListDatabaseUsersWithParams(context.Background(), &admin.ListDatabaseUsersApiParams{
+			GroupId:      groupID,
+			IncludeCount: admin.PtrBool(true),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+		}).Execute()
+		if err != nil {
+			return nil, 0, resp, err
+		}
+		return databaseUsers.GetResults(), databaseUsers.GetTotalCount(), resp, nil
+	})
+	if peErr != nil {
+		return *peErr, nil
 	}
 
-	dbUserResults := databaseUsers.GetResults()
 	for i := range dbUserResults {
 		databaseUser := dbUserResults[i]
 		var model = Model{
@@ -252,6 +331,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 			X509Type:     databaseUser.X509Type,
 			Username:     &databaseUser.Username,
 			ProjectId:    currentModel.ProjectId,
+			Profile:      currentModel.Profile,
 		}
 
 		var roles []RoleDefinition
@@ -286,6 +366,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "List Complete",
 		ResourceModels:  dbUserModels,
+		NextToken:       nextToken,
 	}, nil
 }
 
@@ -340,7 +421,7 @@ func setModel(currentModel *Model) (*admin.CloudDatabaseUser, error) {
 	}
 
 	if currentModel.Password == nil {
-		if (*currentModel.LdapAuthType == none) && (*currentModel.AWSIAMType == none) && (*currentModel.X509Type == none) {
+		if (*currentModel.LdapAuthType == none) && (*currentModel.AWSIAMType == none) && (*currentModel.X509Type == none) && !managedPassword(currentModel) {
 			err := fmt.Errorf("password cannot be empty if not LDAP or IAM or X509 is not provided")
 			return nil, err
 		}
@@ -372,6 +453,23 @@ func setModel(currentModel *Model) (*admin.CloudDatabaseUser, error) {
 }
 
 func updateUserCFNIdentifier(model *Model) {
-	cfnid := fmt.Sprintf("%s-%s", *model.Username, *model.ProjectId)
+	cfnid := util.DatabaseUserURN(*model.ProjectId, *model.DatabaseName, *model.Username).String()
 	model.UserCFNIdentifier = &cfnid
 }
+
+// identify sets the project, database and username of a model identified by the URN of its UserCFNIdentifier only.
+func identify(model *Model) error {
+	if model.UserCFNIdentifier == nil || model.ProjectId != nil || model.DatabaseName != nil || model.Username != nil {
+		return nil
+	}
+	urn, err := util.ParseURN(*model.UserCFNIdentifier)
+	if err != nil {
+		return err
+	}
+	ids, err := urn.IDs(util.URNProject, util.URNDatabase, util.URNUser)
+	if err != nil {
+		return err
+	}
+	model.ProjectId, model.DatabaseName, model.Username = &ids[0], &ids[1], &ids[2]
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/database-user/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/mocksvc/mockadmin20231115014"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/idempotency"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+const projectID = "65aaaaaaaaaaaaaaaaaaaaaa"
+
+// mockUsers makes the handlers use a mock of the DatabaseUsersApi.
+func mockUsers(t *testing.T) *mockadmin20231115014.DatabaseUsersApi {
+	t.Helper()
+	users := mockadmin20231115014.NewDatabaseUsersApi(t)
+	t.Cleanup(util.UseAtlasClient(&util.MongoDBClient{Atlas20231115014: &admin.APIClient{DatabaseUsersApi: users}}))
+	return users
+}
+
+// previousAttempt is an idempotency.Store keeping the binding of an attempt that crashed, so the next attempt finds it.
+type previousAttempt struct {
+	value   string
+	deleted bool
+}
+
+func (s *previousAttempt) Get(string) (string, error) { return s.value, nil }
+func (s *previousAttempt) Put(_, value string) error  { s.value = value; return nil }
+func (s *previousAttempt) Delete(string) error        { s.deleted = true; return nil }
+
+func atlasError(status int, errorCode string) error {
+	err := new(admin.GenericOpenAPIError)
+	err.SetModel(admin.ApiError{Error: admin.PtrInt(status), ErrorCode: admin.PtrString(errorCode)})
+	return err
+}
+
+func newModel() *resource.Model {
+	return &resource.Model{
+		ProjectId:    aws.String(projectID),
+		DatabaseName: aws.String("admin"),
+		Username:     aws.String("user"),
+		Password:     aws.String("password1"),
+		Roles:        []resource.RoleDefinition{{DatabaseName: aws.String("admin"), RoleName: aws.String("readAnyDatabase")}},
+	}
+}
+
+func TestCreateResumesPreviousAttempt(t *testing.T) {
+	store := &previousAttempt{}
+	newStore := idempotency.NewStore
+	idempotency.NewStore = func(*handler.Request) idempotency.Store { return store }
+	t.Cleanup(func() { idempotency.NewStore = newStore })
+	users := mockUsers(t)
+	users.EXPECT().CreateDatabaseUser(mock.Anything, projectID, mock.Anything).Return(admin.CreateDatabaseUserApiRequest{ApiService: users}).Once()
+	users.EXPECT().CreateDatabaseUserExecute(mock.Anything).Return(&admin.CloudDatabaseUser{}, &http.Response{StatusCode: http.StatusCreated}, nil).Once()
+	users.EXPECT().GetDatabaseUser(mock.Anything, projectID, "admin", "user").Return(admin.GetDatabaseUserApiRequest{ApiService: users})
+	users.EXPECT().GetDatabaseUserExecute(mock.Anything).Return(&admin.CloudDatabaseUser{}, &http.Response{StatusCode: http.StatusOK}, nil)
+
+	// the first attempt crashes after the user is created, its binding stays in the store
+	req := handler.NewRequest("User", nil, handler.RequestContext{StackID: "stack"}, nil, nil, nil, nil)
+	event, err := resource.Create(req, nil, newModel())
+	require.NoError(t, err)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.NotEmpty(t, store.value)
+	store.deleted = false
+
+	event, err = resource.Create(req, nil, newModel())
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.True(t, store.deleted, "the binding is removed once the Create succeeded")
+}
+
+func TestCreateFailureRemovesBinding(t *testing.T) {
+	store := &previousAttempt{}
+	newStore := idempotency.NewStore
+	idempotency.NewStore = func(*handler.Request) idempotency.Store { return store }
+	t.Cleanup(func() { idempotency.NewStore = newStore })
+	users := mockUsers(t)
+	users.EXPECT().CreateDatabaseUser(mock.Anything, projectID, mock.Anything).Return(admin.CreateDatabaseUserApiRequest{ApiService: users})
+	users.EXPECT().CreateDatabaseUserExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusBadRequest},
+		atlasError(http.StatusBadRequest, "INVALID_ATTRIBUTE"))
+
+	req := handler.NewRequest("User", nil, handler.RequestContext{StackID: "stack"}, nil, nil, nil, nil)
+	event, err := resource.Create(req, nil, newModel())
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.True(t, store.deleted, "a failed Create isn't retried, the binding is removed too")
+}
+
+func TestDeleteClearsBinding(t *testing.T) {
+	store := &previousAttempt{value: "properties admin/user"}
+	newStore := idempotency.NewStore
+	idempotency.NewStore = func(*handler.Request) idempotency.Store { return store }
+	t.Cleanup(func() { idempotency.NewStore = newStore })
+	users := mockUsers(t)
+	users.EXPECT().DeleteDatabaseUser(mock.Anything, projectID, "admin", "user").Return(admin.DeleteDatabaseUserApiRequest{ApiService: users})
+	users.EXPECT().DeleteDatabaseUserExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusNoContent}, nil)
+
+	req := handler.NewRequest("User", nil, handler.RequestContext{StackID: "stack"}, nil, nil, nil, nil)
+	event, err := resource.Delete(req, nil, newModel())
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.True(t, store.deleted, "a new resource with the same logical ID must not resume the deleted user")
+}
+
+func TestCreateAdoptsExistingUser(t *testing.T) {
+	users := mockUsers(t)
+	users.EXPECT().CreateDatabaseUser(mock.Anything, projectID, mock.Anything).Return(admin.CreateDatabaseUserApiRequest{ApiService: users})
+	users.EXPECT().CreateDatabaseUserExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusConflict},
+		atlasError(http.StatusConflict, "USER_ALREADY_EXISTS"))
+	users.EXPECT().UpdateDatabaseUser(mock.Anything, projectID, "admin", "user", mock.Anything).
+		Return(admin.UpdateDatabaseUserApiRequest{ApiService: users})
+	users.EXPECT().UpdateDatabaseUserExecute(mock.Anything).Return(&admin.CloudDatabaseUser{}, &http.Response{StatusCode: http.StatusOK}, nil)
+
+	req := handler.NewRequest("", nil, handler.RequestContext{}, nil, nil, nil, json.RawMessage(`{"AdoptIfExists": true}`))
+	event, err := resource.Create(req, nil, newModel())
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, progressevent.AdoptedMessage("database user", "admin/user"), event.Message)
+}
+
+func TestReadNotFound(t *testing.T) {
+	users := mockUsers(t)
+	users.EXPECT().GetDatabaseUser(mock.Anything, projectID, "admin", "user").Return(admin.GetDatabaseUserApiRequest{ApiService: users})
+	users.EXPECT().GetDatabaseUserExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusNotFound},
+		atlasError(http.StatusNotFound, "USERNAME_NOT_FOUND"))
+
+	event, err := resource.Read(handler.Request{}, nil, newModel())
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, event.HandlerErrorCode)
+}
+
+func TestList(t *testing.T) {
+	users := mockUsers(t)
+	users.EXPECT().ListDatabaseUsersWithParams(mock.Anything, mock.MatchedBy(func(params *admin.ListDatabaseUsersApiParams) bool {
+		return params.GroupId == projectID
+	})).Return(admin.ListDatabaseUsersApiRequest{ApiService: users})
+	users.EXPECT().ListDatabaseUsersExecute(mock.Anything).Return(&admin.PaginatedApiAtlasDatabaseUser{
+		Results: &[]admin.CloudDatabaseUser{{
+			GroupId:      projectID,
+			DatabaseName: "admin",
+			Username:     "user",
+			Roles:        &[]admin.DatabaseUserRole{{DatabaseName: "admin", RoleName: "readAnyDatabase"}},
+			Labels:       &[]admin.ComponentLabel{{Key: admin.PtrString("env"), Value: admin.PtrString("test")}},
+		}},
+		TotalCount: admin.PtrInt(1),
+	}, &http.Response{StatusCode: http.StatusOK}, nil)
+
+	event, err := resource.List(handler.Request{}, nil, &resource.Model{ProjectId: aws.String(projectID)})
+	require.NoError(t, err)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.Len(t, event.ResourceModels, 1)
+	model := event.ResourceModels[0].(resource.Model)
+	assert.Equal(t, "user", aws.StringValue(model.Username))
+	assert.Equal(t, []resource.RoleDefinition{{DatabaseName: aws.String("admin"), RoleName: aws.String("readAnyDatabase")}}, model.Roles)
+	assert.Equal(t, []resource.LabelDefinition{{Key: aws.String("env"), Value: aws.String("test")}}, model.Labels)
+}
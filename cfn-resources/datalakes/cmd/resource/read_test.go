@@ -0,0 +1,72 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/datalakes/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/dataFederation/{tenantName}", admin.DataLakeTenant{
+		GroupId:   admin.PtrString("650000000000000000000001"),
+		Name:      admin.PtrString("tenant"),
+		State:     admin.PtrString("ACTIVE"),
+		Hostnames: []string{"tenant.a.query.mongodb.net"},
+		CloudProviderConfig: &admin.DataLakeCloudProviderConfig{Aws: admin.DataLakeAWSCloudProviderConfig{
+			RoleId:            "650000000000000000000002",
+			TestS3Bucket:      "bucket",
+			IamAssumedRoleARN: admin.PtrString("arn:aws:iam::123456789012:role/atlas"),
+			IamUserARN:        admin.PtrString("arn:aws:iam::123456789012:root"),
+			ExternalId:        admin.PtrString("external"),
+		}},
+		DataProcessRegion: &admin.DataLakeDataProcessRegion{CloudProvider: "AWS", Region: "VIRGINIA_USA"},
+		Storage: &admin.DataLakeStorage{
+			Databases: []admin.DataLakeDatabaseInstance{{
+				Name: admin.PtrString("database"),
+				Collections: []admin.DataLakeDatabaseCollection{{
+					Name: admin.PtrString("collection"),
+					DataSources: []admin.DataLakeDatabaseDataSourceSettings{{
+						StoreName:  admin.PtrString("store"),
+						Database:   admin.PtrString("database"),
+						Collection: admin.PtrString("collection"),
+					}},
+				}},
+			}},
+			Stores: []admin.DataLakeStoreSettings{{Name: admin.PtrString("store"), Provider: "atlas"}},
+		},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "datalakes",
+		Schema:         "../../mongodb-atlas-datalakes.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "TenantName": "tenant",
+				"CloudProviderConfig": {"Aws": {"RoleId": "650000000000000000000002",
+					"IamAssumedRoleARN": "arn:aws:iam::123456789012:role/atlas",
+					"IamUserARN": "arn:aws:iam::123456789012:root", "ExternalId": "external"}},
+				"DataProcessRegion": {"CloudProvider": "AWS", "Region": "VIRGINIA_USA"}}`,
+		}},
+	})
+}
@@ -17,14 +17,14 @@ package resource
 import (
 	"context"
 	"fmt"
-	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"github.com/spf13/cast"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
@@ -32,21 +32,31 @@ import (
 var RequiredFields = []string{constants.ProjectID, constants.TenantName}
 var ListRequiredFields = []string{constants.ProjectID}
 
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup() // logger setup
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-datalakes",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: RequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: RequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: RequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: RequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
+}
 
-	validationError := validateRequest(RequiredFields, currentModel)
-	if validationError != nil {
-		return *validationError, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if _, ok := req.CallbackContext["status"]; ok {
 		sid := req.CallbackContext["project_id"].(string)
 		currentModel.ProjectId = &sid
@@ -61,7 +71,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	dataLake, resp, err := client.Atlas20231115002.DataFederationApi.CreateFederatedDatabase(context.Background(), projectID, dataLakeReq).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 	currentModel.ProjectId = dataLake.GroupId
 	event := handler.ProgressEvent{
@@ -77,31 +87,12 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return event, nil
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup() // logger setup
-
-	if validationError := validateRequest(RequiredFields, currentModel); validationError != nil {
-		return *validationError, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	projectID := *currentModel.ProjectId
 	dataLake, resp, err := client.Atlas20231115002.DataFederationApi.GetFederatedDatabase(context.Background(), projectID, *currentModel.TenantName).Execute()
 	if err != nil {
-		if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusNotFound {
-			return handler.ProgressEvent{
-				OperationStatus:  handler.Failed,
-				Message:          "Resource Not Found",
-				HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
-		}
-
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	readModel := convertToModel(dataLake, currentModel)
@@ -112,21 +103,8 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup() // logger setup
-
-	// Validate required fields in the request
-	if validationError := validateRequest(RequiredFields, currentModel); validationError != nil {
-		return *validationError, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// update handles the Update event from the Cloudformation service.
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if !isExist(currentModel, client) {
 		return handler.ProgressEvent{
 			OperationStatus:  handler.Failed,
@@ -148,7 +126,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	dataLake, resp, err := client.Atlas20231115002.DataFederationApi.UpdateFederatedDatabaseWithParams(context.Background(), bodyRequest).Execute()
 	if err != nil {
 		if resp != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+			return progressevent.GetFailedEventByError(err, resp), nil
 		}
 
 		return handler.ProgressEvent{
@@ -166,21 +144,8 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup() // logger setup
-
-	// Validate required fields in the request
-	if validationError := validateRequest(RequiredFields, currentModel); validationError != nil {
-		return *validationError, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if !isExist(currentModel, client) {
 		return handler.ProgressEvent{
 			OperationStatus:  handler.Failed,
@@ -191,7 +156,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	_, resp, err := client.Atlas20231115002.DataFederationApi.DeleteFederatedDatabase(context.Background(), *currentModel.ProjectId, *currentModel.TenantName).Execute()
 	if err != nil {
 		if resp != nil {
-			return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+			return progressevent.GetFailedEventByError(err, resp), nil
 		}
 
 		return handler.ProgressEvent{
@@ -206,24 +171,11 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-// List  handles the list event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup() // logger setup
-
-	// Validate required fields in the request
-	if validationError := validateRequest(ListRequiredFields, currentModel); validationError != nil {
-		return *validationError, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// list  handles the list event from the Cloudformation service.
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	result, resp, err := client.Atlas20231115002.DataFederationApi.ListFederatedDatabases(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	var models []interface{}
@@ -288,9 +240,14 @@ func flattenDataLakeStorageDatabaseCollectionsDataSources(dataSources []admin.Da
 	database := make([]DataLakeDatabaseDataSourceView, 0)
 	for ind := range dataSources {
 		database = append(database, DataLakeDatabaseDataSourceView{
-			StoreName:     dataSources[ind].StoreName,
-			DefaultFormat: dataSources[ind].DefaultFormat,
-			Path:          dataSources[ind].Path,
+			AllowInsecure:   dataSources[ind].AllowInsecure,
+			Collection:      dataSources[ind].Collection,
+			CollectionRegex: dataSources[ind].CollectionRegex,
+			Database:        dataSources[ind].Database,
+			StoreName:       dataSources[ind].StoreName,
+			DefaultFormat:   dataSources[ind].DefaultFormat,
+			Path:            dataSources[ind].Path,
+			Urls:            dataSources[ind].Urls,
 		})
 	}
 	return database
@@ -355,16 +312,6 @@ func expandDataLakeDataProcessRegion(dataProcessRegion *DataLakeDataProcessRegio
 	return nil
 }
 
-// logger setup function
-func setup() {
-	util.SetupLogger("mongodb-atlas-data-lakes")
-}
-
-// function to validate inputs to all actions
-func validateRequest(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
-}
-
 // function to track snapshot creation status
 func validateProgress(client *util.MongoDBClient, currentModel *Model, targetState string) (handler.ProgressEvent, error) {
 	projectID := *currentModel.ProjectId
@@ -17,18 +17,18 @@ package resource
 import (
 	"context"
 	"crypto/rand"
-	"errors"
 	"math"
 	"math/big"
 	"strconv"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
@@ -39,25 +39,32 @@ var (
 	ReadAndDeleteRequiredFields   = []string{constants.ProjectID}
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-encryption-at-rest")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-encryption-at-rest",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateAndUpdateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadAndDeleteRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: CreateAndUpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: ReadAndDeleteRequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(CreateAndUpdateRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	_, resp, err := client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.UpdateEncryptionAtRest(context.Background(), *currentModel.ProjectId, currentModel.getParams()).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 	currentModel.Id = aws.String(strconv.FormatInt(randInt64(), 10))
 
@@ -68,31 +75,22 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(ReadAndDeleteRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	info, resp, err := client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.GetEncryptionAtRest(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	if pe := validateExist(info); pe != nil {
 		return *pe, nil
 	}
 
-	currentModel.AwsKmsConfig.CustomerMasterKeyID = info.AwsKms.CustomerMasterKeyID
-	currentModel.AwsKmsConfig.Enabled = info.AwsKms.Enabled
-	currentModel.AwsKmsConfig.RoleID = info.AwsKms.RoleId
-	currentModel.AwsKmsConfig.Region = info.AwsKms.Region
+	currentModel.AwsKmsConfig = &AwsKmsConfig{
+		CustomerMasterKeyID: info.AwsKms.CustomerMasterKeyID,
+		Enabled:             info.AwsKms.Enabled,
+		RoleID:              info.AwsKms.RoleId,
+		Region:              info.AwsKms.Region,
+	}
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -101,21 +99,10 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(CreateAndUpdateRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	info, resp, err := client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.GetEncryptionAtRest(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	if pe := validateExist(info); pe != nil {
@@ -124,7 +111,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	_, resp, err = client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.UpdateEncryptionAtRest(context.Background(), *currentModel.ProjectId, currentModel.getParams()).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -134,21 +121,10 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(ReadAndDeleteRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	info, resp, err := client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.GetEncryptionAtRest(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	if pe := validateExist(info); pe != nil {
@@ -160,7 +136,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 	_, resp, err = client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.UpdateEncryptionAtRest(context.Background(), *currentModel.ProjectId, params).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -169,10 +145,6 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-
 func validateExist(info *admin.EncryptionAtRest) *handler.ProgressEvent {
 	if info != nil && info.AwsKms != nil && aws.BoolValue(info.AwsKms.Enabled) {
 		return nil
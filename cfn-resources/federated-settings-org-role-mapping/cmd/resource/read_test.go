@@ -0,0 +1,51 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/federated-settings-org-role-mapping/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/federationSettings/{federationSettingsId}/connectedOrgConfigs/{orgId}/roleMappings/{id}", admin.AuthFederationRoleMapping{
+		Id:                admin.PtrString("650000000000000000000003"),
+		ExternalGroupName: "group",
+		RoleAssignments: []admin.RoleAssignment{
+			{OrgId: admin.PtrString("650000000000000000000002"), Role: admin.PtrString("ORG_MEMBER")},
+			{GroupId: admin.PtrString("650000000000000000000001"), Role: admin.PtrString("GROUP_READ_ONLY")},
+		},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "federated settings org role mapping",
+		Schema:         "../../mongodb-atlas-federatedsettingsorgrolemapping.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "FederationSettingsId": "650000000000000000000004", "OrgId": "650000000000000000000002",
+				"Id": "650000000000000000000003", "ExternalGroupName": "group", "RoleAssignments": [
+					{"OrgId": "650000000000000000000002", "Role": "ORG_MEMBER"},
+					{"ProjectId": "650000000000000000000001", "Role": "GROUP_READ_ONLY"}]}`,
+		}},
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/global-cluster-config/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	client, err := s.AtlasClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cluster, _, err := client.ClustersApi.CreateCluster(context.Background(), projectID, &admin.AdvancedClusterDescription{
+		Name:        admin.PtrString("cluster"),
+		ClusterType: admin.PtrString("GEOSHARDED"),
+		ReplicationSpecs: &[]admin.ReplicationSpec{
+			{ZoneName: admin.PtrString("Zone EU")},
+			{ZoneName: admin.PtrString("Zone US")},
+		},
+	}).Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	specs := cluster.GetReplicationSpecs()
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/clusters/{clusterName}/globalWrites", admin.GeoSharding{
+		CustomZoneMapping: &map[string]string{"US": specs[1].GetId(), "DE": specs[0].GetId()},
+		ManagedNamespaces: &[]admin.ManagedNamespaces{{
+			Db: "db", Collection: "coll", CustomShardKey: "key",
+			IsCustomShardKeyHashed: admin.PtrBool(false), IsShardKeyUnique: admin.PtrBool(false),
+		}},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "global cluster config",
+		Schema:         "../../mongodb-atlas-globalclusterconfig.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "` + projectID + `", "ClusterName": "cluster",
+				"ManagedNamespaces": [{"Db": "db", "Collection": "coll", "CustomShardKey": "key", "IsCustomShardKeyHashed": false, "IsShardKeyUnique": false}],
+				"CustomZoneMappings": [{"Location": "DE", "Zone": "Zone EU"}, {"Location": "US", "Zone": "Zone US"}]}`,
+		}},
+	})
+}
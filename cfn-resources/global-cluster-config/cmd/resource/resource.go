@@ -18,37 +18,43 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
+	"sort"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-global-cluster-config")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-global-cluster-config",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: RequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: RequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: RequiredFields},
 }
 
-var RequiredFields = []string{constants.ClusterName, constants.ProjectID}
-
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	if errValidation := validateModel(RequiredFields, currentModel); errValidation != nil {
-		return *errValidation, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+var RequiredFields = []string{constants.ClusterName, constants.ProjectID}
 
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	projectID := *currentModel.ProjectId
 	clusterName := *currentModel.ClusterName
 	nameSpaces := currentModel.ManagedNamespaces
@@ -78,19 +84,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return event, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errValidation := validateModel(RequiredFields, currentModel); errValidation != nil {
-		return *errValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	config, event, err := ReadConfig(client, currentModel)
 	if err != nil {
 		if config == nil {
@@ -112,26 +106,26 @@ func ReadConfig(client *util.MongoDBClient, currentModel *Model) (*Model, handle
 
 	globalCluster, resp, err := client.Atlas20231115002.GlobalClustersApi.GetManagedNamespace(context.Background(), projectID, clusterName).Execute()
 	if err != nil {
-		if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusNotFound {
-			return nil, handler.ProgressEvent{
-				OperationStatus:  handler.Failed,
-				Message:          err.Error(),
-				HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
-		}
-
-		return nil, progressevent.GetFailedEventByResponse(fmt.Sprintf("Failed to fetch managed namespace : %s", err.Error()),
-			resp), err
+		return nil, progressevent.GetFailedEventByError(err, resp), err
 	}
 
 	nameSpaces := globalCluster.ManagedNamespaces
-	zoneMappings := globalCluster.CustomZoneMapping
-	if len(nameSpaces) == 0 && len(*zoneMappings) == 0 {
+	zoneMappings := globalCluster.GetCustomZoneMapping()
+	if len(nameSpaces) == 0 && len(zoneMappings) == 0 {
 		return nil, handler.ProgressEvent{
 			OperationStatus:  handler.Failed,
 			Message:          "resource Not Found",
 			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, errors.New("resource not found")
 	}
 	readModel := newModel(globalCluster, currentModel)
+	if len(zoneMappings) > 0 {
+		// Atlas maps the locations to the IDs of the zones, the model to their names
+		cluster, resp, err := client.Atlas20231115002.ClustersApi.GetCluster(context.Background(), projectID, clusterName).Execute()
+		if err != nil {
+			return nil, progressevent.GetFailedEventByError(err, resp), err
+		}
+		readModel.CustomZoneMappings = flattenCustomZoneMappings(zoneMappings, cluster.GetReplicationSpecs())
+	}
 	return readModel, handler.ProgressEvent{}, nil
 }
 
@@ -140,13 +134,28 @@ func newModel(globalCluster *admin.GeoSharding, currentModel *Model) *Model {
 	readModel.ProjectId = currentModel.ProjectId
 	readModel.ClusterName = currentModel.ClusterName
 	maps := flattenManagedNamespaces(globalCluster.ManagedNamespaces)
-	readModel.CustomZoneMappings = currentModel.CustomZoneMappings
 	readModel.ManagedNamespaces = maps
 	readModel.Profile = currentModel.Profile
-	readModel.RemoveAllZoneMapping = currentModel.RemoveAllZoneMapping
 	return readModel
 }
 
+func flattenCustomZoneMappings(zoneMappings map[string]string, replicationSpecs []admin.ReplicationSpec) []ZoneMapping {
+	zoneNames := make(map[string]string, len(replicationSpecs))
+	for i := range replicationSpecs {
+		zoneNames[replicationSpecs[i].GetId()] = replicationSpecs[i].GetZoneName()
+	}
+	results := make([]ZoneMapping, 0, len(zoneMappings))
+	for location, zoneID := range zoneMappings {
+		results = append(results, ZoneMapping{
+			Location: util.StringPtr(location),
+			Zone:     util.StringPtr(zoneNames[zoneID]),
+		})
+	}
+	// the map has no order, every Read must return the same model
+	sort.Slice(results, func(i, j int) bool { return *results[i].Location < *results[j].Location })
+	return results
+}
+
 func flattenManagedNamespaces(managedNamespaces []admin.ManagedNamespaces) []ManagedNamespace {
 	var results []ManagedNamespace
 	for ind := range managedNamespaces {
@@ -162,27 +171,7 @@ func flattenManagedNamespaces(managedNamespaces []admin.ManagedNamespaces) []Man
 	return results
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	// No OP
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	// No OP
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if !isExist(client, currentModel) {
 		return progressevent.GetFailedEventByCode("Resource Not Found", cloudformation.HandlerErrorCodeNotFound), nil
 	}
@@ -221,11 +210,6 @@ func isExist(client *util.MongoDBClient, currentModel *Model) bool {
 	return err == nil && config != nil
 }
 
-// function to validate inputs to all actions
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
-}
-
 func removeManagedNamespaces(ctx context.Context, client *util.MongoDBClient, remove []ManagedNamespace, projectID, clusterName string) {
 	for _, m := range remove {
 		addManagedNamespace := &admin.DeleteManagedNamespaceApiParams{
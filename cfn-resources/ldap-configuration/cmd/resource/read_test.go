@@ -0,0 +1,54 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/ldap-configuration/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/userSecurity", admin.UserSecurity{
+		Ldap: &admin.LDAPSecuritySettings{
+			AuthenticationEnabled: admin.PtrBool(true),
+			AuthorizationEnabled:  admin.PtrBool(false),
+			BindUsername:          admin.PtrString("CN=admin,DC=example,DC=com"),
+			Hostname:              admin.PtrString("ldap.example.com"),
+			Port:                  admin.PtrInt(636),
+			UserToDNMapping: []admin.UserToDNMapping{
+				{Match: "(.+)@example.com", Substitution: admin.PtrString("CN={0},DC=example,DC=com")},
+			},
+		},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "ldap configuration",
+		Schema:         "../../mongodb-atlas-ldapconfiguration.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "AuthenticationEnabled": true, "AuthorizationEnabled": false,
+				"BindUsername": "CN=admin,DC=example,DC=com", "BindPassword": "password", "Hostname": "ldap.example.com", "Port": 636,
+				"UserToDNMapping": [{"Match": "(.+)@example.com", "Substitution": "CN={0},DC=example,DC=com"}]}`,
+		}},
+	})
+}
@@ -16,15 +16,15 @@ package resource
 
 import (
 	"context"
-	"errors"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
@@ -43,24 +43,33 @@ var UpdateRequiredFields = []string{constants.ProjectID}
 var DeleteRequiredFields = []string{constants.ProjectID}
 var ListRequiredFields []string
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-ldap-configuration")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-ldap-configuration",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(CreateRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
+
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	ctx := context.Background()
 	ldapConf, resp, err := client.Atlas20231115002.LDAPConfigurationApi.GetLDAPConfiguration(ctx, *currentModel.ProjectId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	if isResourceEnabled(ldapConf) {
@@ -73,7 +82,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	LDAPConfigResponse, resp, err := client.Atlas20231115002.LDAPConfigurationApi.SaveLDAPConfiguration(ctx, *currentModel.ProjectId, ldapReq).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.CompleteByResponse(*LDAPConfigResponse)
@@ -85,19 +94,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if err := validator.ValidateModel(ReadRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	ldapConf, pe := get(client, *currentModel.ProjectId)
 	if pe != nil {
 		return *pe, nil
@@ -111,19 +108,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if err := validator.ValidateModel(UpdateRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if _, pe := get(client, *currentModel.ProjectId); pe != nil {
 		return *pe, nil
 	}
@@ -133,7 +118,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	ctx := context.Background()
 	LDAPConfigResponse, resp, err := client.Atlas20231115002.LDAPConfigurationApi.SaveLDAPConfiguration(ctx, *currentModel.ProjectId, ldapReq).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.CompleteByResponse(*LDAPConfigResponse)
@@ -144,19 +129,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if err := validator.ValidateModel(DeleteRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if _, pe := get(client, *currentModel.ProjectId); pe != nil {
 		return *pe, nil
 	}
@@ -168,7 +141,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	ctx := context.Background()
 	_, resp, err := client.Atlas20231115002.LDAPConfigurationApi.SaveLDAPConfiguration(ctx, *currentModel.ProjectId, ldapReq).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -177,13 +150,14 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-
 func (m *Model) CompleteByResponse(resp admin.UserSecurity) {
 	m.AuthenticationEnabled = resp.Ldap.AuthenticationEnabled
 	m.AuthorizationEnabled = resp.Ldap.AuthorizationEnabled
+	m.AuthzQueryTemplate = resp.Ldap.AuthzQueryTemplate
+	m.BindUsername = resp.Ldap.BindUsername
+	m.CaCertificate = resp.Ldap.CaCertificate
+	m.Hostname = resp.Ldap.Hostname
+	m.Port = resp.Ldap.Port
 
 	mappings := make([]ApiAtlasNDSUserToDNMappingView, len(resp.Ldap.UserToDNMapping))
 
@@ -201,7 +175,7 @@ func get(client *util.MongoDBClient, groupID string) (*admin.UserSecurity, *hand
 	ctx := context.Background()
 	ldapConf, resp, err := client.Atlas20231115002.LDAPConfigurationApi.GetLDAPConfiguration(ctx, groupID).Execute()
 	if err != nil {
-		errPe := progressevent.GetFailedEventByResponse(err.Error(), resp)
+		errPe := progressevent.GetFailedEventByError(err, resp)
 		return nil, &errPe
 	}
 
@@ -16,14 +16,15 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"time"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
@@ -37,25 +38,37 @@ var CreateRequiredFields = []string{constants.ProjectID, BindUsername, BindPassw
 var ReadRequiredFields = []string{constants.ProjectID, RequestID}
 var DeleteRequiredFields = []string{constants.ProjectID, RequestID}
 
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
+var verifyPoller = progressevent.Poller{
+	Resource:        "LDAP verification",
+	Message:         "Create in progress",
+	TargetStates:    []string{"SUCCESS"},
+	FailureStates:   []string{"FAILED"},
+	Timeout:         15 * time.Minute,
+	MaxDelaySeconds: 30,
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-ldap-verify")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-ldap-verify",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	if err := validateModel(CreateRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
+
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if req.CallbackContext != nil {
 		return validateProgress(client, currentModel, req), nil
 	}
@@ -63,7 +76,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	params := currentModel.GetAtlasParams()
 	ldapResponse, resp, err := client.Atlas20231115002.LDAPConfigurationApi.VerifyLDAPConfiguration(context.Background(), *currentModel.ProjectId, params).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.CompleteByResponse(ldapResponse)
@@ -79,22 +92,10 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if err := validateModel(ReadRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	ldapResponse, resp, err := client.Atlas20231115002.LDAPConfigurationApi.GetLDAPConfigurationStatus(context.Background(), *currentModel.ProjectId, *currentModel.RequestId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.CompleteByResponse(ldapResponse)
@@ -105,26 +106,10 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if err := validateModel(DeleteRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	_, resp, err := client.Atlas20231115002.LDAPConfigurationApi.GetLDAPConfigurationStatus(context.Background(), *currentModel.ProjectId, *currentModel.RequestId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error deleting resource : %s", err.Error()), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	params := &admin.LDAPVerifyConnectivityJobRequestParams{
@@ -134,7 +119,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		BindUsername: "-",
 	}
 	if _, resp, err := client.Atlas20231115002.LDAPConfigurationApi.VerifyLDAPConfiguration(context.Background(), *currentModel.ProjectId, params).Execute(); err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -143,10 +128,6 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-
 func (m *Model) GetAtlasParams() *admin.LDAPVerifyConnectivityJobRequestParams {
 	ldap := admin.LDAPVerifyConnectivityJobRequestParams{
 		Hostname:     *m.HostName,
@@ -181,6 +162,14 @@ func (m *Model) CompleteByResponse(resp *admin.LDAPVerifyConnectivityJobRequest)
 
 	m.Validations = mapping
 	m.Status = resp.Status
+
+	if resp.Request != nil {
+		m.AuthzQueryTemplate = resp.Request.AuthzQueryTemplate
+		m.BindUsername = &resp.Request.BindUsername
+		m.CaCertificate = resp.Request.CaCertificate
+		m.HostName = &resp.Request.Hostname
+		m.Port = &resp.Request.Port
+	}
 }
 
 func validateProgress(client *util.MongoDBClient, model *Model, req handler.Request) handler.ProgressEvent {
@@ -188,32 +177,18 @@ func validateProgress(client *util.MongoDBClient, model *Model, req handler.Requ
 
 	ldapResponse, resp, err := client.Atlas20231115002.LDAPConfigurationApi.GetLDAPConfigurationStatus(context.Background(), *model.ProjectId, requestID).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp)
+		return progressevent.GetFailedEventByError(err, resp)
 	}
 
-	switch *ldapResponse.Status {
-	case "PENDING":
-		return handler.ProgressEvent{
-			OperationStatus: handler.InProgress,
-			Message:         "Create in progress",
-			ResourceModel:   model,
-			CallbackContext: map[string]interface{}{
-				"RequestId": requestID,
-			},
-		}
-	case "SUCCESS":
+	state := progressevent.PollState{
+		Name:           util.SafeString(ldapResponse.Status),
+		Model:          model,
+		FailureMessage: getFailedMessage(ldapResponse),
+	}
+	if state.Name == "SUCCESS" {
 		model.CompleteByResponse(ldapResponse)
-		return handler.ProgressEvent{
-			OperationStatus: handler.Success,
-			Message:         "Create successfully",
-			ResourceModel:   model,
-		}
-	default:
-		return handler.ProgressEvent{
-			OperationStatus: handler.Failed,
-			Message:         getFailedMessage(ldapResponse),
-		}
 	}
+	return verifyPoller.Poll(req.CallbackContext, state)
 }
 
 func getFailedMessage(configuration *admin.LDAPVerifyConnectivityJobRequest) string {
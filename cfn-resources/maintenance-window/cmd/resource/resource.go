@@ -16,41 +16,43 @@ package resource
 
 import (
 	"context"
-	"errors"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
 var RequiredFields = []string{constants.ProjectID}
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-maintenance-window")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-maintenance-window",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: RequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: RequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: RequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteWindow, RequiredFields: RequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	if err := validator.ValidateModel(RequiredFields, currentModel); err != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *err, nil
-	}
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	maintenanceWindow, _ := get(client, *currentModel)
 	if maintenanceWindow != nil {
 		return progress_events.GetFailedEventByCode("resource already exists", cloudformation.HandlerErrorCodeAlreadyExists), nil
@@ -62,7 +64,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	_, resp, err := client.Atlas20231115002.MaintenanceWindowsApi.UpdateMaintenanceWindow(context.Background(), *currentModel.ProjectId, &atlasModel).Execute()
 	if err != nil {
-		return progress_events.GetFailedEventByResponse(err.Error(), resp), nil
+		return progress_events.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -71,20 +73,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	if err := validator.ValidateModel(RequiredFields, currentModel); err != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *err, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	maintenanceWindow, errorProgressEvent := get(client, *currentModel)
 	if errorProgressEvent != nil {
 		return *errorProgressEvent, nil
@@ -101,20 +90,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	if err := validator.ValidateModel(RequiredFields, currentModel); err != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *err, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	_, handlerError := get(client, *currentModel)
 	if handlerError != nil {
 		return *handlerError, nil
@@ -126,7 +102,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	_, resp, err := client.Atlas20231115002.MaintenanceWindowsApi.UpdateMaintenanceWindow(context.Background(), *currentModel.ProjectId, &atlasModel).Execute()
 	if err != nil {
-		return progress_events.GetFailedEventByResponse(err.Error(), resp), nil
+		return progress_events.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -135,20 +111,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	if err := validator.ValidateModel(RequiredFields, currentModel); err != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *err, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+func deleteWindow(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	_, handlerError := get(client, *currentModel)
 	if handlerError != nil {
 		return *handlerError, nil
@@ -156,7 +119,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	resp, err := client.Atlas20231115002.MaintenanceWindowsApi.ResetMaintenanceWindow(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
-		return progress_events.GetFailedEventByResponse(err.Error(), resp), nil
+		return progress_events.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -165,10 +128,6 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-
 func (m Model) toAtlasModel() admin.GroupMaintenanceWindow {
 	return admin.GroupMaintenanceWindow{
 		DayOfWeek:            *m.DayOfWeek,
@@ -182,7 +141,7 @@ func get(client *util.MongoDBClient, currentModel Model) (*admin.GroupMaintenanc
 	maintenanceWindow, resp, err := client.Atlas20231115002.MaintenanceWindowsApi.GetMaintenanceWindow(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
 		_, _ = logger.Warnf("Read - error: %+v", err)
-		ev := progress_events.GetFailedEventByResponse(err.Error(), resp)
+		ev := progress_events.GetFailedEventByError(err, resp)
 		return nil, &ev
 	}
 
@@ -32,8 +32,7 @@ import (
 
 var createRequiredFields = []string{constants.ProjectID, constants.RegionName, constants.AtlasCIDRBlock}
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if err := validateCreateModel(createRequiredFields, currentModel); err != nil {
 		return handler.ProgressEvent{
 			OperationStatus: handler.Failed,
@@ -42,12 +41,6 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		}, err
 	}
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	containerRequest := &admin.CloudProviderContainer{
 		ProviderName:   admin.PtrString(constants.AWS),
 		RegionName:     currentModel.RegionName,
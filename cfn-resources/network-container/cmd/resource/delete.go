@@ -30,28 +30,14 @@ import (
 
 var deleteRequiredFields = []string{constants.ProjectID, constants.ID}
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	_, _ = logger.Debugf("Delete currentModel:%+v", currentModel)
-
-	if errEvent := validateModel(deleteRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	_, _ = logger.Debugf("Delete currentModel:%+v", currentModel)
 	projectID := *currentModel.ProjectId
 	containerID := *currentModel.Id
 
 	containerResponse, response, err := client.Atlas20231115002.NetworkPeeringApi.GetPeeringContainer(context.Background(), projectID, containerID).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource: %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 
 	if containerResponse != nil && containerResponse.Provisioned != nil && *containerResponse.Provisioned {
@@ -75,8 +61,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 func retryDeleteIfRequired(client *util.MongoDBClient, response *http.Response, err error, projectID, containerID string) (handler.ProgressEvent, error) {
 	if response.StatusCode != 409 {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource: %s", err.Error()),
-			response), err
+		return progressevent.GetFailedEventByError(err, response), err
 	}
 
 	// handling "CANNOT_DELETE_RECENTLY_CREATED_CONTAINER" error during release process:
@@ -99,6 +84,5 @@ func retryDeleteIfRequired(client *util.MongoDBClient, response *http.Response,
 		}, nil
 	}
 
-	return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource: %s", errSecondCall.Error()),
-		response), errSecondCall
+	return progressevent.GetFailedEventByError(errSecondCall, response), errSecondCall
 }
@@ -16,7 +16,7 @@ package resource
 
 import (
 	"context"
-	"log"
+	"net/http"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
@@ -27,38 +27,29 @@ import (
 
 var listRequiredFields = []string{constants.ProjectID}
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	_, _ = logger.Debugf("List currentModel:%+v", currentModel)
-	log.SetFlags(log.LstdFlags | log.Lshortfile)
-
-	_, _ = logger.Debugf("List currentModel:%+v", currentModel)
-
-	if errEvent := validateModel(listRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	aws := constants.AWS
+	containers, nextToken, peErr := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.CloudProviderContainer, int, *http.Response, error) {
+		containerRequest := &admin.ListPeeringContainerByCloudProviderApiParams{
+			ProviderName: &aws,
+			GroupId:      *currentModel.ProjectId,
+			IncludeCount: admin.PtrBool(true),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+		}
+		_, _ = logger.Debugf("List - containerRequest:%v", containerRequest)
+		containerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.ListPeeringContainerByCloudProviderWithParams(context.Background(), containerRequest).Execute()
+		if err != nil {
+			_, _ = logger.Warnf("Error %v", err)
+			return nil, 0, resp, err
+		}
+		_, _ = logger.Debugf("containerResponse:%v", containerResponse)
+		return containerResponse.GetResults(), containerResponse.GetTotalCount(), resp, nil
+	})
 	if peErr != nil {
 		return *peErr, nil
 	}
 
-	aws := constants.AWS
-	containerRequest := &admin.ListPeeringContainerByCloudProviderApiParams{
-		ProviderName: &aws,
-		GroupId:      *currentModel.ProjectId,
-	}
-	_, _ = logger.Debugf("List - containerRequest:%v", containerRequest)
-	containerResponse, _, err := client.Atlas20231115002.NetworkPeeringApi.ListPeeringContainerByCloudProviderWithParams(context.Background(), containerRequest).Execute()
-	if err != nil {
-		_, _ = logger.Warnf("Error %v", err)
-		return handler.ProgressEvent{}, err
-	}
-
-	_, _ = logger.Debugf("containerResponse:%v", containerResponse)
-
-	containers := containerResponse.Results
 	mm := make([]interface{}, 0)
 	for i := range containers {
 		mm = append(mm, completeByConnection(&containers[i], *currentModel.ProjectId, *currentModel.Profile))
@@ -68,6 +59,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "List Complete",
 		ResourceModels:  mm,
+		NextToken:       nextToken,
 	}, nil
 }
 
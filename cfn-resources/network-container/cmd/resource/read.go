@@ -16,7 +16,6 @@ package resource
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
@@ -26,25 +25,13 @@ import (
 
 var readRequiredFields = []string{constants.ProjectID, constants.ID}
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	if errEvent := validateModel(readRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	projectID := *currentModel.ProjectId
 	containerID := *currentModel.Id
 
 	containerResponse, response, err := client.Atlas20231115002.NetworkPeeringApi.GetPeeringContainer(context.Background(), projectID, containerID).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 
 	currentModel.RegionName = containerResponse.RegionName
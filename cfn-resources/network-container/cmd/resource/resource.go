@@ -15,15 +15,30 @@
 package resource
 
 import (
-	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-network-container")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-network-container",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: readRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: updateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: deleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: listRequiredFields},
 }
 
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
@@ -16,7 +16,6 @@ package resource
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
@@ -28,18 +27,7 @@ import (
 
 var updateRequiredFields = []string{constants.ProjectID, constants.ID}
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	if errEvent := validateModel(updateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	projectID := *currentModel.ProjectId
 	containerID := *currentModel.Id
 	containerRequest := &admin.CloudProviderContainer{}
@@ -53,8 +41,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	containerRequest.RegionName = currentModel.RegionName
 	containerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.UpdatePeeringContainer(context.Background(), projectID, containerID, containerRequest).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.Id = containerResponse.Id
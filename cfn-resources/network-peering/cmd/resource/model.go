@@ -4,15 +4,23 @@ package resource
 
 // Model is autogenerated from the json schema
 type Model struct {
-	ProjectId           *string `json:",omitempty"`
-	ContainerId         *string `json:",omitempty"`
-	AccepterRegionName  *string `json:",omitempty"`
-	AwsAccountId        *string `json:",omitempty"`
-	RouteTableCIDRBlock *string `json:",omitempty"`
-	VpcId               *string `json:",omitempty"`
-	ConnectionId        *string `json:",omitempty"`
-	ErrorStateName      *string `json:",omitempty"`
-	StatusName          *string `json:",omitempty"`
-	Id                  *string `json:",omitempty"`
-	Profile             *string `json:",omitempty"`
+	ProjectId           *string         `json:",omitempty"`
+	ContainerId         *string         `json:",omitempty"`
+	AccepterRegionName  *string         `json:",omitempty"`
+	AwsAccountId        *string         `json:",omitempty"`
+	RouteTableCIDRBlock *string         `json:",omitempty"`
+	VpcId               *string         `json:",omitempty"`
+	ConnectionId        *string         `json:",omitempty"`
+	ErrorStateName      *string         `json:",omitempty"`
+	StatusName          *string         `json:",omitempty"`
+	Id                  *string         `json:",omitempty"`
+	Profile             *string         `json:",omitempty"`
+	TimeoutOptions      *TimeoutOptions `json:",omitempty"`
+}
+
+// TimeoutOptions is autogenerated from the json schema
+type TimeoutOptions struct {
+	TimeOutInSeconds       *int  `json:",omitempty"`
+	CallbackDelaySeconds   *int  `json:",omitempty"`
+	ReturnSuccessIfTimeOut *bool `json:",omitempty"`
 }
@@ -0,0 +1,53 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/network-peering/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/peers/{peerId}", admin.BaseNetworkPeeringConnectionSettings{
+		Id:                  admin.PtrString("650000000000000000000003"),
+		ContainerId:         "650000000000000000000002",
+		ProviderName:        admin.PtrString("AWS"),
+		AccepterRegionName:  admin.PtrString("us-east-1"),
+		AwsAccountId:        admin.PtrString("123456789012"),
+		RouteTableCidrBlock: admin.PtrString("10.0.0.0/16"),
+		VpcId:               admin.PtrString("vpc-0123456789abcdef0"),
+		ConnectionId:        admin.PtrString("pcx-0123456789abcdef0"),
+		StatusName:          admin.PtrString("AVAILABLE"),
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "network peering",
+		Schema:         "../../mongodb-atlas-networkpeering.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "Id": "650000000000000000000003",
+				"ContainerId": "650000000000000000000002", "AccepterRegionName": "us-east-1", "AwsAccountId": "123456789012",
+				"RouteTableCIDRBlock": "10.0.0.0/16", "VpcId": "vpc-0123456789abcdef0"}`,
+		}},
+	})
+}
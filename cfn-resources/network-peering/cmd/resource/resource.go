@@ -16,22 +16,42 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-network-peering")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-network-peering",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
+}
+
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
 const (
@@ -52,6 +72,26 @@ const (
 // and allows
 // AtlasCIDRBlock  - defaults to: "172.31.0.0/21"
 
+var (
+	creationPoller = progressevent.Poller{
+		Resource:        "Network peering",
+		Message:         "Creating",
+		TargetStates:    []string{StatusPendingAcceptance, StatusAvailable},
+		FailureStates:   []string{StatusFailed, StatusDeleted},
+		Timeout:         30 * time.Minute,
+		MinDelaySeconds: 5,
+		MaxDelaySeconds: 30,
+	}
+	deletionPoller = progressevent.Poller{
+		Resource:        "Network peering",
+		Message:         "Deleting",
+		TargetStates:    []string{StatusDeleted},
+		Timeout:         30 * time.Minute,
+		MinDelaySeconds: 5,
+		MaxDelaySeconds: 30,
+	}
+)
+
 var (
 	DefaultAWSCIDR             = "172.31.0.0/21"
 	DefaultRouteTableCIDRBlock = "10.0.0.0/24"
@@ -67,28 +107,11 @@ var UpdateRequiredFields = []string{constants.ProjectID, constants.AccepterRegio
 var DeleteRequiredFields = []string{constants.ProjectID, constants.ID}
 var ListRequiredFields = []string{constants.ProjectID}
 
-// validateModel to validate inputs to all actions
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
-}
-
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validateModel(CreateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if _, ok := req.CallbackContext["stateName"]; ok {
 		currentModel.Id = aws.String(req.CallbackContext["id"].(string))
-		return validateCreationProcess(client, currentModel), nil
+		return validateCreationProcess(client, currentModel, &req), nil
 	}
 
 	projectID := *currentModel.ProjectId
@@ -108,8 +131,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.CreatePeeringConnection(context.Background(), projectID, &peerRequest).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(),
-			resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.Id = peerResponse.Id
@@ -123,40 +145,25 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	), nil
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	if errEvent := validateModel(ReadRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	projectID := *currentModel.ProjectId
 	peerID := *currentModel.Id
 
 	peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.GetPeeringConnection(context.Background(), projectID, peerID).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(),
-			resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
+	currentModel.AccepterRegionName = peerResponse.AccepterRegionName
 	currentModel.AwsAccountId = peerResponse.AwsAccountId
+	currentModel.ContainerId = &peerResponse.ContainerId
 	currentModel.RouteTableCIDRBlock = peerResponse.RouteTableCidrBlock
 	currentModel.VpcId = peerResponse.VpcId
 	currentModel.Id = peerResponse.Id
 	currentModel.ConnectionId = peerResponse.ConnectionId
 	currentModel.ErrorStateName = peerResponse.ErrorStateName
-	if currentModel.ErrorStateName != nil {
-		currentModel.ErrorStateName = peerResponse.ErrorStateName
-	}
-	if currentModel.StatusName != nil {
-		currentModel.StatusName = peerResponse.StatusName
-	}
+	currentModel.StatusName = peerResponse.StatusName
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -165,15 +172,8 @@ func Read(req handler.Request, prevModel, currentModel *Model) (handler.Progress
 	}, nil
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// update handles the Update event from the Cloudformation service.
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	projectID := *currentModel.ProjectId
 	if currentModel.Id == nil || *currentModel.Profile == "" {
 		return handler.ProgressEvent{
@@ -205,7 +205,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	peerRequest.ContainerId = *currentModel.ContainerId
 	peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.UpdatePeeringConnection(context.Background(), projectID, peerID, &peerRequest).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.Id = peerResponse.Id
@@ -216,29 +216,17 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	if errEvent := validateModel(DeleteRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if _, ok := req.CallbackContext["stateName"]; ok {
-		return validateDeletionProcess(client, currentModel), nil
+		return validateDeletionProcess(client, currentModel, &req), nil
 	}
 
 	projectID := *currentModel.ProjectId
 	peerID := *currentModel.Id
 	_, resp, err := client.Atlas20231115002.NetworkPeeringApi.DeletePeeringConnection(context.Background(), projectID, peerID).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(),
-			resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	return progressevent.GetInProgressProgressEvent("Deleting",
@@ -250,27 +238,26 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	), nil
 }
 
-// List handles the List event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	if errEvent := validateModel(ListRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+// list handles the List event from the Cloudformation service.
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	projectID := *currentModel.ProjectId
+	networkPeeringConnections, nextToken, peErr := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.BaseNetworkPeeringConnectionSettings, int, *http.Response, error) {
+		peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.ListPeeringConnectionsWithParams(context.Background(), &admin.ListPeeringConnectionsApiParams{
+			GroupId:      projectID,
+			IncludeCount: admin.PtrBool(true),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+		}).Execute()
+		if err != nil {
+			return nil, 0, resp, err
+		}
+		return peerResponse.GetResults(), peerResponse.GetTotalCount(), resp, nil
+	})
 	if peErr != nil {
 		return *peErr, nil
 	}
 
-	projectID := *currentModel.ProjectId
-	peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.ListPeeringConnections(context.Background(), projectID).Execute()
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
-	}
-
 	models := make([]interface{}, 0)
-	networkPeeringConnections := peerResponse.Results
 	for i := range networkPeeringConnections {
 		var model Model
 		model.AccepterRegionName = networkPeeringConnections[i].AccepterRegionName
@@ -288,75 +275,47 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "List Complete",
 		ResourceModels:  models,
+		NextToken:       nextToken,
 	}, nil
 }
 
-func validateDeletionProcess(client *util.MongoDBClient, currentModel *Model) handler.ProgressEvent {
+func validateDeletionProcess(client *util.MongoDBClient, currentModel *Model, req *handler.Request) handler.ProgressEvent {
 	state, err := getStatus(client, *currentModel.ProjectId, *currentModel.Id)
 	if err != nil {
-		return progressevent.GetFailedEventByCode(err.Error(), cloudformation.HandlerErrorCodeInvalidRequest)
+		return progressevent.GetFailedEventByError(err, nil)
 	}
-
-	if state == StatusDeleted {
-		return handler.ProgressEvent{
-			OperationStatus: handler.Success,
-			Message:         "Complete",
-		}
+	if state.Name != StatusDeleted {
+		state.Model = currentModel
 	}
-
-	return progressevent.GetInProgressProgressEvent("Deleting",
-		map[string]interface{}{
-			"stateName": state,
-		},
-		currentModel,
-		5,
-	)
+	return deletionPoller.WithOptions(timeoutOptions(req, currentModel)...).Poll(req.CallbackContext, state)
 }
 
-func validateCreationProcess(client *util.MongoDBClient, currentModel *Model) handler.ProgressEvent {
+func validateCreationProcess(client *util.MongoDBClient, currentModel *Model, req *handler.Request) handler.ProgressEvent {
 	state, err := getStatus(client, *currentModel.ProjectId, *currentModel.Id)
 	if err != nil {
-		return progressevent.GetFailedEventByCode(err.Error(), cloudformation.HandlerErrorCodeInvalidRequest)
-	}
-	if state == StatusFailed {
-		return progressevent.GetFailedEventByCode("Creation failed", cloudformation.HandlerErrorCodeInternalFailure)
+		return progressevent.GetFailedEventByError(err, nil)
 	}
-
-	if state == StatusPendingAcceptance || state == StatusAvailable {
-		return handler.ProgressEvent{
-			OperationStatus: handler.Success,
-			Message:         "Complete",
-			ResourceModel:   currentModel,
-		}
-	}
-
-	return progressevent.GetInProgressProgressEvent("Creating",
-		map[string]interface{}{
-			"stateName": state,
-			"id":        &currentModel.Id,
-		},
-		currentModel,
-		5,
-	)
+	state.Model = currentModel
+	return creationPoller.WithOptions(timeoutOptions(req, currentModel)...).Poll(req.CallbackContext, state)
 }
 
-func getStatus(client *util.MongoDBClient, projectID, peerID string) (statusName string, err error) {
+func getStatus(client *util.MongoDBClient, projectID, peerID string) (progressevent.PollState, error) {
 	peerResponse, _, err := client.Atlas20231115002.NetworkPeeringApi.GetPeeringConnection(context.Background(), projectID, peerID).Execute()
 	if err != nil {
 		if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusNotFound {
-			return StatusDeleted, nil
+			return progressevent.PollState{Name: StatusDeleted}, nil
 		}
 
-		return "", err
-	}
-
-	if util.IsStringPresent(peerResponse.ErrorStateName) {
-		err = errors.New(*peerResponse.ErrorStateName)
+		return progressevent.PollState{}, err
 	}
 
-	if util.IsStringPresent(peerResponse.StatusName) {
-		statusName = *peerResponse.StatusName
-	}
+	return progressevent.PollState{
+		Name:           util.SafeString(peerResponse.StatusName),
+		FailureMessage: util.SafeString(peerResponse.ErrorStateName),
+	}, nil
+}
 
-	return
+// timeoutOptions returns the TimeoutOptions of the model, then the ones of the type configuration.
+func timeoutOptions(req *handler.Request, currentModel *Model) []*progressevent.TimeoutOptions {
+	return []*progressevent.TimeoutOptions{(*progressevent.TimeoutOptions)(currentModel.TimeoutOptions), progressevent.TypeTimeoutOptions(req)}
 }
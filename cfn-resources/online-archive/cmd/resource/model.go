@@ -19,6 +19,7 @@ type Model struct {
 	Schedule        *ScheduleView        `json:",omitempty"`
 	State           *string              `json:",omitempty"`
 	TotalCount      *float64             `json:",omitempty"`
+	TimeoutOptions  *TimeoutOptions      `json:",omitempty"`
 }
 
 // CriteriaView is autogenerated from the json schema
@@ -46,3 +47,10 @@ type ScheduleView struct {
 	DayOfMonth  *int    `json:",omitempty"`
 	DayOfWeek   *int    `json:",omitempty"`
 }
+
+// TimeoutOptions is autogenerated from the json schema
+type TimeoutOptions struct {
+	TimeOutInSeconds       *int  `json:",omitempty"`
+	CallbackDelaySeconds   *int  `json:",omitempty"`
+	ReturnSuccessIfTimeOut *bool `json:",omitempty"`
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/online-archive/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/clusters/{clusterName}/onlineArchives/{archiveId}", admin.BackupOnlineArchive{
+		Id:             admin.PtrString("650000000000000000000002"),
+		GroupId:        admin.PtrString("650000000000000000000001"),
+		ClusterName:    admin.PtrString("cluster"),
+		DbName:         admin.PtrString("store"),
+		CollName:       admin.PtrString("orders"),
+		CollectionType: admin.PtrString("STANDARD"),
+		State:          admin.PtrString("ACTIVE"),
+		Criteria: &admin.Criteria{
+			Type:            admin.PtrString("DATE"),
+			DateField:       admin.PtrString("created"),
+			DateFormat:      admin.PtrString("ISODATE"),
+			ExpireAfterDays: admin.PtrInt(30),
+		},
+		Schedule: &admin.OnlineArchiveSchedule{Type: "DAILY", StartHour: admin.PtrInt(1), EndHour: admin.PtrInt(3),
+			StartMinute: admin.PtrInt(0), EndMinute: admin.PtrInt(30)},
+		PartitionFields: &[]admin.PartitionField{{FieldName: "created", Order: 0}, {FieldName: "customer", Order: 1}},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "online archive",
+		Schema:         "../../mongodb-atlas-onlinearchive.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "ClusterName": "cluster",
+				"ArchiveId": "650000000000000000000002", "DbName": "store", "CollName": "orders", "CollectionType": "STANDARD",
+				"Criteria": {"Type": "DATE", "DateField": "created", "DateFormat": "ISODATE", "ExpireAfterDays": 30},
+				"Schedule": {"Type": "DAILY", "StartHour": 1, "EndHour": 3, "StartMinute": 0, "EndMinute": 30},
+				"PartitionFields": [{"FieldName": "created", "Order": 0}, {"FieldName": "customer", "Order": 1}]}`,
+		}},
+	})
+}
@@ -17,12 +17,15 @@ package resource
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"github.com/spf13/cast"
@@ -40,26 +43,53 @@ var requiredCriteriaType = map[string][]string{
 	"CUSTOM": {"Query"},
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-online-archive")
-}
+const stateDeleted = "DELETED"
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(CreateRequiredFields, currentModel); err != nil {
-		return *err, nil
+var (
+	createPoller = progressevent.Poller{
+		Resource:      "Online archive",
+		TargetStates:  []string{"ACTIVE", "PAUSING", "PAUSED"},
+		FailureStates: []string{"ORPHANED", stateDeleted},
+		Timeout:       30 * time.Minute,
 	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
+	deletePoller = progressevent.Poller{
+		Resource:     "Online archive",
+		TargetStates: []string{stateDeleted},
+		Timeout:      30 * time.Minute,
 	}
+)
+
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-online-archive",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	// a missing ArchiveId is reported as not found before the other fields are validated
+	Update: handlerkit.Operation[Model]{Run: update},
+	Delete: handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:   handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
+}
+
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
+
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	ctx := context.Background()
 	archiveID, iOK := req.CallbackContext["id"]
 	if _, ok := req.CallbackContext["stateName"]; ok && iOK {
 		id := cast.ToString(archiveID)
 		currentModel.ArchiveId = &id
-		return validateProgress(ctx, client, currentModel, "PENDING")
+		return validateProgress(ctx, client, currentModel, &req, &createPoller)
 	}
 
 	params, errHandler := newCreateParams(currentModel)
@@ -68,7 +98,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 	outputRequest, resp, err := client.Atlas20231115014.OnlineArchiveApi.CreateOnlineArchive(ctx, *currentModel.ProjectId, *currentModel.ClusterName, &params).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 	currentModel.ArchiveId = outputRequest.Id
 	currentModel.Criteria.ExpireAfterDays = outputRequest.Criteria.ExpireAfterDays
@@ -86,30 +116,19 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.ArchiveId == nil {
 		return handler.ProgressEvent{
 			OperationStatus:  handler.Failed,
 			Message:          "no Id found in currentModel",
 			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
 	}
-	if err := validator.ValidateModel(ReadRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
 
 	olArchive, resp, err := client.Atlas20231115014.OnlineArchiveApi.GetOnlineArchive(context.Background(), *currentModel.ProjectId, *currentModel.ArchiveId, *currentModel.ClusterName).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
-	currentModel.ArchiveId = olArchive.Id
-	currentModel.State = olArchive.State
-	currentModel.ProjectId = olArchive.GroupId
+	setArchiveData(currentModel, olArchive)
 	currentModel.TotalCount = aws.Float64(1)
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -118,9 +137,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.ArchiveId == nil {
 		return handler.ProgressEvent{
 			OperationStatus:  handler.Failed,
@@ -130,10 +147,6 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	if err := validator.ValidateModel(UpdateRequiredFields, currentModel); err != nil {
 		return *err, nil
 	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
 
 	params, errHandler := newUpdateParams(currentModel)
 	if errHandler != nil {
@@ -145,7 +158,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 	outputRequest, resp, err := client.Atlas20231115014.OnlineArchiveApi.UpdateOnlineArchive(ctx, *currentModel.ProjectId, *currentModel.ArchiveId, *currentModel.ClusterName, &params).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.ArchiveId = outputRequest.Id
@@ -159,22 +172,13 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(DeleteRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	ctx := context.Background()
 	archiveID, iOK := req.CallbackContext["id"]
 	if _, ok := req.CallbackContext["stateName"]; ok && iOK {
 		id := cast.ToString(archiveID)
 		currentModel.ArchiveId = &id
-		return validateProgress(ctx, client, currentModel, "PENDING")
+		return validateProgress(ctx, client, currentModel, &req, &deletePoller)
 	}
 
 	if ArchiveDeleted(ctx, client, currentModel) {
@@ -183,7 +187,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	_, resp, err := client.Atlas20231115014.OnlineArchiveApi.DeleteOnlineArchive(ctx, *currentModel.ProjectId, *currentModel.ArchiveId, *currentModel.ClusterName).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -198,47 +202,88 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if err := validator.ValidateModel(ListRequiredFields, currentModel); err != nil {
-		return *err, nil
-	}
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-	params := admin.ListOnlineArchivesApiParams{
-		GroupId:      *currentModel.ProjectId,
-		ClusterName:  *currentModel.ClusterName,
-		IncludeCount: currentModel.IncludeCount,
-		ItemsPerPage: currentModel.ItemsPerPage,
-		PageNum:      currentModel.PageNum,
-	}
-	archivesResponse, resp, err := client.Atlas20231115014.OnlineArchiveApi.ListOnlineArchivesWithParams(context.Background(), &params).Execute()
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	var totalCount int
+	archives, nextToken, peErr := util.ListPage(&req, aws.IntValue(currentModel.ItemsPerPage), func(pageNum, itemsPerPage int) ([]admin.BackupOnlineArchive, int, *http.Response, error) {
+		params := admin.ListOnlineArchivesApiParams{
+			GroupId:      *currentModel.ProjectId,
+			ClusterName:  *currentModel.ClusterName,
+			IncludeCount: admin.PtrBool(true),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+		}
+		archivesResponse, resp, err := client.Atlas20231115014.OnlineArchiveApi.ListOnlineArchivesWithParams(context.Background(), &params).Execute()
+		if err != nil {
+			return nil, 0, resp, err
+		}
+		totalCount = archivesResponse.GetTotalCount()
+		return archivesResponse.GetResults(), totalCount, resp, nil
+	})
+	if peErr != nil {
+		return *peErr, nil
 	}
 
-	archives := archivesResponse.GetResults()
 	resources := make([]any, 0, len(archives))
 	for i := range archives {
 		model := Model{
-			ArchiveId: archives[i].Id,
-			ProjectId: currentModel.ProjectId,
-			State:     archives[i].State,
-		}
-		if archivesResponse.TotalCount != nil {
-			model.TotalCount = aws.Float64(float64(*archivesResponse.TotalCount))
+			ArchiveId:  archives[i].Id,
+			ProjectId:  currentModel.ProjectId,
+			State:      archives[i].State,
+			TotalCount: aws.Float64(float64(totalCount)),
 		}
 		resources = append(resources, model)
 	}
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		ResourceModels:  resources,
+		NextToken:       nextToken,
 	}, nil
 }
 
+// setArchiveData sets the properties of the model from the online archive.
+func setArchiveData(currentModel *Model, archive *admin.BackupOnlineArchive) {
+	currentModel.ArchiveId = archive.Id
+	currentModel.ProjectId = archive.GroupId
+	currentModel.ClusterName = archive.ClusterName
+	currentModel.DbName = archive.DbName
+	currentModel.CollName = archive.CollName
+	currentModel.CollectionType = archive.CollectionType
+	currentModel.State = archive.State
+
+	currentModel.Criteria = nil
+	if c := archive.Criteria; c != nil {
+		currentModel.Criteria = &CriteriaView{
+			Type:            c.Type,
+			DateField:       c.DateField,
+			DateFormat:      c.DateFormat,
+			ExpireAfterDays: c.ExpireAfterDays,
+			Query:           c.Query,
+		}
+	}
+
+	currentModel.Schedule = nil
+	if sc := archive.Schedule; sc != nil {
+		currentModel.Schedule = &ScheduleView{
+			Type:        &sc.Type,
+			EndHour:     sc.EndHour,
+			EndMinute:   sc.EndMinute,
+			StartHour:   sc.StartHour,
+			StartMinute: sc.StartMinute,
+			DayOfMonth:  sc.DayOfMonth,
+			DayOfWeek:   sc.DayOfWeek,
+		}
+	}
+
+	var partitionFields []PartitionFieldView
+	for _, f := range archive.GetPartitionFields() {
+		partitionFields = append(partitionFields, PartitionFieldView{
+			FieldName: &f.FieldName,
+			Order:     aws.Float64(float64(f.Order)),
+		})
+	}
+	currentModel.PartitionFields = partitionFields
+}
+
 func newCreateParams(currentModel *Model) (admin.BackupOnlineArchiveCreate, *handler.ProgressEvent) {
 	requestInput := admin.BackupOnlineArchiveCreate{
 		DbName:   *currentModel.DbName,
@@ -329,41 +374,26 @@ func newPartitionFields(currentModel *Model) *[]admin.PartitionField {
 	return &partitionFields
 }
 
-func validateProgress(ctx context.Context, client *util.MongoDBClient, currentModel *Model, targetState string) (event handler.ProgressEvent, err error) {
+func validateProgress(ctx context.Context, client *util.MongoDBClient, currentModel *Model, req *handler.Request, poller *progressevent.Poller) (event handler.ProgressEvent, err error) {
 	archive, err := ArchiveExists(ctx, client, currentModel)
 	if err != nil {
-		return handler.ProgressEvent{
-			Message:          err.Error(),
-			OperationStatus:  handler.Failed,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeServiceInternalError}, nil
-	}
-	if *archive.State == targetState {
-		p := handler.NewProgressEvent()
-		p.ResourceModel = currentModel
-		p.OperationStatus = cloudformation.OperationStatusInProgress
-		p.CallbackDelaySeconds = 60
-		p.Message = "Pending"
-		p.CallbackContext = map[string]interface{}{
-			"stateName": archive.State,
-			"id":        currentModel.ArchiveId,
-		}
-		return p, nil
+		return progressevent.GetFailedEventByError(err, nil), nil
 	}
-	p := handler.NewProgressEvent()
-	p.OperationStatus = cloudformation.OperationStatusSuccess
-	p.Message = "Complete"
-	if *archive.State != "DELETED" {
-		p.ResourceModel = currentModel
+	currentModel.State = archive.State
+
+	state := progressevent.PollState{Name: *archive.State, Model: currentModel}
+	if *archive.State == stateDeleted {
+		state.Model = nil
 	}
-	return p, nil
+	timeoutOptions := (*progressevent.TimeoutOptions)(currentModel.TimeoutOptions)
+	return poller.WithOptions(timeoutOptions, progressevent.TypeTimeoutOptions(req)).Poll(req.CallbackContext, state), nil
 }
 
 func ArchiveExists(ctx context.Context, client *util.MongoDBClient, currentModel *Model) (*admin.BackupOnlineArchive, error) {
 	archive, resp, err := client.Atlas20231115014.OnlineArchiveApi.GetOnlineArchive(ctx, *currentModel.ProjectId, *currentModel.ArchiveId, *currentModel.ClusterName).Execute()
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			state := "DELETED"
-			return &admin.BackupOnlineArchive{State: &state}, nil
+			return &admin.BackupOnlineArchive{State: admin.PtrString(stateDeleted)}, nil
 		}
 		return nil, err
 	}
@@ -372,5 +402,5 @@ func ArchiveExists(ctx context.Context, client *util.MongoDBClient, currentModel
 
 func ArchiveDeleted(ctx context.Context, client *util.MongoDBClient, currentModel *Model) bool {
 	a, _ := ArchiveExists(ctx, client, currentModel)
-	return a == nil || *a.State == "DELETED"
+	return a == nil || *a.State == stateDeleted
 }
@@ -0,0 +1,50 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/org-invitation/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/orgs/{orgId}/invites/{invitationId}", admin.OrganizationInvitation{
+		Id:              admin.PtrString("650000000000000000000002"),
+		OrgId:           admin.PtrString(fakeatlas.OrgID),
+		OrgName:         "org",
+		Username:        admin.PtrString("user@example.com"),
+		InviterUsername: admin.PtrString("admin@example.com"),
+		Roles:           []string{"ORG_MEMBER"},
+		TeamIds:         []string{"650000000000000000000003"},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "org invitation",
+		Schema:         "../../mongodb-atlas-orginvitation.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "OrgId": "` + fakeatlas.OrgID + `", "Id": "650000000000000000000002", "OrgName": "org",
+				"Username": "user@example.com", "Roles": ["ORG_MEMBER"], "TeamIds": ["650000000000000000000003"]}`,
+		}},
+	})
+}
@@ -16,13 +16,16 @@ package resource
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/idempotency"
 	log "github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
@@ -32,40 +35,55 @@ var UpdateRequiredFields = []string{constants.OrgID, constants.ID}
 var DeleteRequiredFields = []string{constants.OrgID, constants.ID}
 var ListRequiredFields = []string{constants.OrgID}
 
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-org-invitation",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-OrgInvitation")
-}
-
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	_, _ = log.Debugf("Create() currentModel:%+v", currentModel)
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	// Validation
-	modelValidation := validateModel(CreateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
+	binding := idempotency.New(&req, currentModel)
+	defer binding.Done()
 
 	invitationReq := &admin.OrganizationInvitationRequest{
 		TeamIds:  currentModel.TeamIds,
 		Roles:    currentModel.Roles,
 		Username: currentModel.Username,
 	}
-	invitation, res, err := atlasV2.OrganizationsApi.CreateOrganizationInvitation(context.Background(), *currentModel.OrgId, invitationReq).Execute()
+	var invitation *admin.OrganizationInvitation
+	var res *http.Response
+	_, _, err := binding.Create(func(id string) (bool, error) {
+		var err error
+		invitation, res, err = atlasV2.OrganizationsApi.GetOrganizationInvitation(context.Background(), *currentModel.OrgId, id).Execute()
+		if progressevent.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}, func() (string, error) {
+		var err error
+		invitation, res, err = atlasV2.OrganizationsApi.CreateOrganizationInvitation(context.Background(), *currentModel.OrgId, invitationReq).Execute()
+		return invitation.GetId(), err
+	})
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 	currentModel.Id = invitation.Id
 
@@ -76,22 +94,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	_, _ = log.Debugf("Read() currentModel:%+v", currentModel)
-
-	// Validation
-	modelValidation := validateModel(ReadRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
 
 	invitation, res, err := atlasV2.OrganizationsApi.GetOrganizationInvitation(context.Background(), *currentModel.OrgId, *currentModel.Id).Execute()
@@ -105,7 +108,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 			}
 		}
 
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	model := readAtlasOrgInvitation(invitation, currentModel)
@@ -116,22 +119,11 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	_, _ = log.Warnf("Update() currentModel:%+v", currentModel)
 
 	// Validation
-	modelValidation := validateModel(UpdateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
 	atlasV2 := client.Atlas20231115002
 
 	invitationReq := &admin.OrganizationInvitationUpdateRequest{
@@ -142,7 +134,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	invitation, res, err := atlasV2.OrganizationsApi.UpdateOrganizationInvitationById(context.Background(), *currentModel.OrgId, *currentModel.Id, invitationReq).Execute()
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 	_, _ = log.Debugf("%s invitation updated", *currentModel.Id)
 
@@ -154,29 +146,15 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	_, _ = log.Debugf("Delete() currentModel:%+v", currentModel)
-
-	// Validation
-	modelValidation := validateModel(DeleteRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
 
 	_, res, err := atlasV2.OrganizationsApi.DeleteOrganizationInvitation(context.Background(), *currentModel.OrgId, *currentModel.Id).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 	_, _ = log.Debugf("deleted invitation with Id :%s", *currentModel.Id)
+	idempotency.Clear(&req)
 
 	// Response
 	return handler.ProgressEvent{
@@ -185,22 +163,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	_, _ = log.Debugf("List() currentModel:%+v", currentModel)
-
-	// Validation
-	modelValidation := validateModel(ListRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
 
 	invitations, res, err := atlasV2.OrganizationsApi.ListOrganizationInvitationsWithParams(context.Background(), &admin.ListOrganizationInvitationsApiParams{
@@ -208,7 +171,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		Username: currentModel.Username,
 	}).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	var invites []interface{}
@@ -229,6 +192,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 func readAtlasOrgInvitation(invitation *admin.OrganizationInvitation, currentModel *Model) (model *Model) {
 	currentModel.Username = invitation.Username
 	currentModel.OrgId = invitation.OrgId
+	currentModel.OrgName = &invitation.OrgName
 	currentModel.Id = invitation.Id
 	currentModel.TeamIds = invitation.TeamIds
 	currentModel.Roles = invitation.Roles
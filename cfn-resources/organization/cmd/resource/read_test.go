@@ -0,0 +1,53 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/organization/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/orgs/{orgId}", admin.AtlasOrganization{
+		Id:        admin.PtrString(fakeatlas.OrgID),
+		Name:      "org",
+		IsDeleted: admin.PtrBool(false),
+	})
+	s.Stub("GET /api/atlas/v2/orgs/{orgId}/settings", admin.OrganizationSettings{
+		ApiAccessListRequired:   admin.PtrBool(false),
+		MultiFactorAuthRequired: admin.PtrBool(true),
+		RestrictEmployeeAccess:  admin.PtrBool(false),
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "organization",
+		Schema:         "../../mongodb-atlas-organization.json",
+		RequestContext: s.RequestContext(),
+		Session:        s.Session(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "AwsSecretName": "org-secret", "OrgOwnerId": "650000000000000000000002", "OrgId": "` + fakeatlas.OrgID + `",
+				"Name": "org", "IsDeleted": false, "ApiAccessListRequired": false, "MultiFactorAuthRequired": true, "RestrictEmployeeAccess": false,
+				"APIKey": {"Description": "key", "Roles": ["ORG_OWNER"]}}`,
+		}},
+	})
+}
@@ -16,22 +16,21 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
 
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/secrets"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 )
 
 var CreateRequiredFields = []string{constants.OrgOwnerID, constants.Name, constants.AwsSecretName, constants.OrgKeyDescription, constants.OrgKeyRoles}
@@ -58,24 +57,31 @@ type DeleteResponse struct {
 	Response *http.Response
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-organization")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-organization",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	// the organization is managed with its own API key, from AwsSecretName, instead of the profile
+	Read:   handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields, SkipClient: true},
+	Update: handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields, SkipClient: true},
+	Delete: handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields, SkipClient: true},
 }
 
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	modelValidation := validator.ValidateModel(CreateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	conn := client.Atlas20231115014
 	ctx := context.Background()
 
@@ -133,13 +139,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 }
 
 // Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if modelValidation := validator.ValidateModel(ReadRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
+func read(req handler.Request, _ *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	newOrgClient, peErr := util.NewAtlasClientRemovingProfilePrefix(&req, currentModel.AwsSecretName)
 	if peErr != nil {
 		return *peErr, nil
@@ -156,13 +156,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		ResourceModel:   model}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if modelValidation := validator.ValidateModel(UpdateRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
+func update(req handler.Request, _ *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	newOrgClient, peErr := util.NewAtlasClientRemovingProfilePrefix(&req, currentModel.AwsSecretName)
 	if peErr != nil {
 		return *peErr, nil
@@ -186,13 +180,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 }
 
 // Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if modelValidation := validator.ValidateModel(DeleteRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
+func deleteResource(req handler.Request, _ *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	newOrgClient, peErr := util.NewAtlasClientRemovingProfilePrefix(&req, currentModel.AwsSecretName)
 	if peErr != nil {
 		return *peErr, nil
@@ -288,11 +276,6 @@ func deleteCallback(ctx context.Context, conn *admin.APIClient, currentModel *Mo
 	}, nil
 }
 
-// List handles the List event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-
 func (model *Model) getOrgDetails(ctx context.Context, conn *admin.APIClient, currentModel *Model) (responseModel *Model, response *http.Response, err error) {
 	org, response, err := conn.OrganizationsApi.GetOrganization(ctx, *currentModel.OrgId).Execute()
 	if err != nil {
@@ -314,29 +297,10 @@ func (model *Model) getOrgDetails(ctx context.Context, conn *admin.APIClient, cu
 }
 
 func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
-	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
-	_, _ = logger.Warn(errMsg)
-	if response.StatusCode == http.StatusConflict {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          errMsg,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}, nil
-	}
-
-	if response.StatusCode == http.StatusUnauthorized {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          "Not found",
-			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
-	}
-
-	if response.StatusCode == http.StatusBadRequest {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          errMsg,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
-	}
-	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+	_, _ = logger.Warnf("%s error:%s", method, err.Error())
+	event := progress_events.GetFailedEventByError(err, response)
+	event.Message = fmt.Sprintf("%s error:%s", method, event.Message)
+	return event, nil
 }
 
 func setAPIkeyInputs(currentModel *Model) (apiKeyInput *admin.CreateAtlasOrganizationApiKey) {
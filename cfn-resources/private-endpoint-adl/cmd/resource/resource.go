@@ -16,14 +16,15 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
@@ -43,24 +44,35 @@ func validateAndDefaultRequest(fields []string, model *Model) *handler.ProgressE
 	return validator.ValidateModel(fields, model)
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-private-endpoint-adl")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-private-endpoint-adl",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create},
+	Read:     handlerkit.Operation[Model]{Run: read},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource},
+	List:     handlerkit.Operation[Model]{Run: list},
 }
 
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
+
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	validationError := validateAndDefaultRequest(RequiredFields, currentModel)
 	if validationError != nil {
 		return *validationError, nil
 	}
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	alreadyExists, pe := resourceAlreadyExists(*client, *currentModel)
 	if pe != nil {
 		return *pe, nil
@@ -80,7 +92,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 	_, resp, err := client.Atlas20231115014.DataFederationApi.CreateDataFederationPrivateEndpoint(ctx, *currentModel.ProjectId, &requestBody).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 	event := handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -97,16 +109,15 @@ func resourceAlreadyExists(client util.MongoDBClient, currentModel Model) (bool,
 			return false, nil
 		}
 
-		pe := progressevent.GetFailedEventByResponse(err.Error(), resp)
+		pe := progressevent.GetFailedEventByError(err, resp)
 		return false, &pe
 	}
 
 	return true, nil
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.EndpointId == nil {
 		return progressevent.GetFailedEventByResponse("required field missing. Resource not found", &http.Response{
 			StatusCode: http.StatusNotFound,
@@ -117,16 +128,10 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		return *validationError, nil
 	}
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	ctx := context.Background()
 	dlEndpoint, resp, err := client.Atlas20231115014.DataFederationApi.GetDataFederationPrivateEndpoint(ctx, *currentModel.ProjectId, *currentModel.EndpointId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.Comment = dlEndpoint.Comment
@@ -140,29 +145,17 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	return event, nil
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: update")
-}
-
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	validationError := validateAndDefaultRequest(RequiredFields, currentModel)
 	if validationError != nil {
 		return *validationError, nil
 	}
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	ctx := context.Background()
 	_, resp, err := client.Atlas20231115014.DataFederationApi.DeleteDataFederationPrivateEndpoint(ctx, *currentModel.ProjectId, *currentModel.EndpointId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 	event := handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -171,27 +164,31 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return event, nil
 }
 
-// List handles the List event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
+// list handles the List event from the Cloudformation service.
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	validationError := validateAndDefaultRequest(ListRequiredFields, currentModel)
 	if validationError != nil {
 		return *validationError, nil
 	}
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	ctx := context.Background()
+	endpoints, nextToken, peErr := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.PrivateNetworkEndpointIdEntry, int, *http.Response, error) {
+		list, resp, err := client.Atlas20231115014.DataFederationApi.ListDataFederationPrivateEndpointsWithParams(ctx, &admin.ListDataFederationPrivateEndpointsApiParams{
+			GroupId:      *currentModel.ProjectId,
+			IncludeCount: admin.PtrBool(true),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+		}).Execute()
+		if err != nil {
+			return nil, 0, resp, err
+		}
+		return list.GetResults(), list.GetTotalCount(), resp, nil
+	})
 	if peErr != nil {
 		return *peErr, nil
 	}
-
-	ctx := context.Background()
-	list, resp, err := client.Atlas20231115014.DataFederationApi.ListDataFederationPrivateEndpoints(ctx, *currentModel.ProjectId).Execute()
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
-	}
-	models := make([]any, 0, len(list.GetResults()))
-	for _, v := range list.GetResults() {
+	models := make([]any, 0, len(endpoints))
+	for _, v := range endpoints {
 		models = append(models, &Model{
 			ProjectId:  currentModel.ProjectId,
 			Profile:    currentModel.Profile,
@@ -205,6 +202,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "list data lake endpoints",
 		ResourceModels:  models,
+		NextToken:       nextToken,
 	}
 	return event, nil
 }
@@ -16,25 +16,41 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-private-endpoint")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-private-endpoint-aws",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+}
+
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
 const (
@@ -58,26 +74,12 @@ var UpdateRequiredFields []string
 var DeleteRequiredFields = []string{constants.ProjectID, constants.ID, EndpointServiceID}
 var ListRequiredFields = []string{constants.GroupID}
 
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(CreateRequiredFields, currentModel); errEvent != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.EnforceConnectionSuccess == nil {
 		currentModel.EnforceConnectionSuccess = aws.Bool(true)
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	// progress callback setup
 	if _, ok := req.CallbackContext["state"]; ok {
 		privateEndpoint, response, peError := getPrivateEndpoint(client, currentModel)
@@ -129,8 +131,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 					err.Error()), cloudformation.HandlerErrorCodeAlreadyExists),
 				nil
 		}
-		return progress_events.GetFailedEventByResponse(fmt.Sprintf("error creating Serverless Private Endpoint %s",
-				err.Error()), response),
+		return progress_events.GetFailedEventByError(err, response),
 			nil
 	}
 
@@ -152,26 +153,12 @@ func getPrivateEndpoint(client *util.MongoDBClient, model *Model) (*admin.Privat
 	return privateEndpoint, response, err
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(ReadRequiredFields, currentModel); errEvent != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	privateEndpoint, response, err := getPrivateEndpoint(client, currentModel)
 	defer response.Body.Close()
 	if err != nil {
-		return progress_events.GetFailedEventByResponse(fmt.Sprintf("READ: Error getting private endpoint: %s", err.Error()), response), nil
+		return progress_events.GetFailedEventByError(err, response), nil
 	}
 
 	currentModel.completeByAtlasModel(*privateEndpoint)
@@ -187,28 +174,8 @@ func (m *Model) completeByAtlasModel(privateEndpoint admin.PrivateLinkEndpoint)
 	m.ConnectionStatus = privateEndpoint.ConnectionStatus
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(DeleteRequiredFields, currentModel); errEvent != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
-	// progress callback setup
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if _, ok := req.CallbackContext["state"]; ok {
 		_, response, peError := getPrivateEndpoint(client, currentModel)
 		defer response.Body.Close()
@@ -236,8 +203,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	_, response, err := privateEndpointRequest.Execute()
 	defer response.Body.Close()
 	if err != nil {
-		return progress_events.GetFailedEventByResponse(fmt.Sprintf("error creating Serverless Private Endpoint %s",
-				err.Error()), response),
+		return progress_events.GetFailedEventByError(err, response),
 			nil
 	}
 
@@ -250,8 +216,3 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			"state": "deleting",
 		}}, nil
 }
-
-// List handles the List event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
@@ -17,19 +17,18 @@ package resource
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 )
 
 const (
@@ -39,8 +38,26 @@ const (
 	InitiatingStatus       = "INITIATING"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-private-endpoint")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-private-endpoint-service",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: createService, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
+}
+
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
 var CreateRequiredFields = []string{constants.ProjectID, constants.Region, constants.CloudProvider}
@@ -49,22 +66,8 @@ var UpdateRequiredFields []string
 var DeleteRequiredFields = []string{constants.ProjectID, constants.ID, constants.CloudProvider}
 var ListRequiredFields = []string{constants.ProjectID, constants.CloudProvider}
 
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(CreateRequiredFields, currentModel); errEvent != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	mongodbClient, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// createService handles the Create event from the Cloudformation service.
+func createService(req handler.Request, mongodbClient *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if isCreating(req) {
 		print("In progress")
 		return validateCreationCompletion(mongodbClient,
@@ -74,29 +77,14 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return create(mongodbClient, currentModel), nil
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(ReadRequiredFields, currentModel); errEvent != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	getPrivateEndpointRequest := client.Atlas20231115014.PrivateEndpointServicesApi.GetPrivateEndpointService(context.Background(), *currentModel.ProjectId,
 		*currentModel.CloudProvider, *currentModel.Id)
 	privateEndpointResponse, response, err := getPrivateEndpointRequest.Execute()
 	defer response.Body.Close()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 
 	currentModel.completeByConnection(*privateEndpointResponse)
@@ -107,27 +95,8 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		ResourceModel:   currentModel}, nil
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(DeleteRequiredFields, currentModel); errEvent != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	getPrivateEndpointRequest := client.Atlas20231115014.PrivateEndpointServicesApi.GetPrivateEndpointService(context.Background(), *currentModel.ProjectId,
 		*currentModel.CloudProvider, *currentModel.Id)
 	privateEndpointResponse, response, err := getPrivateEndpointRequest.Execute()
@@ -146,8 +115,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		}
 	}
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 
 	if privateEndpointResponse == nil {
@@ -161,8 +129,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	defer response.Body.Close()
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 
 	return handler.ProgressEvent{
@@ -175,28 +142,13 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		}}, nil
 }
 
-// List handles the List event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(ListRequiredFields, currentModel); errEvent != nil {
-		_, _ = logger.Warnf("Validation Error")
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+// list handles the List event from the Cloudformation service.
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	getPrivateEndpointRequest := client.Atlas20231115014.PrivateEndpointServicesApi.ListPrivateEndpointServices(context.Background(), *currentModel.ProjectId,
 		*currentModel.CloudProvider)
 	privateEndpointResponse, response, err := getPrivateEndpointRequest.Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error listing resource : %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 
 	mm := make([]interface{}, 0, len(privateEndpointResponse))
@@ -272,8 +224,7 @@ func create(client *util.MongoDBClient, currentModel *Model) handler.ProgressEve
 	}
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error creating resource : %s", err.Error()),
-			response)
+		return progressevent.GetFailedEventByError(err, response)
 	}
 
 	callBackContext := privateEndpointCreationCallBackContext{
@@ -300,8 +251,7 @@ func validateCreationCompletion(client *util.MongoDBClient, currentModel *Model,
 	privateEndpointResponse, response, err := getPrivateEndpointRequest.Execute()
 	defer response.Body.Close()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			response)
+		return progressevent.GetFailedEventByError(err, response)
 	}
 
 	currentModel.completeByConnection(*privateEndpointResponse)
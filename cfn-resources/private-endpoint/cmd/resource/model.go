@@ -13,6 +13,8 @@ type Model struct {
 	Region              *string           `json:",omitempty"`
 	PrivateEndpoints    []PrivateEndpoint `json:",omitempty"`
 	InterfaceEndpoints  []string          `json:",omitempty"`
+	TimeoutOptions      *TimeoutOptions   `json:",omitempty"`
+	Urn                 *string           `json:",omitempty"`
 }
 
 // PrivateEndpoint is autogenerated from the json schema
@@ -23,3 +25,10 @@ type PrivateEndpoint struct {
 	AWSPrivateEndpointStatus   *string  `json:",omitempty"`
 	AtlasPrivateEndpointStatus *string  `json:",omitempty"`
 }
+
+// TimeoutOptions is autogenerated from the json schema
+type TimeoutOptions struct {
+	TimeOutInSeconds       *int  `json:",omitempty"`
+	CallbackDelaySeconds   *int  `json:",omitempty"`
+	ReturnSuccessIfTimeOut *bool `json:",omitempty"`
+}
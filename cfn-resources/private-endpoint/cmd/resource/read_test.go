@@ -0,0 +1,81 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/privateEndpoint/{cloudProvider}/endpointService/{endpointServiceId}", admin.EndpointService{
+		Id:                  admin.PtrString("650000000000000000000002"),
+		CloudProvider:       "AWS",
+		RegionName:          admin.PtrString("US_EAST_1"),
+		EndpointServiceName: admin.PtrString("com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0"),
+		Status:              admin.PtrString("AVAILABLE"),
+		InterfaceEndpoints:  []string{"vpce-0123456789abcdef0"},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "private endpoint",
+		Schema:         "../../mongodb-atlas-privateendpoint.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "GroupId": "650000000000000000000001", "Region": "us-east-1",
+				"Id": "650000000000000000000002",
+				"EndpointServiceName": "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0", "Status": "AVAILABLE",
+				"InterfaceEndpoints": ["vpce-0123456789abcdef0"],
+				"PrivateEndpoints": [{"VpcId": "vpc-0123456789abcdef0", "SubnetIds": ["subnet-0123456789abcdef0"]}]}`,
+		}},
+	})
+}
+
+func TestIdentifiesServiceByURN(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/privateEndpoint/{cloudProvider}/endpointService/{endpointServiceId}", admin.EndpointService{
+		Id:            admin.PtrString("650000000000000000000002"),
+		CloudProvider: "AWS",
+		RegionName:    admin.PtrString("US_EAST_1"),
+		Status:        admin.PtrString("AVAILABLE"),
+	})
+	s.Stub("DELETE /api/atlas/v2/groups/{groupId}/privateEndpoint/{cloudProvider}/endpointService/{endpointServiceId}", nil)
+	urn := util.EndpointServiceURN("650000000000000000000001", "650000000000000000000002").String()
+
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{Urn: aws.String(urn)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, "650000000000000000000001", aws.StringValue(read.GroupId))
+	assert.Equal(t, "650000000000000000000002", aws.StringValue(read.Id))
+	assert.Equal(t, "us-east-1", aws.StringValue(read.Region))
+	assert.Equal(t, urn, aws.StringValue(read.Urn))
+
+	// the service stays AVAILABLE in the stubbed responses, the test only checks that the deletion starts
+	event, err := resource.Delete(s.Request(nil), nil, &resource.Model{Urn: aws.String(urn)})
+	require.NoError(t, err)
+	assert.Equal(t, handler.InProgress, event.OperationStatus, event.Message)
+}
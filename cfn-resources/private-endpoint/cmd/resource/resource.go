@@ -16,10 +16,11 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	resource_constats "github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint/cmd/constants"
@@ -28,21 +29,41 @@ import (
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint/cmd/resource/steps/privateendpointservice"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
 const (
 	providerName = "AWS"
+	stateDeleted = "DELETED"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-private-endpoint")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-private-endpoint",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Identify: identify,
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
+}
+
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
 var CreateRequiredFields = []string{constants.GroupID, constants.Region}
-var ReadRequiredFields = []string{constants.GroupID, constants.ID, constants.Region}
+var ReadRequiredFields = []string{constants.GroupID, constants.ID}
 var UpdateRequiredFields []string
 var DeleteRequiredFields = []string{constants.GroupID, constants.ID}
 var ListRequiredFields = []string{constants.GroupID}
@@ -63,20 +84,8 @@ func (m *Model) newAwsPrivateEndpointInput() []awsvpcendpoint.AwsPrivateEndpoint
 	return awsInput
 }
 
-// Create handles the Create event from the Cloudformation service.
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(CreateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+// create handles the Create event from the Cloudformation service.
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	status, pe := getProcessStatus(req)
 	if pe != nil {
 		return *pe, nil
@@ -84,11 +93,11 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	switch status {
 	case resource_constats.Init:
-		pe := privateendpointservice.Create(*client, *currentModel.Region, *currentModel.GroupId)
+		pe := privateendpointservice.Create(client.Atlas20231115002.PrivateEndpointServicesApi, *currentModel.Region, *currentModel.GroupId)
 		return addModelToProgressEvent(&pe, currentModel), nil
 	case resource_constats.CreatingPrivateEndpointService:
-		peConnection, completionValidation := privateendpointservice.ValidateCreationCompletion(client,
-			*currentModel.GroupId, req)
+		peConnection, completionValidation := privateendpointservice.ValidateCreationCompletion(client.Atlas20231115002.PrivateEndpointServicesApi,
+			*currentModel.GroupId, req, newPoller(&req, currentModel, privateendpointservice.AvailableStatus))
 		if completionValidation != nil {
 			return addModelToProgressEvent(completionValidation, currentModel), nil
 		}
@@ -109,11 +118,12 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			}
 		}
 
-		pe := privateendpoint.Create(client, *currentModel.GroupId, privateEndpointInput, *peConnection.Id)
+		pe := privateendpoint.Create(client.Atlas20231115002.PrivateEndpointServicesApi, *currentModel.GroupId, privateEndpointInput, *peConnection.Id)
 
 		return addModelToProgressEvent(&pe, currentModel), nil
 	default:
-		ValidationOutput, progressEvent := privateendpoint.ValidateCreationCompletion(client, *currentModel.GroupId, req)
+		ValidationOutput, progressEvent := privateendpoint.ValidateCreationCompletion(client.Atlas20231115002.PrivateEndpointServicesApi, *currentModel.GroupId, req,
+			newPoller(&req, currentModel, privateendpoint.StatusAvailable))
 		if progressEvent != nil {
 			return addModelToProgressEvent(progressEvent, currentModel), nil
 		}
@@ -133,8 +143,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			GetPrivateEndpointService(context.Background(), *currentModel.GroupId, providerName, *currentModel.Id).
 			Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-				response), nil
+			return progressevent.GetFailedEventByError(err, response), nil
 		}
 		currentModel.EndpointServiceName = privateEndpointResponse.EndpointServiceName
 
@@ -145,26 +154,13 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 }
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(ReadRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	privateEndpointResponse, response, err := client.Atlas20231115002.PrivateEndpointServicesApi.
 		GetPrivateEndpointService(context.Background(), *currentModel.GroupId, providerName, *currentModel.Id).
 		Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 
 	currentModel.completeByConnection(privateEndpointResponse)
@@ -175,24 +171,8 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		ResourceModel:   currentModel}, nil
 }
 
-// Update handles the Update event from the Cloudformation service.
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: Update")
-}
-
-// Delete handles the Delete event from the Cloudformation service.
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(DeleteRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
+// deleteResource handles the Delete event from the Cloudformation service.
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	privateEndpointResponse, response, err := client.Atlas20231115002.PrivateEndpointServicesApi.GetPrivateEndpointService(context.Background(),
 		*currentModel.GroupId, providerName, *currentModel.Id).Execute()
 
@@ -204,14 +184,14 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		}
 
 		if privateEndpointResponse != nil {
-			return progressevent.GetInProgressProgressEvent("Delete in progress",
-				map[string]interface{}{"stateName": "DELETING"}, currentModel, 20), nil
+			poller := newPoller(&req, currentModel, stateDeleted)
+			poller.Message = "Delete in progress"
+			return poller.Poll(req.CallbackContext, progressevent.PollState{Name: privateEndpointResponse.GetStatus(), Model: currentModel}), nil
 		}
 	}
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 
 	if privateEndpointResponse == nil {
@@ -222,7 +202,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	privateEndpoint := *privateEndpointResponse
 
 	if hasInterfaceEndpoints(privateEndpoint) {
-		epr := privateendpoint.Delete(client, *currentModel.GroupId, *currentModel.Id,
+		epr := privateendpoint.Delete(client.Atlas20231115002.PrivateEndpointServicesApi, *currentModel.GroupId, *currentModel.Id,
 			privateEndpoint.InterfaceEndpoints)
 
 		if epr != nil {
@@ -238,8 +218,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			*currentModel.Id).Execute()
 
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-				response), nil
+			return progressevent.GetFailedEventByError(err, response), nil
 		}
 	}
 
@@ -253,26 +232,13 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		}}, nil
 }
 
-// List handles the List event from the Cloudformation service.
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validator.ValidateModel(ListRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
-	if pe != nil {
-		return *pe, nil
-	}
-
+// list handles the List event from the Cloudformation service.
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	privateEndpointResponse, response, err := client.Atlas20231115002.PrivateEndpointServicesApi.ListPrivateEndpointServices(context.Background(),
 		*currentModel.GroupId,
 		providerName).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error listing resource : %s", err.Error()),
-			response), nil
+		return progressevent.GetFailedEventByError(err, response), nil
 	}
 
 	mm := make([]interface{}, 0, len(privateEndpointResponse))
@@ -307,11 +273,34 @@ func hasInterfaceEndpoints(p admin.EndpointService) bool {
 
 func (m *Model) completeByConnection(c *admin.EndpointService) {
 	m.Id = c.Id
+	// a model identified by its Urn has no region, Atlas returns it like US_EAST_1
+	if m.Region == nil && c.RegionName != nil {
+		m.Region = util.StringPtr(util.EnsureAWSRegion(*c.RegionName))
+	}
+	if m.GroupId != nil && m.Id != nil {
+		m.Urn = util.StringPtr(util.EndpointServiceURN(*m.GroupId, *m.Id).String())
+	}
 	m.EndpointServiceName = c.EndpointServiceName
 	m.ErrorMessage = c.ErrorMessage
 	m.Status = c.Status
+	m.InterfaceEndpoints = c.GetInterfaceEndpoints()
+}
 
-	copy(m.InterfaceEndpoints, c.InterfaceEndpoints)
+// identify sets the project and ID of a model identified by its Urn only.
+func identify(model *Model) error {
+	if model.Urn == nil || model.GroupId != nil || model.Id != nil {
+		return nil
+	}
+	urn, err := util.ParseURN(*model.Urn)
+	if err != nil {
+		return err
+	}
+	ids, err := urn.IDs(util.URNProject, util.URNEndpointService)
+	if err != nil {
+		return err
+	}
+	model.GroupId, model.Id = &ids[0], &ids[1]
+	return nil
 }
 
 func getProcessStatus(req handler.Request) (resource_constats.EventStatus, *handler.ProgressEvent) {
@@ -330,8 +319,21 @@ func getProcessStatus(req handler.Request) (resource_constats.EventStatus, *hand
 	return eventStatus, nil
 }
 
+// newPoller returns the poller of a creation or deletion step, with the TimeoutOptions of the model or of the type configuration.
+func newPoller(req *handler.Request, model *Model, targetState string) *progressevent.Poller {
+	return progressevent.Poller{
+		Resource:        "Private endpoint",
+		TargetStates:    []string{targetState},
+		Timeout:         30 * time.Minute,
+		MinDelaySeconds: 20,
+		MaxDelaySeconds: 80,
+	}.WithOptions((*progressevent.TimeoutOptions)(model.TimeoutOptions), progressevent.TypeTimeoutOptions(req))
+}
+
+// addModelToProgressEvent sets the model of the InProgress events, and of the Success events returned by a poller
+// on timeout.
 func addModelToProgressEvent(progressEvent *handler.ProgressEvent, model *Model) handler.ProgressEvent {
-	if progressEvent.OperationStatus == handler.InProgress {
+	if progressEvent.OperationStatus == handler.InProgress || progressEvent.OperationStatus == handler.Success {
 		progressEvent.ResourceModel = model
 
 		callbackID := progressEvent.CallbackContext["ID"]
@@ -24,7 +24,6 @@ import (
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint/cmd/constants"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
@@ -37,6 +36,17 @@ const (
 	StatusInitiating        = "INITIATING"
 )
 
+// PrivateEndpointServicesAPI is the part of the Atlas PrivateEndpointServicesApi used to manage the private endpoints of
+// an endpoint service.
+type PrivateEndpointServicesAPI interface {
+	CreatePrivateEndpoint(ctx context.Context, groupID string, cloudProvider string, endpointServiceID string,
+		createEndpointRequest *admin.CreateEndpointRequest) admin.CreatePrivateEndpointApiRequest
+	GetPrivateEndpoint(ctx context.Context, groupID string, cloudProvider string, endpointID string, endpointServiceID string) admin.GetPrivateEndpointApiRequest
+	DeletePrivateEndpoint(ctx context.Context, groupID string, cloudProvider string, endpointID string, endpointServiceID string) admin.DeletePrivateEndpointApiRequest
+}
+
+var _ PrivateEndpointServicesAPI = admin.PrivateEndpointServicesApi(nil)
+
 // Todo: im not convinced about this resource, maybe we can find another way
 type privateEndpointCreationCallBackContext struct {
 	StateName        constants.EventStatus
@@ -135,20 +145,19 @@ func getMapFromCallBackContext(callBackContext privateEndpointCreationCallBackCo
 	return callBackMap, err
 }
 
-func Create(client *util.MongoDBClient, groupID string, privateEndpointInput []AtlasPrivateEndpointInput, endpointServiceID string) handler.ProgressEvent {
+func Create(client PrivateEndpointServicesAPI, groupID string, privateEndpointInput []AtlasPrivateEndpointInput, endpointServiceID string) handler.ProgressEvent {
 	for _, endpoint := range privateEndpointInput {
 		interfaceEndpointRequest := &admin.CreateEndpointRequest{
 			Id: &endpoint.InterfaceEndpointID,
 		}
 
-		_, response, err := client.Atlas20231115002.PrivateEndpointServicesApi.CreatePrivateEndpoint(context.Background(),
+		_, response, err := client.CreatePrivateEndpoint(context.Background(),
 			groupID,
 			ProviderName,
 			endpointServiceID,
 			interfaceEndpointRequest).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error creating resource : %s", err.Error()),
-				response)
+			return progressevent.GetFailedEventByError(err, response)
 		}
 	}
 
@@ -166,7 +175,7 @@ func Create(client *util.MongoDBClient, groupID string, privateEndpointInput []A
 	return progressevent.GetInProgressProgressEvent("Adding private endpoint", callBackMap, nil, 20)
 }
 
-func ValidateCreationCompletion(client *util.MongoDBClient, groupID string, req handler.Request) (*ValidationResponse, *handler.ProgressEvent) {
+func ValidateCreationCompletion(client PrivateEndpointServicesAPI, groupID string, req handler.Request, poller *progressevent.Poller) (*ValidationResponse, *handler.ProgressEvent) {
 	callBackContext := privateEndpointCreationCallBackContext{}
 
 	err := callBackContext.FillStruct(req.CallbackContext)
@@ -175,26 +184,25 @@ func ValidateCreationCompletion(client *util.MongoDBClient, groupID string, req
 		return nil, &pe
 	}
 
-	completed := true
+	pendingStatus := ""
 	ids := make([]string, len(callBackContext.PrivateEndpoints))
 	for i := range callBackContext.PrivateEndpoints {
 		ids[i] = callBackContext.PrivateEndpoints[i].InterfaceEndpointID
 		if callBackContext.PrivateEndpoints[i].Status != StatusAvailable {
-			privateEndpointResponse, response, err := client.Atlas20231115002.PrivateEndpointServicesApi.GetPrivateEndpoint(context.Background(),
+			privateEndpointResponse, response, err := client.GetPrivateEndpoint(context.Background(),
 				groupID,
 				ProviderName,
 				callBackContext.PrivateEndpoints[i].InterfaceEndpointID,
 				callBackContext.ID).Execute()
 			if err != nil {
-				pe := progressevent.GetFailedEventByResponse(fmt.Sprintf("Error validating private endpoint create : %s", err.Error()),
-					response)
+				pe := progressevent.GetFailedEventByError(err, response)
 				return nil, &pe
 			}
 			callBackContext.PrivateEndpoints[i].Status = *privateEndpointResponse.ConnectionStatus
 
 			switch *privateEndpointResponse.ConnectionStatus {
 			case StatusPendingAcceptance, StatusPending:
-				completed = false
+				pendingStatus = *privateEndpointResponse.ConnectionStatus
 			case StatusAvailable:
 				continue
 			default:
@@ -205,7 +213,7 @@ func ValidateCreationCompletion(client *util.MongoDBClient, groupID string, req
 		}
 	}
 
-	if completed {
+	if pendingStatus == "" {
 		endpoints := make([]AtlasPrivateEndpointCallBack, len(callBackContext.PrivateEndpoints))
 		for i, v := range callBackContext.PrivateEndpoints {
 			endpoints[i] = AtlasPrivateEndpointCallBack{
@@ -222,8 +230,7 @@ func ValidateCreationCompletion(client *util.MongoDBClient, groupID string, req
 		return &vr, nil
 	}
 
-	pe := progressevent.GetInProgressProgressEvent("Adding private endpoint in progress",
-		req.CallbackContext, nil, 20)
+	pe := poller.Poll(req.CallbackContext, progressevent.PollState{Name: pendingStatus})
 	return nil, &pe
 }
 
@@ -231,17 +238,15 @@ func (i AtlasPrivateEndpointInput) ToString() string {
 	return fmt.Sprintf("%s%s", i.VpcID, i.SubnetIDs)
 }
 
-func Delete(client *util.MongoDBClient, groupID string, endpointServiceID string, interfaceEndpoints []string) *handler.ProgressEvent {
+func Delete(client PrivateEndpointServicesAPI, groupID string, endpointServiceID string, interfaceEndpoints []string) *handler.ProgressEvent {
 	for _, intEndpoints := range interfaceEndpoints {
-		_, response, err := client.Atlas20231115002.PrivateEndpointServicesApi.DeletePrivateEndpoint(context.Background(),
+		_, response, err := client.DeletePrivateEndpoint(context.Background(),
 			groupID,
 			ProviderName,
 			intEndpoints,
 			endpointServiceID).Execute()
 		if err != nil {
-			pe := progressevent.GetFailedEventByResponse(fmt.Sprintf("Error deleting private endpoint : %s",
-				err.Error()),
-				response)
+			pe := progressevent.GetFailedEventByError(err, response)
 			return &pe
 		}
 	}
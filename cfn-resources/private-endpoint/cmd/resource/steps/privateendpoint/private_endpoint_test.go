@@ -0,0 +1,122 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privateendpoint_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint/cmd/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint/cmd/resource/steps/privateendpoint"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+)
+
+const (
+	groupID   = "65aaaaaaaaaaaaaaaaaaaaaa"
+	serviceID = "65bbbbbbbbbbbbbbbbbbbbbb"
+)
+
+// newClient returns the PrivateEndpointServicesApi of a client of an Atlas answering every request with the status
+// and body, and the requests it received. The v20231115002 SDK can't be mocked: its Execute methods are unexported.
+func newClient(t *testing.T, status int, body any) (privateendpoint.PrivateEndpointServicesAPI, *[]string) {
+	t.Helper()
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(srv.Close)
+	client, err := admin.NewClient(admin.UseBaseURL(srv.URL))
+	require.NoError(t, err)
+	return client.PrivateEndpointServicesApi, &requests
+}
+
+func endpointPath(endpointID string) string {
+	return "/api/atlas/v2/groups/" + groupID + "/privateEndpoint/AWS/endpointService/" + serviceID + "/endpoint/" + endpointID
+}
+
+func endpoints() []privateendpoint.AtlasPrivateEndpointInput {
+	return []privateendpoint.AtlasPrivateEndpointInput{
+		{VpcID: "vpc-1", InterfaceEndpointID: "vpce-1", SubnetIDs: []string{"subnet-1"}},
+		{VpcID: "vpc-2", InterfaceEndpointID: "vpce-2", SubnetIDs: []string{"subnet-2", "subnet-3"}},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	client, requests := newClient(t, http.StatusCreated, admin.PrivateLinkEndpoint{})
+
+	event := privateendpoint.Create(client, groupID, endpoints(), serviceID)
+	require.Equal(t, handler.InProgress, event.OperationStatus, event.Message)
+	assert.Len(t, *requests, 2, "one request per interface endpoint")
+	assert.Equal(t, string(constants.CreatingPrivateEndpoint), event.CallbackContext["StateName"])
+	assert.Equal(t, serviceID, event.CallbackContext["ID"])
+	created, ok := event.CallbackContext["PrivateEndpoints"].([]any)
+	require.True(t, ok)
+	require.Len(t, created, 2)
+	assert.Equal(t, privateendpoint.StatusInitiating, created[1].(map[string]any)["Status"])
+}
+
+func TestValidateCreationCompletion(t *testing.T) {
+	testCases := map[string]struct {
+		status    string
+		available bool
+		operation handler.Status
+	}{
+		"pending acceptance": {status: privateendpoint.StatusPendingAcceptance, operation: handler.InProgress},
+		"available":          {status: privateendpoint.StatusAvailable, available: true},
+		"rejected":           {status: "REJECTED", operation: handler.Failed},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client, requests := newClient(t, http.StatusOK,
+				admin.PrivateLinkEndpoint{ConnectionStatus: admin.PtrString(tc.status), Status: admin.PtrString(tc.status)})
+			inputs := endpoints()
+			inputs[0].Status = admin.PtrString(privateendpoint.StatusAvailable)
+			callbackContext, err := privateendpoint.GetCallback(inputs, serviceID, constants.CreatingPrivateEndpoint)
+			require.NoError(t, err)
+			req := handler.NewRequest("", callbackContext, handler.RequestContext{}, nil, nil, nil, nil)
+			poller := &progressevent.Poller{TargetStates: []string{privateendpoint.StatusAvailable}, MinDelaySeconds: 20}
+
+			validation, event := privateendpoint.ValidateCreationCompletion(client, groupID, req, poller)
+			assert.Equal(t, []string{"GET " + endpointPath("vpce-2")}, *requests, "the available endpoint isn't read again")
+			if tc.available {
+				require.Nil(t, event)
+				assert.Equal(t, serviceID, validation.ID)
+				require.Len(t, validation.Endpoints, 2)
+				assert.Equal(t, "vpce-2", validation.Endpoints[1].InterfaceEndpointID)
+				return
+			}
+			require.NotNil(t, event)
+			assert.Equal(t, tc.operation, event.OperationStatus)
+		})
+	}
+}
+
+func TestDeleteStopsAtFirstError(t *testing.T) {
+	client, requests := newClient(t, http.StatusInternalServerError, admin.ApiError{Error: admin.PtrInt(http.StatusInternalServerError)})
+
+	event := privateendpoint.Delete(client, groupID, serviceID, []string{"vpce-1", "vpce-2"})
+	require.NotNil(t, event)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, []string{"DELETE " + endpointPath("vpce-1")}, *requests)
+}
@@ -23,7 +23,6 @@ import (
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint/cmd/constants"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
@@ -34,6 +33,15 @@ const (
 	InitiatingStatus = "INITIATING"
 )
 
+// PrivateEndpointServicesAPI is the part of the Atlas PrivateEndpointServicesApi used to create the endpoint service.
+type PrivateEndpointServicesAPI interface {
+	CreatePrivateEndpointService(ctx context.Context, groupID string,
+		cloudProviderEndpointServiceRequest *admin.CloudProviderEndpointServiceRequest) admin.CreatePrivateEndpointServiceApiRequest
+	GetPrivateEndpointService(ctx context.Context, groupID string, cloudProvider string, endpointServiceID string) admin.GetPrivateEndpointServiceApiRequest
+}
+
+var _ PrivateEndpointServicesAPI = admin.PrivateEndpointServicesApi(nil)
+
 type privateEndpointCreationCallBackContext struct {
 	StateName constants.EventStatus
 	ID        string
@@ -52,13 +60,13 @@ func (s *privateEndpointCreationCallBackContext) FillStruct(m map[string]interfa
 	return nil
 }
 
-func Create(client util.MongoDBClient, region string, groupID string) handler.ProgressEvent {
+func Create(client PrivateEndpointServicesAPI, region string, groupID string) handler.ProgressEvent {
 	privateEndpointRequest := &admin.CloudProviderEndpointServiceRequest{
 		ProviderName: ProviderName,
 		Region:       region,
 	}
 
-	privateEndpointResponse, response, err := client.Atlas20231115002.PrivateEndpointServicesApi.CreatePrivateEndpointService(
+	privateEndpointResponse, response, err := client.CreatePrivateEndpointService(
 		context.Background(),
 		groupID,
 		privateEndpointRequest).Execute()
@@ -71,8 +79,7 @@ func Create(client util.MongoDBClient, region string, groupID string) handler.Pr
 	}
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error creating resource : %s", err.Error()),
-			response)
+		return progressevent.GetFailedEventByError(err, response)
 	}
 
 	callBackContext := privateEndpointCreationCallBackContext{
@@ -84,15 +91,14 @@ func Create(client util.MongoDBClient, region string, groupID string) handler.Pr
 	data, _ := json.Marshal(callBackContext)
 	err = json.Unmarshal(data, &callBackMap)
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error Unmarshalling callback map : %s", err.Error()),
-			response)
+		return progressevent.GetFailedEventByError(err, response)
 	}
 
 	return progressevent.GetInProgressProgressEvent("Creating private endpoint service", callBackMap,
 		nil, 20)
 }
 
-func ValidateCreationCompletion(client *util.MongoDBClient, groupID string, req handler.Request) (*admin.EndpointService, *handler.ProgressEvent) {
+func ValidateCreationCompletion(client PrivateEndpointServicesAPI, groupID string, req handler.Request, poller *progressevent.Poller) (*admin.EndpointService, *handler.ProgressEvent) {
 	PrivateEndpointCallBackContext := privateEndpointCreationCallBackContext{}
 
 	err := PrivateEndpointCallBackContext.FillStruct(req.CallbackContext)
@@ -102,32 +108,16 @@ func ValidateCreationCompletion(client *util.MongoDBClient, groupID string, req
 		return nil, &ev
 	}
 
-	privateEndpointResponse, response, err := client.Atlas20231115002.PrivateEndpointServicesApi.GetPrivateEndpointService(context.Background(), groupID,
+	privateEndpointResponse, response, err := client.GetPrivateEndpointService(context.Background(), groupID,
 		ProviderName, PrivateEndpointCallBackContext.ID).Execute()
 	if err != nil {
-		ev := progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			response)
+		ev := progressevent.GetFailedEventByError(err, response)
 		return nil, &ev
 	}
 
 	switch *privateEndpointResponse.Status {
 	case InitiatingStatus:
-		callBackContext := privateEndpointCreationCallBackContext{
-			StateName: constants.CreatingPrivateEndpointService,
-			ID:        *privateEndpointResponse.Id,
-		}
-
-		var callBackMap map[string]interface{}
-		data, _ := json.Marshal(callBackContext)
-		err = json.Unmarshal(data, &callBackMap)
-		if err != nil {
-			ev := progressevent.GetFailedEventByResponse(fmt.Sprintf("Error Unmarshalling callback map : %s", err.Error()),
-				response)
-			return nil, &ev
-		}
-
-		ev := progressevent.GetInProgressProgressEvent("Private endpoint service initiating", callBackMap,
-			nil, 20)
+		ev := poller.Poll(req.CallbackContext, progressevent.PollState{Name: InitiatingStatus})
 		return nil, &ev
 	case AvailableStatus:
 		return privateEndpointResponse, nil
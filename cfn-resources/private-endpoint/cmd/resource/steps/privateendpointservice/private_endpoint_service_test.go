@@ -0,0 +1,97 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privateendpointservice_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint/cmd/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint/cmd/resource/steps/privateendpointservice"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+)
+
+const (
+	groupID   = "65aaaaaaaaaaaaaaaaaaaaaa"
+	serviceID = "65bbbbbbbbbbbbbbbbbbbbbb"
+)
+
+// newClient returns the PrivateEndpointServicesApi of a client of an Atlas answering every request with the status
+// and body. The v20231115002 SDK can't be mocked: its Execute methods are unexported.
+func newClient(t *testing.T, status int, body any) privateendpointservice.PrivateEndpointServicesAPI {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(srv.Close)
+	client, err := admin.NewClient(admin.UseBaseURL(srv.URL))
+	require.NoError(t, err)
+	return client.PrivateEndpointServicesApi
+}
+
+func TestCreate(t *testing.T) {
+	client := newClient(t, http.StatusCreated, admin.EndpointService{Id: admin.PtrString(serviceID)})
+
+	event := privateendpointservice.Create(client, "US_EAST_1", groupID)
+	assert.Equal(t, handler.InProgress, event.OperationStatus, event.Message)
+	assert.Equal(t, map[string]any{"StateName": string(constants.CreatingPrivateEndpointService), "ID": serviceID}, event.CallbackContext)
+}
+
+func TestCreateAlreadyExists(t *testing.T) {
+	client := newClient(t, http.StatusConflict, admin.ApiError{Error: admin.PtrInt(http.StatusConflict)})
+
+	event := privateendpointservice.Create(client, "US_EAST_1", groupID)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeAlreadyExists, event.HandlerErrorCode)
+}
+
+func TestValidateCreationCompletion(t *testing.T) {
+	testCases := map[string]struct {
+		status    string
+		available bool
+		operation handler.Status
+	}{
+		"initiating": {status: privateendpointservice.InitiatingStatus, operation: handler.InProgress},
+		"available":  {status: privateendpointservice.AvailableStatus, available: true},
+		"failed":     {status: "FAILED", operation: handler.Failed},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := newClient(t, http.StatusOK, admin.EndpointService{Id: admin.PtrString(serviceID), Status: admin.PtrString(tc.status)})
+			req := handler.NewRequest("", map[string]any{"StateName": string(constants.CreatingPrivateEndpointService), "ID": serviceID},
+				handler.RequestContext{}, nil, nil, nil, nil)
+			poller := &progressevent.Poller{TargetStates: []string{privateendpointservice.AvailableStatus}, MinDelaySeconds: 20}
+
+			service, event := privateendpointservice.ValidateCreationCompletion(client, groupID, req, poller)
+			if tc.available {
+				require.Nil(t, event)
+				assert.Equal(t, serviceID, service.GetId())
+				return
+			}
+			require.NotNil(t, event)
+			assert.Nil(t, service)
+			assert.Equal(t, tc.operation, event.OperationStatus)
+		})
+	}
+}
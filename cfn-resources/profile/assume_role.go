@@ -0,0 +1,174 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/cache"
+)
+
+const DefaultAssumeRoleSessionName = "mongodbatlas-cloudformation-resources"
+
+// sessions caches the sessions of the assumed roles while the Lambda is warm,
+// their credentials are refreshed by the SDK before they expire.
+var sessions = cache.NewTTL[string, *session.Session](CacheTTL())
+
+// AssumeRole is the role assumed to read the Atlas credentials, e.g. from a shared-services account.
+type AssumeRole struct {
+	Tags              map[string]string
+	RoleARN           string
+	ExternalID        string
+	Policy            string
+	SessionName       string
+	SourceIdentity    string
+	PolicyARNs        []string
+	TransitiveTagKeys []string
+	Duration          time.Duration
+}
+
+type assumeRoleJSON struct {
+	Tags              map[string]string `json:"Tags,omitempty"`
+	RoleARN           string            `json:"RoleArn"`
+	ExternalID        string            `json:"ExternalId,omitempty"`
+	Policy            string            `json:"Policy,omitempty"`
+	SessionName       string            `json:"SessionName,omitempty"`
+	SourceIdentity    string            `json:"SourceIdentity,omitempty"`
+	Duration          string            `json:"Duration,omitempty"`
+	PolicyARNs        []string          `json:"PolicyArns,omitempty"`
+	TransitiveTagKeys []string          `json:"TransitiveTagKeys,omitempty"`
+}
+
+// UnmarshalJSON reads the role from the profile secret, where the Duration is a Go duration such as 1h.
+func (r *AssumeRole) UnmarshalJSON(data []byte) error {
+	var v assumeRoleJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.RoleARN == "" {
+		return errors.New("AssumeRole.RoleArn is required")
+	}
+	var duration time.Duration
+	if v.Duration != "" {
+		var err error
+		if duration, err = time.ParseDuration(v.Duration); err != nil {
+			return fmt.Errorf("invalid AssumeRole.Duration: %w", err)
+		}
+	}
+	*r = AssumeRole{
+		Tags:              v.Tags,
+		RoleARN:           v.RoleARN,
+		ExternalID:        v.ExternalID,
+		Policy:            v.Policy,
+		SessionName:       v.SessionName,
+		SourceIdentity:    v.SourceIdentity,
+		PolicyARNs:        v.PolicyARNs,
+		TransitiveTagKeys: v.TransitiveTagKeys,
+		Duration:          duration,
+	}
+	return nil
+}
+
+func (r *AssumeRole) MarshalJSON() ([]byte, error) {
+	v := assumeRoleJSON{
+		Tags:              r.Tags,
+		RoleARN:           r.RoleARN,
+		ExternalID:        r.ExternalID,
+		Policy:            r.Policy,
+		SessionName:       r.SessionName,
+		SourceIdentity:    r.SourceIdentity,
+		PolicyARNs:        r.PolicyARNs,
+		TransitiveTagKeys: r.TransitiveTagKeys,
+	}
+	if r.Duration > 0 {
+		v.Duration = r.Duration.String()
+	}
+	return json.Marshal(v)
+}
+
+// AssumeRoleSession returns a session using the credentials of the role, assumed with the base session.
+// The sessions are cached by region, role and client, client is the STS client to use, sts.New(base) if nil.
+func AssumeRoleSession(base *session.Session, role *AssumeRole, client stscreds.AssumeRoler) (*session.Session, error) {
+	roleJSON, err := json.Marshal(role)
+	if err != nil {
+		return nil, err
+	}
+	key := aws.StringValue(base.Config.Region) + "/" + string(roleJSON)
+	if client != nil {
+		key += fmt.Sprintf("/%T@%p", client, client)
+	}
+
+	return sessions.GetOrLoad(key, func() (*session.Session, error) {
+		if client == nil {
+			client = sts.New(base)
+		}
+		creds := stscreds.NewCredentialsWithClient(client, role.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+			p.RoleSessionName = DefaultAssumeRoleSessionName
+			if role.SessionName != "" {
+				p.RoleSessionName = role.SessionName
+			}
+			if role.Duration > 0 {
+				p.Duration = role.Duration
+			}
+			if role.ExternalID != "" {
+				p.ExternalID = aws.String(role.ExternalID)
+			}
+			if role.Policy != "" {
+				p.Policy = aws.String(role.Policy)
+			}
+			if role.SourceIdentity != "" {
+				p.SourceIdentity = aws.String(role.SourceIdentity)
+			}
+			for _, arn := range role.PolicyARNs {
+				p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{Arn: aws.String(arn)})
+			}
+			for k, v := range role.Tags {
+				p.Tags = append(p.Tags, &sts.Tag{Key: aws.String(k), Value: aws.String(v)})
+			}
+			p.TransitiveTagKeys = aws.StringSlice(role.TransitiveTagKeys)
+		})
+		return session.NewSession(base.Config.Copy(&aws.Config{Credentials: creds}))
+	})
+}
+
+// newAssumedRoleProfile reads the profile that p points to with its role, the secret is p.SecretID if set or the
+// same secret name otherwise. Cross-account secrets must be referenced by ARN.
+func newAssumedRoleProfile(base *session.Session, secretID string, p *Profile) (*Profile, error) {
+	sess, err := AssumeRoleSession(base, p.AssumeRole, nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.SecretID != "" {
+		secretID = p.SecretID
+	}
+
+	assumed, err := (&SecretsManagerProvider{Session: sess}).GetProfile(secretID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s with role %s: %w", secretID, p.AssumeRole.RoleARN, err)
+	}
+	if assumed.AssumeRole != nil {
+		return nil, fmt.Errorf("the profile %s read with role %s can not assume another role", secretID, p.AssumeRole.RoleARN)
+	}
+	assumed.AssumeRole = p.AssumeRole
+	assumed.version = p.version + "/" + assumed.version
+	return assumed, nil
+}
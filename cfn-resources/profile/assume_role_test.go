@@ -0,0 +1,129 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profile_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSTS struct {
+	inputs []*sts.AssumeRoleInput
+}
+
+func (s *stubSTS) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	s.inputs = append(s.inputs, input)
+	return &sts.AssumeRoleOutput{Credentials: &sts.Credentials{
+		AccessKeyId:     aws.String("assumed-id"),
+		SecretAccessKey: aws.String("assumed-secret"),
+		SessionToken:    aws.String("assumed-token"),
+		Expiration:      aws.Time(time.Now().Add(time.Hour)),
+	}}, nil
+}
+
+func (s *stubSTS) AssumeRoleWithContext(_ aws.Context, input *sts.AssumeRoleInput, _ ...request.Option) (*sts.AssumeRoleOutput, error) {
+	return s.AssumeRole(input)
+}
+
+func newBaseSession(t *testing.T) *session.Session {
+	t.Helper()
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials("base-id", "base-secret", ""),
+	})
+	require.NoError(t, err)
+	return sess
+}
+
+func TestAssumeRoleSession(t *testing.T) {
+	stub := new(stubSTS)
+	role := &profile.AssumeRole{
+		RoleARN:     "arn:aws:iam::111111111111:role/atlas-credentials",
+		ExternalID:  "external",
+		SessionName: "workload",
+		Duration:    30 * time.Minute,
+		Tags:        map[string]string{"team": "data"},
+	}
+
+	sess, err := profile.AssumeRoleSession(newBaseSession(t), role, stub)
+	require.NoError(t, err)
+	creds, err := sess.Config.Credentials.Get()
+	require.NoError(t, err)
+	assert.Equal(t, "assumed-id", creds.AccessKeyID)
+
+	require.Len(t, stub.inputs, 1)
+	input := stub.inputs[0]
+	assert.Equal(t, role.RoleARN, aws.StringValue(input.RoleArn))
+	assert.Equal(t, "external", aws.StringValue(input.ExternalId))
+	assert.Equal(t, "workload", aws.StringValue(input.RoleSessionName))
+	assert.Equal(t, int64(1800), aws.Int64Value(input.DurationSeconds))
+	require.Len(t, input.Tags, 1)
+	assert.Equal(t, "team", aws.StringValue(input.Tags[0].Key))
+
+	// the session and its credentials are reused
+	cached, err := profile.AssumeRoleSession(newBaseSession(t), role, stub)
+	require.NoError(t, err)
+	assert.Same(t, sess, cached)
+	_, err = cached.Config.Credentials.Get()
+	require.NoError(t, err)
+	assert.Len(t, stub.inputs, 1)
+
+	other := *role
+	other.ExternalID = "other"
+	otherSess, err := profile.AssumeRoleSession(newBaseSession(t), &other, stub)
+	require.NoError(t, err)
+	assert.NotSame(t, sess, otherSess)
+}
+
+func TestAssumeRoleSessionPerClient(t *testing.T) {
+	role := &profile.AssumeRole{RoleARN: "arn:aws:iam::111111111111:role/atlas-per-client"}
+	first, second := new(stubSTS), new(stubSTS)
+
+	sess, err := profile.AssumeRoleSession(newBaseSession(t), role, first)
+	require.NoError(t, err)
+	otherSess, err := profile.AssumeRoleSession(newBaseSession(t), role, second)
+	require.NoError(t, err)
+	assert.NotSame(t, sess, otherSess)
+
+	_, err = otherSess.Config.Credentials.Get()
+	require.NoError(t, err)
+	assert.Empty(t, first.inputs)
+	assert.Len(t, second.inputs, 1)
+}
+
+func TestAssumeRoleJSON(t *testing.T) {
+	var p profile.Profile
+	secret := `{"AssumeRole": {"RoleArn": "arn:aws:iam::111111111111:role/atlas", "ExternalId": "ext", "Duration": "1h"},
+		"SecretId": "arn:aws:secretsmanager:us-east-1:111111111111:secret:cfn/atlas/profile/shared"}`
+	require.NoError(t, json.Unmarshal([]byte(secret), &p))
+	require.NotNil(t, p.AssumeRole)
+	assert.Equal(t, "arn:aws:iam::111111111111:role/atlas", p.AssumeRole.RoleARN)
+	assert.Equal(t, "ext", p.AssumeRole.ExternalID)
+	assert.Equal(t, time.Hour, p.AssumeRole.Duration)
+	assert.Equal(t, "arn:aws:secretsmanager:us-east-1:111111111111:secret:cfn/atlas/profile/shared", p.SecretID)
+
+	require.Error(t, json.Unmarshal([]byte(`{"AssumeRole": {"ExternalId": "ext"}}`), &p))
+	require.Error(t, json.Unmarshal([]byte(`{"AssumeRole": {"RoleArn": "arn", "Duration": "soon"}}`), &p))
+}
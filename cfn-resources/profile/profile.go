@@ -15,25 +15,40 @@
 package profile
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/cache"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
 )
 
 const (
-	DefaultProfile = "default"
+	DefaultProfile  = "default"
+	DefaultCacheTTL = 5 * time.Minute
 )
 
+// profiles caches the profiles read from the backends while the Lambda is warm,
+// so callback invocations don't read the secret again.
+var profiles = cache.NewTTL[string, *Profile](CacheTTL())
+
+// Profile holds the credentials used to call Atlas, either programmatic API keys (PublicKey and PrivateKey)
+// or a service account (ClientId and ClientSecret). The service account is used when both of its fields are set.
+// A profile with AssumeRole only points to the profile to use, read with the role from SecretId.
 type Profile struct {
-	DebugClient *bool  `json:"DebugClient,omitempty"`
-	PublicKey   string `json:"PublicKey"`
-	PrivateKey  string `json:"PrivateKey"`
-	BaseURL     string `json:"BaseUrl,omitempty"`
+	DebugClient  *bool       `json:"DebugClient,omitempty"`
+	AssumeRole   *AssumeRole `json:"AssumeRole,omitempty"`
+	PublicKey    string      `json:"PublicKey"`
+	PrivateKey   string      `json:"PrivateKey"`
+	ClientID     string      `json:"ClientId,omitempty"`
+	ClientSecret string      `json:"ClientSecret,omitempty"`
+	BaseURL      string      `json:"BaseUrl,omitempty"`
+	SecretID     string      `json:"SecretId,omitempty"`
+	key          string
+	version      string
 }
 
 func NewProfile(req *handler.Request, profileName *string, prefixRequired bool) (*Profile, error) {
@@ -41,23 +56,65 @@ func NewProfile(req *handler.Request, profileName *string, prefixRequired bool)
 		profileName = aws.String(DefaultProfile)
 	}
 
-	secretsManagerClient := secretsmanager.New(req.Session)
+	// secrets named without the prefix are created by the organization resource, always in Secrets Manager.
 	secretID := *profileName
+	var provider ProfileProvider = &SecretsManagerProvider{Session: req.Session}
 	if prefixRequired {
 		secretID = SecretNameWithPrefix(*profileName)
+		var err error
+		if provider, err = NewProvider(req); err != nil {
+			return nil, err
+		}
 	}
-	resp, err := secretsManagerClient.GetSecretValue(&secretsmanager.GetSecretValueInput{SecretId: &secretID})
+
+	key := strings.Join([]string{req.RequestContext.AccountID, req.RequestContext.Region, provider.Backend(), secretID}, "/")
+	cached, err := profiles.GetOrLoad(key, func() (*Profile, error) {
+		profile, err := provider.GetProfile(secretID)
+		if err != nil {
+			return nil, err
+		}
+		if profile.AssumeRole != nil {
+			if profile, err = newAssumedRoleProfile(req.Session, secretID, profile); err != nil {
+				return nil, err
+			}
+		}
+		profile.key = key
+		return profile, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	profile := new(Profile)
-	err = json.Unmarshal([]byte(*resp.SecretString), &profile)
-	if err != nil {
-		return nil, err
+	profile := *cached
+	return &profile, nil
+}
+
+// CacheTTL returns how long profiles and clients are kept while the Lambda is warm,
+// it can be changed with MONGODB_ATLAS_PROFILE_CACHE_TTL, e.g. 10m, a zero duration disables the cache.
+func CacheTTL() time.Duration {
+	if v := os.Getenv("MONGODB_ATLAS_PROFILE_CACHE_TTL"); v != "" {
+		if ttl, err := time.ParseDuration(v); err == nil {
+			return ttl
+		}
 	}
+	return DefaultCacheTTL
+}
 
-	return profile, nil
+// CacheKey identifies the credentials of the profile: the backend and secret it was read from and its version.
+// It's empty for profiles not read with NewProfile, which must not be cached.
+func (p *Profile) CacheKey() string {
+	if p.key == "" {
+		return ""
+	}
+	return p.key + "@" + p.version
+}
+
+// Invalidate removes the profile from the cache, so the next NewProfile reads the secret again.
+// It's used when Atlas rejects the profile credentials, e.g. after they are rotated.
+func Invalidate(p *Profile) {
+	if p != nil && p.key != "" {
+		profiles.Delete(p.key)
+	}
 }
 
 func (p *Profile) NewBaseURL() string {
@@ -84,6 +141,27 @@ func (p *Profile) NewPrivateKey() string {
 	return p.PrivateKey
 }
 
+func (p *Profile) NewClientID() string {
+	if id := os.Getenv("MONGODB_ATLAS_CLIENT_ID"); id != "" {
+		return id
+	}
+
+	return p.ClientID
+}
+
+func (p *Profile) NewClientSecret() string {
+	if secret := os.Getenv("MONGODB_ATLAS_CLIENT_SECRET"); secret != "" {
+		return secret
+	}
+
+	return p.ClientSecret
+}
+
+// UseServiceAccount reports if the profile authenticates with a service account instead of API keys.
+func (p *Profile) UseServiceAccount() bool {
+	return p.NewClientID() != "" && p.NewClientSecret() != ""
+}
+
 func (p *Profile) AreKeysAvailable() bool {
 	return p.NewPublicKey() == "" || p.PrivateKey == ""
 }
@@ -16,6 +16,7 @@ package profile_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/stretchr/testify/assert"
@@ -32,3 +33,27 @@ func Test_UseDebug(t *testing.T) {
 	profileTrue := profile.Profile{DebugClient: &trueBool}
 	assert.True(t, profileTrue.UseDebug())
 }
+
+func Test_UseServiceAccount(t *testing.T) {
+	apiKeys := profile.Profile{PublicKey: "public", PrivateKey: "private"}
+	assert.False(t, apiKeys.UseServiceAccount())
+
+	serviceAccount := profile.Profile{ClientID: "mdb_sa_id", ClientSecret: "mdb_sa_sk"}
+	assert.True(t, serviceAccount.UseServiceAccount())
+
+	missingSecret := profile.Profile{ClientID: "mdb_sa_id"}
+	assert.False(t, missingSecret.UseServiceAccount())
+	t.Setenv("MONGODB_ATLAS_CLIENT_SECRET", "env_secret")
+	assert.True(t, missingSecret.UseServiceAccount())
+	assert.Equal(t, "env_secret", missingSecret.NewClientSecret())
+}
+
+func Test_CacheTTL(t *testing.T) {
+	assert.Equal(t, profile.DefaultCacheTTL, profile.CacheTTL())
+	t.Setenv("MONGODB_ATLAS_PROFILE_CACHE_TTL", "10m")
+	assert.Equal(t, 10*time.Minute, profile.CacheTTL())
+	t.Setenv("MONGODB_ATLAS_PROFILE_CACHE_TTL", "0s")
+	assert.Zero(t, profile.CacheTTL())
+	t.Setenv("MONGODB_ATLAS_PROFILE_CACHE_TTL", "invalid")
+	assert.Equal(t, profile.DefaultCacheTTL, profile.CacheTTL())
+}
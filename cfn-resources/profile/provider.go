@@ -0,0 +1,199 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/cfnerr"
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+)
+
+// Backends where the profiles can be read from, selected with the ProfileBackend type configuration.
+const (
+	BackendSecretsManager = "SecretsManager"
+	BackendParameterStore = "ParameterStore"
+	BackendEnvironment    = "Environment"
+	BackendFile           = "File"
+)
+
+const DefaultProfileFile = "profiles.json"
+
+// ProfileProvider reads profiles from a credentials backend.
+type ProfileProvider interface {
+	// Backend returns the name of the backend, used to cache the profiles.
+	Backend() string
+	// GetProfile returns the profile stored under name, which includes the cfn/atlas/profile prefix when required.
+	GetProfile(name string) (*Profile, error)
+}
+
+// TypeConfiguration holds the properties of the resource type configuration used to read the profiles,
+// it's shared by all the resources.
+type TypeConfiguration struct {
+	ProfileBackend *string `json:",omitempty"`
+	ProfileFile    *string `json:",omitempty"`
+}
+
+// NewProvider returns the provider selected by the type configuration of the request, Secrets Manager by default.
+// MONGODB_ATLAS_PROFILE_BACKEND and MONGODB_ATLAS_PROFILE_FILE take precedence, e.g. for local testing.
+func NewProvider(req *handler.Request) (ProfileProvider, error) {
+	config := new(TypeConfiguration)
+	if err := req.UnmarshalTypeConfig(config); err != nil {
+		var cfnErr cfnerr.Error
+		if !errors.As(err, &cfnErr) || cfnErr.Code() != "BodyEmpty" {
+			return nil, fmt.Errorf("invalid type configuration: %w", err)
+		}
+	}
+
+	backend := aws.StringValue(config.ProfileBackend)
+	if v := os.Getenv("MONGODB_ATLAS_PROFILE_BACKEND"); v != "" {
+		backend = v
+	}
+	path := aws.StringValue(config.ProfileFile)
+	if v := os.Getenv("MONGODB_ATLAS_PROFILE_FILE"); v != "" {
+		path = v
+	}
+
+	switch backend {
+	case "", BackendSecretsManager:
+		return &SecretsManagerProvider{Session: req.Session}, nil
+	case BackendParameterStore:
+		return &ParameterStoreProvider{Session: req.Session}, nil
+	case BackendEnvironment:
+		return EnvironmentProvider{}, nil
+	case BackendFile:
+		if path == "" {
+			path = DefaultProfileFile
+		}
+		return &FileProvider{Path: path}, nil
+	default:
+		return nil, fmt.Errorf("unknown profile backend %q, valid values are %s, %s, %s and %s",
+			backend, BackendSecretsManager, BackendParameterStore, BackendEnvironment, BackendFile)
+	}
+}
+
+// SecretsManagerProvider reads the profiles from Secrets Manager secrets containing the profile as JSON.
+type SecretsManagerProvider struct {
+	Session *session.Session
+}
+
+func (p *SecretsManagerProvider) Backend() string {
+	return BackendSecretsManager
+}
+
+func (p *SecretsManagerProvider) GetProfile(name string) (*Profile, error) {
+	resp, err := secretsmanager.New(p.Session).GetSecretValue(&secretsmanager.GetSecretValueInput{SecretId: &name})
+	if err != nil {
+		return nil, err
+	}
+
+	profile := new(Profile)
+	if err := json.Unmarshal([]byte(aws.StringValue(resp.SecretString)), profile); err != nil {
+		return nil, err
+	}
+	profile.version = aws.StringValue(resp.VersionId)
+	return profile, nil
+}
+
+// ParameterStoreProvider reads the profiles from SSM SecureString parameters containing the profile as JSON,
+// named like the secrets with a leading slash, e.g. /cfn/atlas/profile/default.
+type ParameterStoreProvider struct {
+	Session *session.Session
+}
+
+func (p *ParameterStoreProvider) Backend() string {
+	return BackendParameterStore
+}
+
+func (p *ParameterStoreProvider) GetProfile(name string) (*Profile, error) {
+	if strings.Contains(name, "/") && !strings.HasPrefix(name, "/") {
+		name = "/" + name
+	}
+	resp, err := ssm.New(p.Session).GetParameter(&ssm.GetParameterInput{Name: &name, WithDecryption: aws.Bool(true)})
+	if err != nil {
+		return nil, err
+	}
+
+	profile := new(Profile)
+	if err := json.Unmarshal([]byte(aws.StringValue(resp.Parameter.Value)), profile); err != nil {
+		return nil, err
+	}
+	profile.version = strconv.FormatInt(aws.Int64Value(resp.Parameter.Version), 10)
+	return profile, nil
+}
+
+// EnvironmentProvider reads a single profile from the MONGODB_ATLAS_* environment variables, whatever its name.
+type EnvironmentProvider struct{}
+
+func (EnvironmentProvider) Backend() string {
+	return BackendEnvironment
+}
+
+func (EnvironmentProvider) GetProfile(_ string) (*Profile, error) {
+	profile := &Profile{
+		PublicKey:    os.Getenv("MONGODB_ATLAS_PUBLIC_KEY"),
+		PrivateKey:   os.Getenv("MONGODB_ATLAS_PRIVATE_KEY"),
+		ClientID:     os.Getenv("MONGODB_ATLAS_CLIENT_ID"),
+		ClientSecret: os.Getenv("MONGODB_ATLAS_CLIENT_SECRET"),
+		BaseURL:      os.Getenv("MONGODB_ATLAS_BASE_URL"),
+	}
+	if !profile.UseServiceAccount() && (profile.PublicKey == "" || profile.PrivateKey == "") {
+		return nil, errors.New("MONGODB_ATLAS_PUBLIC_KEY and MONGODB_ATLAS_PRIVATE_KEY, or MONGODB_ATLAS_CLIENT_ID and MONGODB_ATLAS_CLIENT_SECRET, must be set")
+	}
+	return profile, nil
+}
+
+// FileProvider reads the profiles from a local JSON file mapping the profile names to the profiles, e.g.
+// {"default": {"PublicKey": "...", "PrivateKey": "..."}}. It's meant for local testing of the handlers.
+type FileProvider struct {
+	Path string
+}
+
+func (p *FileProvider) Backend() string {
+	return BackendFile
+}
+
+func (p *FileProvider) GetProfile(name string) (*Profile, error) {
+	info, err := os.Stat(p.Path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(p.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles map[string]*Profile
+	if err := json.Unmarshal(content, &profiles); err != nil {
+		return nil, fmt.Errorf("invalid profile file %s: %w", p.Path, err)
+	}
+	name = strings.TrimPrefix(name, constants.ProfileNamePrefix+"/")
+	profile, ok := profiles[name]
+	if !ok || profile == nil {
+		return nil, fmt.Errorf("profile %s not found in %s", name, p.Path)
+	}
+	profile.version = info.ModTime().String()
+	return profile, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profile_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(typeConfig string) *handler.Request {
+	req := handler.NewRequest("id", nil, handler.RequestContext{}, nil, nil, nil, []byte(typeConfig))
+	return &req
+}
+
+func TestNewProvider(t *testing.T) {
+	testCases := map[string]struct {
+		typeConfig string
+		backend    string
+		wantErr    bool
+	}{
+		"no type configuration": {backend: profile.BackendSecretsManager},
+		"secrets manager":       {typeConfig: `{"ProfileBackend": "SecretsManager"}`, backend: profile.BackendSecretsManager},
+		"parameter store":       {typeConfig: `{"ProfileBackend": "ParameterStore"}`, backend: profile.BackendParameterStore},
+		"environment":           {typeConfig: `{"ProfileBackend": "Environment"}`, backend: profile.BackendEnvironment},
+		"file":                  {typeConfig: `{"ProfileBackend": "File", "ProfileFile": "/tmp/profiles.json"}`, backend: profile.BackendFile},
+		"unknown":               {typeConfig: `{"ProfileBackend": "Vault"}`, wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			provider, err := profile.NewProvider(newRequest(tc.typeConfig))
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.backend, provider.Backend())
+		})
+	}
+}
+
+func TestNewProviderEnvironmentOverride(t *testing.T) {
+	t.Setenv("MONGODB_ATLAS_PROFILE_BACKEND", profile.BackendFile)
+	t.Setenv("MONGODB_ATLAS_PROFILE_FILE", "local.json")
+	provider, err := profile.NewProvider(newRequest(`{"ProfileBackend": "SecretsManager"}`))
+	require.NoError(t, err)
+	assert.Equal(t, &profile.FileProvider{Path: "local.json"}, provider)
+}
+
+func TestEnvironmentProvider(t *testing.T) {
+	t.Setenv("MONGODB_ATLAS_PUBLIC_KEY", "")
+	t.Setenv("MONGODB_ATLAS_PRIVATE_KEY", "")
+	t.Setenv("MONGODB_ATLAS_CLIENT_ID", "")
+	t.Setenv("MONGODB_ATLAS_CLIENT_SECRET", "")
+	_, err := profile.EnvironmentProvider{}.GetProfile("default")
+	require.Error(t, err)
+
+	t.Setenv("MONGODB_ATLAS_PUBLIC_KEY", "public")
+	t.Setenv("MONGODB_ATLAS_PRIVATE_KEY", "private")
+	p, err := profile.EnvironmentProvider{}.GetProfile("default")
+	require.NoError(t, err)
+	assert.Equal(t, "public", p.PublicKey)
+	assert.Equal(t, "private", p.PrivateKey)
+}
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	content := `{"default": {"PublicKey": "public", "PrivateKey": "private"}, "sa": {"ClientId": "id", "ClientSecret": "secret"}}`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	provider := &profile.FileProvider{Path: path}
+
+	p, err := provider.GetProfile(profile.SecretNameWithPrefix("default"))
+	require.NoError(t, err)
+	assert.Equal(t, "public", p.PublicKey)
+
+	p, err = provider.GetProfile("sa")
+	require.NoError(t, err)
+	assert.True(t, p.UseServiceAccount())
+
+	_, err = provider.GetProfile("missing")
+	require.Error(t, err)
+}
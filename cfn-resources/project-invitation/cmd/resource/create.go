@@ -16,43 +16,45 @@ package resource
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
-	log "github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/idempotency"
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("Create() currentModel:%+v", currentModel)
-
-	errValidation := validateModel(CreateRequiredFields, currentModel)
-	if errValidation != nil {
-		return *errValidation, nil
-	}
-
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if !util.IsStringPresent(currentModel.Profile) {
 		currentModel.Profile = aws.String(profile.DefaultProfile)
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+	binding := idempotency.New(&req, currentModel)
+	defer binding.Done()
 	invitationReq := &admin.GroupInvitationRequest{
 		Roles:    currentModel.Roles,
 		Username: currentModel.Username,
 	}
 
-	invitation, res, err := client.Atlas20231115002.ProjectsApi.CreateProjectInvitation(context.Background(), *currentModel.ProjectId, invitationReq).Execute()
+	var invitation *admin.GroupInvitation
+	var res *http.Response
+	_, _, err := binding.Create(func(id string) (bool, error) {
+		var err error
+		invitation, res, err = client.Atlas20231115002.ProjectsApi.GetProjectInvitation(context.Background(), *currentModel.ProjectId, id).Execute()
+		if progressevents.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}, func() (string, error) {
+		var err error
+		invitation, res, err = client.Atlas20231115002.ProjectsApi.CreateProjectInvitation(context.Background(), *currentModel.ProjectId, invitationReq).Execute()
+		return invitation.GetId(), err
+	})
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	}
 	currentModel.Id = invitation.Id
 
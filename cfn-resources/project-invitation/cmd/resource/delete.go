@@ -21,38 +21,27 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/idempotency"
 	log "github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("Delete() currentModel:%+v", currentModel)
-	errValidation := validateModel(DeleteRequiredFields, currentModel)
-	if errValidation != nil {
-		return *errValidation, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if !util.IsStringPresent(currentModel.Profile) {
 		currentModel.Profile = aws.String(profile.DefaultProfile)
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	params := &admin.DeleteProjectInvitationApiParams{
 		GroupId:      *currentModel.ProjectId,
 		InvitationId: *currentModel.Id,
 	}
 	_, resp, err := client.Atlas20231115002.ProjectsApi.DeleteProjectInvitationWithParams(context.Background(), params).Execute()
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevents.GetFailedEventByError(err, resp), nil
 	}
 	_, _ = log.Debugf("deleted invitation with Id :%s", *currentModel.Id)
+	idempotency.Clear(&req)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -21,30 +21,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
-	log "github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("List() currentModel:%+v", currentModel)
-
-	errValidation := validateModel(ListRequiredFields, currentModel)
-	if errValidation != nil {
-		return *errValidation, nil
-	}
-
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if !util.IsStringPresent(currentModel.Profile) {
 		currentModel.Profile = aws.String(profile.DefaultProfile)
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	listOptions := &admin.ListProjectInvitationsApiParams{
 		Username: currentModel.Username,
 		GroupId:  *currentModel.ProjectId,
@@ -52,7 +37,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 
 	invitations, res, err := client.Atlas20231115002.ProjectsApi.ListProjectInvitationsWithParams(context.Background(), listOptions).Execute()
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	}
 
 	var invites []interface{}
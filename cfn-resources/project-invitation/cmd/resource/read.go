@@ -25,24 +25,11 @@ import (
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 )
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	_, _ = log.Debugf("Read() currentModel:%+v", currentModel)
-
-	errValidation := validateModel(ReadRequiredFields, currentModel)
-	if errValidation != nil {
-		return *errValidation, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if !util.IsStringPresent(currentModel.Profile) {
 		currentModel.Profile = aws.String(profile.DefaultProfile)
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	invitation, res, err := client.Atlas20231115002.ProjectsApi.GetProjectInvitation(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
 	if err != nil {
 		_, _ = log.Warnf("Read - error: %+v", err)
@@ -52,7 +39,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 			}
 		}
 
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	}
 
 	return handler.ProgressEvent{
@@ -0,0 +1,48 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/project-invitation/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/invites/{invitationId}", admin.GroupInvitation{
+		Id:              admin.PtrString("650000000000000000000002"),
+		GroupId:         admin.PtrString("650000000000000000000001"),
+		Username:        admin.PtrString("user@example.com"),
+		InviterUsername: admin.PtrString("admin@example.com"),
+		Roles:           []string{"GROUP_READ_ONLY"},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "project invitation",
+		Schema:         "../../mongodb-atlas-projectinvitation.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "Id": "650000000000000000000002",
+				"Username": "user@example.com", "Roles": ["GROUP_READ_ONLY"]}`,
+		}},
+	})
+}
@@ -26,32 +26,20 @@ import (
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	_, _ = log.Warnf("Update() currentModel:%+v", currentModel)
 
-	errValidation := validateModel(UpdateRequiredFields, currentModel)
-	if errValidation != nil {
-		return *errValidation, nil
-	}
-
 	if !util.IsStringPresent(currentModel.Profile) {
 		currentModel.Profile = aws.String(profile.DefaultProfile)
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	invitationReq := &admin.GroupInvitationUpdateRequest{
 		Roles: currentModel.Roles,
 	}
 
 	invitation, res, err := client.Atlas20231115002.ProjectsApi.UpdateProjectInvitationById(context.Background(), *currentModel.ProjectId, *currentModel.Id, invitationReq).Execute()
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	}
 	_, _ = log.Debugf("%s invitation updated", *currentModel.Id)
 
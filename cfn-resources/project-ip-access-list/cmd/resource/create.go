@@ -25,41 +25,37 @@ import (
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 )
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validateModel(CreateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if len(currentModel.AccessList) == 0 {
 		return progressevents.GetFailedEventByCode("AccessList must not be empty", cloudformation.HandlerErrorCodeInvalidRequest), nil
 	}
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
 	// Create atlas client
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
 
-	event, err := createEntries(currentModel, client)
+	adopted, event, err := createEntries(currentModel, client, progressevents.TypeAdoptIfExists(&req))
 	if event.OperationStatus == handler.Failed || err != nil {
 		return event, nil
 	}
 
+	message := "Create Complete"
+	if adopted {
+		message = progressevents.AdoptedMessage("access list of the project", *currentModel.ProjectId)
+	}
+
 	_, _ = logger.Debugf("Create --- currentModel:%+v", currentModel)
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		Message:         "Create Complete",
+		Message:         message,
 		ResourceModel:   currentModel,
 	}, nil
 }
 
-func createEntries(model *Model, client *util.MongoDBClient) (handler.ProgressEvent, error) {
+// createEntries adds the entries of the model to the access list. It fails if one of them is already in it unless
+// adopt is set, Atlas then updates the existing entries and adopted is returned.
+func createEntries(model *Model, client *util.MongoDBClient, adopt bool) (adopted bool, event handler.ProgressEvent, err error) {
 	request, err := newPaginatedNetworkAccess(model)
 	if err != nil {
-		return handler.ProgressEvent{
+		return false, handler.ProgressEvent{
 			Message:          "Error in parsing the resource schema",
 			OperationStatus:  handler.Failed,
 			HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}, err
@@ -67,26 +63,27 @@ func createEntries(model *Model, client *util.MongoDBClient) (handler.ProgressEv
 
 	projectID := *model.ProjectId
 
-	if isEntryAlreadyInAccessList, err := isEntryAlreadyInAccessList(client, model); isEntryAlreadyInAccessList || err != nil {
-		if err != nil {
-			return handler.ProgressEvent{
-				Message:          fmt.Sprintf("Error validating entries: %s", err.Error()),
-				OperationStatus:  handler.Failed,
-				HandlerErrorCode: cloudformation.HandlerErrorCodeInternalFailure}, err
-		}
-		return handler.ProgressEvent{
+	isEntryAlreadyInAccessList, err := isEntryAlreadyInAccessList(client, model)
+	if err != nil {
+		return false, handler.ProgressEvent{
+			Message:          fmt.Sprintf("Error validating entries: %s", err.Error()),
+			OperationStatus:  handler.Failed,
+			HandlerErrorCode: cloudformation.HandlerErrorCodeInternalFailure}, err
+	}
+	if isEntryAlreadyInAccessList && !adopt {
+		return false, handler.ProgressEvent{
 			Message:          "Entry already exists in the access list",
 			OperationStatus:  handler.Failed,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}, err
+			HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}, nil
 	}
 
 	if _, _, err := client.Atlas20231115002.ProjectIPAccessListApi.CreateProjectIpAccessList(context.Background(), projectID, &request.Results).Execute(); err != nil {
 		_, _ = logger.Warnf("Error createEntries projectId:%s, err:%+v", projectID, err)
-		return handler.ProgressEvent{
+		return false, handler.ProgressEvent{
 			Message:          err.Error(),
 			OperationStatus:  handler.Failed,
 			HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, err
 	}
 
-	return handler.ProgressEvent{}, nil
+	return isEntryAlreadyInAccessList, handler.ProgressEvent{}, nil
 }
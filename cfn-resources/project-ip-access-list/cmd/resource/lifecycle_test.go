@@ -0,0 +1,102 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/project-ip-access-list/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+func TestLifecycle(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	model := &resource.Model{
+		ProjectId: aws.String(projectID),
+		AccessList: []resource.AccessListDefinition{
+			{CIDRBlock: aws.String("10.0.0.0/16"), Comment: aws.String("vpc")},
+			{IPAddress: aws.String("192.168.1.1")},
+		},
+	}
+
+	event, _ := fakeatlas.Run(t, s, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, _ = fakeatlas.Run(t, s, resource.Create, nil, model)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeAlreadyExists, event.HandlerErrorCode)
+
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{ProjectId: aws.String(projectID)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, 2, aws.IntValue(read.TotalCount))
+
+	updated := *model
+	updated.AccessList = []resource.AccessListDefinition{{CIDRBlock: aws.String("10.1.0.0/16")}}
+	event, _ = fakeatlas.Run(t, s, resource.Update, model, &updated)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, _ = fakeatlas.Run(t, s, resource.List, nil, &resource.Model{ProjectId: aws.String(projectID)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.Len(t, event.ResourceModels, 1)
+	listed, ok := event.ResourceModels[0].(*resource.Model)
+	require.True(t, ok)
+	require.Len(t, listed.AccessList, 1)
+	assert.Equal(t, "10.1.0.0/16", aws.StringValue(listed.AccessList[0].CIDRBlock))
+
+	event, _ = fakeatlas.Run(t, s, resource.Delete, nil, &updated)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, _ = fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{ProjectId: aws.String(projectID)})
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, event.HandlerErrorCode)
+}
+
+func TestCreateAdoptsExistingEntries(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	client, err := s.AtlasClient()
+	require.NoError(t, err)
+	_, _, err = client.ProjectIPAccessListApi.CreateProjectIpAccessList(context.Background(), projectID, &[]admin.NetworkPermissionEntry{
+		{CidrBlock: admin.PtrString("10.0.0.0/16"), Comment: admin.PtrString("manual")},
+	}).Execute()
+	require.NoError(t, err)
+
+	model := &resource.Model{
+		ProjectId: aws.String(projectID),
+		AccessList: []resource.AccessListDefinition{
+			{CIDRBlock: aws.String("10.0.0.0/16"), Comment: aws.String("vpc")},
+			{IPAddress: aws.String("192.168.1.1")},
+		},
+	}
+	event, _ := fakeatlas.RunWithTypeConfig(t, s, `{"AdoptIfExists": true}`, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, progressevent.AdoptedMessage("access list of the project", projectID), event.Message)
+
+	entry, _, err := client.ProjectIPAccessListApi.GetProjectIpList(context.Background(), projectID, "10.0.0.0/16").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "vpc", entry.GetComment(), "the existing entry is updated")
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{ProjectId: aws.String(projectID)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, 2, aws.IntValue(read.TotalCount))
+}
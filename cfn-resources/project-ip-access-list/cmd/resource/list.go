@@ -15,68 +15,35 @@
 package resource
 
 import (
-	"context"
-	"fmt"
-
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	if errEvent := validateModel(ListRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if !util.IsStringPresent(currentModel.Profile) {
 		currentModel.Profile = aws.String(profile.DefaultProfile)
 	}
 
 	// Create atlas client
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
-	pageNum := 0
-	itemsPerPage := 500
-	includeCount := true
-
-	if currentModel.ListOptions != nil {
-		if currentModel.ListOptions.PageNum != nil {
-			pageNum = *currentModel.ListOptions.PageNum
-		}
-
-		if currentModel.ListOptions.IncludeCount != nil {
-			includeCount = *currentModel.ListOptions.IncludeCount
-		}
-
-		if currentModel.ListOptions.ItemsPerPage != nil {
-			itemsPerPage = *currentModel.ListOptions.ItemsPerPage
-		}
-	}
 
-	listOptions := &admin.ListProjectIpAccessListsApiParams{
-		GroupId:      *currentModel.ProjectId,
-		IncludeCount: &includeCount,
-		ItemsPerPage: &itemsPerPage,
-		PageNum:      &pageNum,
+	// the whole access list is a single resource, so all the pages are read
+	itemsPerPage := defaultItemsPerPage
+	if currentModel.ListOptions != nil && currentModel.ListOptions.ItemsPerPage != nil {
+		itemsPerPage = *currentModel.ListOptions.ItemsPerPage
 	}
 
-	result, resp, err := client.Atlas20231115002.ProjectIPAccessListApi.ListProjectIpAccessListsWithParams(context.Background(), listOptions).Execute()
+	entries, resp, err := getAllEntries(client, *currentModel.ProjectId, itemsPerPage)
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			resp), nil
+		return progressevents.GetFailedEventByError(err, resp), nil
 	}
 
 	mm := make([]AccessListDefinition, 0)
-	for i := range result.Results {
+	for i := range entries {
 		var m AccessListDefinition
-		m.completeByConnection(result.Results[i])
+		m.completeByConnection(entries[i])
 		mm = append(mm, m)
 	}
 	currentModel.AccessList = mm
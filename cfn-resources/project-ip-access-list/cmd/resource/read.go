@@ -15,9 +15,6 @@
 package resource
 
 import (
-	"context"
-	"fmt"
-
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -26,37 +23,30 @@ import (
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 )
 
-// Read handles the Read event from the Cloudformation service.
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	if errEvent := validateModel(ReadRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
+// read handles the Read event from the Cloudformation service.
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if !util.IsStringPresent(currentModel.Profile) {
 		currentModel.Profile = aws.String(profile.DefaultProfile)
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
-	result, resp, err := client.Atlas20231115002.ProjectIPAccessListApi.ListProjectIpAccessLists(context.Background(), *currentModel.ProjectId).Execute()
+	entries, resp, err := getAllEntries(client, *currentModel.ProjectId, defaultItemsPerPage)
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
-			resp), nil
+		return progressevents.GetFailedEventByError(err, resp), nil
 	}
 
-	if *result.TotalCount == 0 {
+	if len(entries) == 0 {
 		return handler.ProgressEvent{
 			Message:          "The entry to read is not in the access list",
 			OperationStatus:  handler.Failed,
 			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
 	}
 
-	currentModel.TotalCount = result.TotalCount
+	// the model is built from Atlas alone, the Read of an import only has the primary identifier
+	currentModel.AccessList = make([]AccessListDefinition, len(entries))
+	for i := range entries {
+		currentModel.AccessList[i].completeByConnection(entries[i])
+	}
+	currentModel.TotalCount = aws.Int(len(entries))
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		Message:         "Read Complete",
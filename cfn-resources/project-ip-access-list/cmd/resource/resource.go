@@ -19,17 +19,39 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-project-ip-access-list")
+const defaultItemsPerPage = 500
+
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-project-ip-access-list",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
+}
+
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
 var CreateRequiredFields = []string{constants.ProjectID, constants.AccessList}
@@ -38,11 +60,6 @@ var UpdateRequiredFields = []string{constants.ProjectID, constants.AccessList}
 var DeleteRequiredFields = []string{constants.ProjectID}
 var ListRequiredFields = []string{constants.ProjectID}
 
-// function to validate inputs to all actions
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
-}
-
 func newPaginatedNetworkAccess(model *Model) (*admin.PaginatedNetworkAccess, error) {
 	var accesslist []admin.NetworkPermissionEntry
 	for i := range model.AccessList {
@@ -104,8 +121,7 @@ func deleteEntriesForUpdate(list []AccessListDefinition, projectID string, clien
 	for _, accessListEntry := range list {
 		entry, err := getEntry(accessListEntry)
 		if err != nil {
-			return progressevents.GetFailedEventByResponse(fmt.Sprintf("Error getting the resource: %s", err.Error()),
-				nil)
+			return progressevents.GetFailedEventByError(err, nil)
 		}
 
 		if _, resp, err := client.Atlas20231115002.ProjectIPAccessListApi.DeleteProjectIpAccessList(context.Background(), projectID, entry).Execute(); err != nil {
@@ -114,8 +130,7 @@ func deleteEntriesForUpdate(list []AccessListDefinition, projectID string, clien
 				continue
 			}
 
-			return progressevents.GetFailedEventByResponse(fmt.Sprintf("Error deleting the resource: %s", err.Error()),
-				resp)
+			return progressevents.GetFailedEventByError(err, resp)
 		}
 	}
 
@@ -126,44 +141,42 @@ func deleteEntries(model *Model, client *util.MongoDBClient) handler.ProgressEve
 	for _, accessListEntry := range model.AccessList {
 		entry, err := getEntry(accessListEntry)
 		if err != nil {
-			return progressevents.GetFailedEventByResponse(fmt.Sprintf("Error getting the resource: %s", err.Error()),
-				nil)
+			return progressevents.GetFailedEventByError(err, nil)
 		}
 
 		if _, resp, err := client.Atlas20231115002.ProjectIPAccessListApi.DeleteProjectIpAccessList(context.Background(), *model.ProjectId, entry).Execute(); err != nil {
-			return progressevents.GetFailedEventByResponse(fmt.Sprintf("Error deleting the resource: %s", err.Error()),
-				resp)
+			return progressevents.GetFailedEventByError(err, resp)
 		}
 	}
 
 	return handler.ProgressEvent{}
 }
 
-func getAllEntries(client *util.MongoDBClient, projectID string) (*admin.PaginatedNetworkAccess, error) {
-	includeCount := true
-	itemPerPage := 500
-
-	listOptions := &admin.ListProjectIpAccessListsApiParams{
-		GroupId:      projectID,
-		IncludeCount: &includeCount,
-		ItemsPerPage: &itemPerPage,
-	}
-	accessList, _, err := client.Atlas20231115002.ProjectIPAccessListApi.ListProjectIpAccessListsWithParams(context.Background(), listOptions).Execute()
-	if err != nil {
-		return nil, err
-	}
-
-	return accessList, nil
+// getAllEntries returns every entry of the project access list, going through all the pages
+func getAllEntries(client *util.MongoDBClient, projectID string, itemsPerPage int) ([]admin.NetworkPermissionEntry, *http.Response, error) {
+	return util.ListAllPages(itemsPerPage, func(pageNum, itemsPerPage int) ([]admin.NetworkPermissionEntry, int, *http.Response, error) {
+		listOptions := &admin.ListProjectIpAccessListsApiParams{
+			GroupId:      projectID,
+			IncludeCount: admin.PtrBool(true),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+		}
+		accessList, resp, err := client.Atlas20231115002.ProjectIPAccessListApi.ListProjectIpAccessListsWithParams(context.Background(), listOptions).Execute()
+		if err != nil {
+			return nil, 0, resp, err
+		}
+		return accessList.GetResults(), accessList.GetTotalCount(), resp, nil
+	})
 }
 
 // isEntryAlreadyInAccessList checks if the entry already exists in the atlas access list
 func isEntryAlreadyInAccessList(client *util.MongoDBClient, model *Model) (bool, error) {
-	existingEntries, err := getAllEntries(client, *model.ProjectId)
+	existingEntries, _, err := getAllEntries(client, *model.ProjectId, defaultItemsPerPage)
 	if err != nil {
 		return false, err
 	}
 
-	existingEntriesMap := newAccessListMap(existingEntries.Results)
+	existingEntriesMap := newAccessListMap(existingEntries)
 	for _, entry := range model.AccessList {
 		if isEntryInMap(entry, existingEntriesMap) {
 			return true, nil
@@ -212,7 +225,10 @@ func newAccessListMap(accessList []admin.NetworkPermissionEntry) map[string]bool
 
 func (m *AccessListDefinition) completeByConnection(c admin.NetworkPermissionEntry) {
 	m.IPAddress = c.IpAddress
-	m.CIDRBlock = c.CidrBlock
+	// Atlas also returns the /32 CIDR block of an IP address, which the template doesn't set
+	if c.IpAddress == nil {
+		m.CIDRBlock = c.CidrBlock
+	}
 	m.Comment = c.Comment
 	m.AwsSecurityGroup = c.AwsSecurityGroup
 }
@@ -22,21 +22,11 @@ import (
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 )
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	if errEvent := validateModel(UpdateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if !util.IsStringPresent(currentModel.Profile) {
 		currentModel.Profile = aws.String(profile.DefaultProfile)
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	// "cfn test" will fail without these validations
 	if len(prevModel.AccessList) == 0 {
 		return handler.ProgressEvent{
@@ -45,7 +35,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
 	}
 
-	existingEntries, err := getAllEntries(client, *currentModel.ProjectId)
+	existingEntries, _, err := getAllEntries(client, *currentModel.ProjectId, defaultItemsPerPage)
 	if err != nil {
 		return handler.ProgressEvent{
 			Message:          "Error in retrieving the existing entries",
@@ -53,7 +43,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, err
 	}
 
-	if *existingEntries.TotalCount == 0 {
+	if len(existingEntries) == 0 {
 		return handler.ProgressEvent{
 			Message:          "You have no entry in the accesslist. You should use CREATE instead of UPDATE",
 			OperationStatus:  handler.Failed,
@@ -79,7 +69,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return progressEvent, nil
 	}
 
-	progressEvent, err = createEntries(currentModel, client)
+	_, progressEvent, err = createEntries(currentModel, client, false)
 	if progressEvent.OperationStatus == handler.Failed || err != nil {
 		return progressEvent, nil
 	}
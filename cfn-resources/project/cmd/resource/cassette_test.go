@@ -0,0 +1,101 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/project/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/test/e2e/utility"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/cassette"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+// TestCassette records the scenario of the project e2e test.
+func TestCassette(t *testing.T) {
+	// the cassette isn't recorded against Atlas yet, commit it and remove this skip to replay the scenario
+	if !cassette.Recording() {
+		t.Skipf("cassette not recorded, run the test with %s=%s", cassette.ModeEnv, cassette.ModeRecord)
+	}
+	r := cassette.New(t, "project")
+	orgID := r.Env("MONGODB_ATLAS_ORG_ID", "5f0000000000000000000001")
+	client := r.AtlasClient()
+	team, err := utility.NewAtlasTeam(context.Background(), client, "cfn-cassette-project", orgID)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _, _ = client.TeamsApi.DeleteTeam(context.Background(), orgID, team.GetId()).Execute()
+	})
+
+	model := resource.Model{
+		Profile: aws.String("default"),
+		Name:    aws.String("cfn-cassette-project"),
+		OrgId:   aws.String(orgID),
+		ProjectSettings: &resource.ProjectSettings{
+			IsCollectDatabaseSpecificsStatisticsEnabled: aws.Bool(false),
+			IsDataExplorerEnabled:                       aws.Bool(false),
+			IsPerformanceAdvisorEnabled:                 aws.Bool(false),
+			IsRealtimePerformancePanelEnabled:           aws.Bool(false),
+			IsSchemaAdvisorEnabled:                      aws.Bool(false),
+		},
+		ProjectTeams: []resource.ProjectTeam{{TeamId: team.Id, RoleNames: []string{"GROUP_OWNER"}}},
+		Tags:         map[string]string{"key1": "val1", "key2": "val2"},
+	}
+	reqCtx := handler.RequestContext{AccountID: "cassette", Region: "us-east-1"}
+
+	event, created := testutil.Run(t, reqCtx, r.Recording(), resource.Create, nil, &model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	projectID := aws.StringValue(created.Id)
+	project, _, err := client.ProjectsApi.GetProject(context.Background(), projectID).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "cfn-cassette-project", project.GetName())
+	assertTags(t, model.Tags, project.GetTags())
+	teams, _, err := client.TeamsApi.ListProjectTeams(context.Background(), projectID).Execute()
+	require.NoError(t, err)
+	require.Len(t, teams.GetResults(), 1)
+	assert.Equal(t, team.GetId(), teams.GetResults()[0].GetTeamId())
+
+	updated := model
+	updated.Id = created.Id
+	updated.Name = aws.String("cfn-cassette-project-updated")
+	updated.Tags = map[string]string{"key1": "val3", "key3": "val4"}
+	event, _ = testutil.Run(t, reqCtx, r.Recording(), resource.Update, created, &updated)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	project, _, err = client.ProjectsApi.GetProject(context.Background(), projectID).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "cfn-cassette-project-updated", project.GetName())
+	assertTags(t, updated.Tags, project.GetTags())
+
+	event, _ = testutil.Run(t, reqCtx, r.Recording(), resource.Delete, nil, created)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	_, resp, _ := client.ProjectsApi.GetProject(context.Background(), projectID).Execute()
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func assertTags(t *testing.T, expected map[string]string, tags []admin.ResourceTag) {
+	t.Helper()
+	actual := map[string]string{}
+	for _, tag := range tags {
+		actual[tag.GetKey()] = tag.GetValue()
+	}
+	assert.Equal(t, expected, actual)
+}
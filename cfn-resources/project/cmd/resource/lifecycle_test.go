@@ -0,0 +1,131 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/project/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycle(t *testing.T) {
+	s := fakeatlas.New(t)
+	model := &resource.Model{
+		Name:  aws.String("project"),
+		OrgId: aws.String(fakeatlas.OrgID),
+		Tags:  map[string]string{"env": "test"},
+		ProjectSettings: &resource.ProjectSettings{
+			IsDataExplorerEnabled: aws.Bool(false),
+		},
+		ProjectTeams: []resource.ProjectTeam{{TeamId: aws.String("650000000000000000000001"), RoleNames: []string{"GROUP_READ_ONLY"}}},
+	}
+
+	event, created := fakeatlas.Run(t, s, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.NotNil(t, created.Id)
+	assert.Equal(t, 0, aws.IntValue(created.ClusterCount))
+
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{Id: created.Id})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, "project", aws.StringValue(read.Name))
+	assert.Equal(t, map[string]string{"env": "test"}, read.Tags)
+	assert.False(t, aws.BoolValue(read.ProjectSettings.IsDataExplorerEnabled))
+	assert.True(t, aws.BoolValue(read.ProjectSettings.IsSchemaAdvisorEnabled))
+	require.Len(t, read.ProjectTeams, 1)
+	assert.Equal(t, []string{"GROUP_READ_ONLY"}, read.ProjectTeams[0].RoleNames)
+
+	updated := *read
+	updated.Name = aws.String("renamed")
+	updated.Tags = map[string]string{"env": "prod"}
+	updated.ProjectTeams = []resource.ProjectTeam{{TeamId: read.ProjectTeams[0].TeamId, RoleNames: []string{"GROUP_OWNER"}}}
+	event, _ = fakeatlas.Run(t, s, resource.Update, read, &updated)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, read = fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{Id: created.Id})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, "renamed", aws.StringValue(read.Name))
+	assert.Equal(t, map[string]string{"env": "prod"}, read.Tags)
+	require.Len(t, read.ProjectTeams, 1)
+	assert.Equal(t, []string{"GROUP_OWNER"}, read.ProjectTeams[0].RoleNames)
+
+	event, _ = fakeatlas.Run(t, s, resource.List, nil, &resource.Model{})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.Len(t, event.ResourceModels, 1)
+	listed, ok := event.ResourceModels[0].(resource.Model)
+	require.True(t, ok)
+	assert.Equal(t, created.Id, listed.Id)
+	assert.Equal(t, "renamed", aws.StringValue(listed.Name))
+
+	event, _ = fakeatlas.Run(t, s, resource.Delete, nil, &resource.Model{Id: created.Id})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, _ = fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{Id: created.Id})
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, event.HandlerErrorCode)
+}
+
+func TestCreateAdoptsExistingProject(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	model := &resource.Model{
+		Name:            aws.String("project"),
+		OrgId:           aws.String(fakeatlas.OrgID),
+		Tags:            map[string]string{"env": "test"},
+		ProjectSettings: &resource.ProjectSettings{IsDataExplorerEnabled: aws.Bool(false)},
+	}
+
+	event, _ := fakeatlas.Run(t, s, resource.Create, nil, model)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeAlreadyExists, event.HandlerErrorCode)
+
+	event, created := fakeatlas.RunWithTypeConfig(t, s, `{"AdoptIfExists": true}`, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, progressevent.AdoptedMessage("project", "project"), event.Message)
+	assert.Equal(t, projectID, aws.StringValue(created.Id))
+
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{Id: aws.String(projectID)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, map[string]string{"env": "test"}, read.Tags)
+	assert.False(t, aws.BoolValue(read.ProjectSettings.IsDataExplorerEnabled))
+}
+
+func TestCreateDoesNotAdoptProjectOfAnotherOrg(t *testing.T) {
+	s := fakeatlas.New(t)
+	otherProjectID := s.AddProjectInOrg("5f0000000000000000000002", "project")
+	s.AddProject("project")
+	model := &resource.Model{
+		Name:  aws.String("project"),
+		OrgId: aws.String(fakeatlas.OrgID),
+		Tags:  map[string]string{"env": "test"},
+	}
+
+	event, _ := fakeatlas.RunWithTypeConfig(t, s, `{"AdoptIfExists": true}`, resource.Create, nil, model)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Contains(t, event.Message, "can't be adopted")
+
+	client, err := s.AtlasClient()
+	require.NoError(t, err)
+	other, _, err := client.ProjectsApi.GetProject(context.Background(), otherProjectID).Execute()
+	require.NoError(t, err)
+	assert.Empty(t, other.GetTags(), "the project of the other organization isn't changed")
+}
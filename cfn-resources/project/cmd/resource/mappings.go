@@ -15,13 +15,17 @@
 package resource
 
 import (
+	"maps"
+	"slices"
+
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
+// NewResourceTags returns the Atlas tags ordered by key, so that the requests don't depend on the map order.
 func NewResourceTags(tags map[string]string) []admin.ResourceTag {
 	sliceTags := make([]admin.ResourceTag, 0, len(tags))
-	for k, v := range tags {
-		tag := admin.NewResourceTag(k, v)
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		tag := admin.NewResourceTag(k, tags[k])
 		sliceTags = append(sliceTags, *tag)
 	}
 	return sliceTags
@@ -0,0 +1,100 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/plan"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+// projectChanges are the Atlas calls of an Update, computed by diffProject for both Update and PlanUpdate.
+type projectChanges struct {
+	newTeams, changedTeams, removeTeams []admin.TeamRole
+	newKeys, changedKeys, removeKeys    []ProjectApiKey
+	// project, teams and settings report if the project, its teams and its settings are updated.
+	project, teams, settings bool
+}
+
+// diffProject returns the changes from prevModel to currentModel, assignedTeams are the teams of the project before the Update.
+func diffProject(prevModel, currentModel *Model, assignedTeams []admin.TeamRole) *projectChanges {
+	c := &projectChanges{
+		project:  currentModel.Name != nil || !reflect.DeepEqual(prevModel.Tags, currentModel.Tags),
+		teams:    currentModel.ProjectTeams != nil,
+		settings: currentModel.ProjectSettings != nil,
+	}
+	if c.teams {
+		c.newTeams, c.changedTeams, c.removeTeams = getChangeInTeams(currentModel.ProjectTeams, assignedTeams)
+	}
+	if currentModel.ProjectApiKeys != nil {
+		c.newKeys, c.changedKeys, c.removeKeys = GetChangeInAPIKeys(currentModel.ProjectApiKeys, prevModel.ProjectApiKeys)
+	}
+	return c
+}
+
+// PlanUpdate returns the Atlas API calls made by Update for the change from prevModel to currentModel.
+// Removing a team or an API key, or one of their roles, revokes an access so it's disruptive.
+func PlanUpdate(prevModel, currentModel *Model) *plan.Plan {
+	p := new(plan.Plan)
+	prevTeams := readTeams(prevModel.ProjectTeams)
+	changes := diffProject(prevModel, currentModel, prevTeams)
+
+	if changes.project {
+		p.Add("ProjectsApi.UpdateProject", false, "update the name and tags of the project")
+	}
+
+	for _, team := range changes.removeTeams {
+		p.Add("TeamsApi.RemoveProjectTeam", true, "remove the team %s from the project", util.SafeString(team.TeamId))
+	}
+	for _, team := range changes.newTeams {
+		p.Add("TeamsApi.AddAllTeamsToProject", false, "add the team %s with the roles %v", util.SafeString(team.TeamId), team.GetRoleNames())
+	}
+	for _, team := range changes.changedTeams {
+		i := slices.IndexFunc(prevTeams, func(t admin.TeamRole) bool { return util.AreStringPtrEqual(t.TeamId, team.TeamId) })
+		removed := plan.RemovedRoles(prevTeams[i].GetRoleNames(), team.GetRoleNames())
+		p.Add("TeamsApi.UpdateTeamRoles", len(removed) > 0, "set the roles of the team %s to %v%s", util.SafeString(team.TeamId), team.GetRoleNames(),
+			plan.Revoking(removed))
+	}
+
+	for _, key := range changes.removeKeys {
+		p.Add("ProgrammaticAPIKeysApi.RemoveProjectApiKey", true, "unassign the API key %s from the project", util.SafeString(key.Key))
+	}
+	for _, key := range changes.newKeys {
+		p.Add("ProgrammaticAPIKeysApi.UpdateApiKeyRoles", false, "assign the API key %s with the roles %v", util.SafeString(key.Key), key.RoleNames)
+	}
+	for _, key := range changes.changedKeys {
+		i := slices.IndexFunc(prevModel.ProjectApiKeys, func(k ProjectApiKey) bool { return util.AreStringPtrEqual(k.Key, key.Key) })
+		removed := plan.RemovedRoles(prevModel.ProjectApiKeys[i].RoleNames, key.RoleNames)
+		p.Add("ProgrammaticAPIKeysApi.UpdateApiKeyRoles", len(removed) > 0, "set the roles of the API key %s to %v%s", util.SafeString(key.Key), key.RoleNames,
+			plan.Revoking(removed))
+	}
+
+	if changes.settings {
+		p.Add("ProjectsApi.UpdateProjectSettings", false, "apply the project settings")
+	}
+	return p
+}
+
+// planOnly returns the event of an Update in plan-only mode, reading the project to know if Atlas already has the current model.
+func planOnly(req handler.Request, client *util.MongoDBClient, prevModel, currentModel *Model) handler.ProgressEvent {
+	atlasModel := *currentModel
+	event, _ := read(req, client, nil, &atlasModel)
+	applied := event.OperationStatus == handler.Success && plan.Applied(event.ResourceModel, prevModel, currentModel)
+	return PlanUpdate(prevModel, currentModel).OnlyEvent(applied, currentModel)
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/project/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanUpdate(t *testing.T) {
+	prevModel := &resource.Model{
+		Name: aws.String("project"),
+		ProjectTeams: []resource.ProjectTeam{
+			{TeamId: aws.String("team1"), RoleNames: []string{"GROUP_OWNER", "GROUP_READ_ONLY"}},
+			{TeamId: aws.String("team2"), RoleNames: []string{"GROUP_OWNER"}},
+		},
+		ProjectApiKeys: []resource.ProjectApiKey{
+			{Key: aws.String("key1"), RoleNames: []string{"GROUP_OWNER"}},
+		},
+	}
+	currentModel := &resource.Model{
+		Name: aws.String("project"),
+		ProjectTeams: []resource.ProjectTeam{
+			{TeamId: aws.String("team1"), RoleNames: []string{"GROUP_OWNER"}},
+			{TeamId: aws.String("team3"), RoleNames: []string{"GROUP_READ_ONLY"}},
+		},
+		ProjectApiKeys: []resource.ProjectApiKey{},
+	}
+
+	p := resource.PlanUpdate(prevModel, currentModel)
+
+	assert.Equal(t, []plan.Call{
+		{Operation: "ProjectsApi.UpdateProject", Description: "update the name and tags of the project"},
+		{Operation: "TeamsApi.RemoveProjectTeam", Description: "remove the team team2 from the project", Disruptive: true},
+		{Operation: "TeamsApi.AddAllTeamsToProject", Description: "add the team team3 with the roles [GROUP_READ_ONLY]"},
+		{Operation: "TeamsApi.UpdateTeamRoles", Description: "set the roles of the team team1 to [GROUP_OWNER], revoking [GROUP_READ_ONLY]", Disruptive: true},
+		{Operation: "ProgrammaticAPIKeysApi.RemoveProjectApiKey", Description: "unassign the API key key1 from the project", Disruptive: true},
+	}, p.Calls)
+	assert.True(t, p.Disruptive())
+}
+
+func TestPlanOnlyUpdate(t *testing.T) {
+	s := fakeatlas.New(t)
+	model := &resource.Model{Name: aws.String("project"), OrgId: aws.String(fakeatlas.OrgID), Tags: map[string]string{"env": "test"}}
+	event, created := fakeatlas.Run(t, s, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{Id: created.Id})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	req := handler.NewRequest("", nil, s.RequestContext(), nil, nil, nil, []byte(`{"PlanOnly": true}`))
+	renamed := *read
+	renamed.Name = aws.String("renamed")
+	event, err := resource.Update(req, read, &renamed)
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Contains(t, event.Message, "ProjectsApi.UpdateProject")
+
+	// the rollback restores the model Atlas still has
+	event, err = resource.Update(req, &renamed, read)
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, err = resource.Update(req, read, read)
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, read = fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{Id: created.Id})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, "project", aws.StringValue(read.Name), "nothing is changed in Atlas")
+}
@@ -16,16 +16,19 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"reflect"
+	"net/http"
+	"slices"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/idempotency"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/plan"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
@@ -37,26 +40,33 @@ type UpdateAPIKey struct {
 	Key           string
 }
 
-func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
-	util.SetupLogger("mongodb-atlas-project")
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-project",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadUpdateDeleteRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: ReadUpdateDeleteRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: ReadUpdateDeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list},
+}
 
-	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
-		return nil, errEvent
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return nil, peErr
-	}
-	return client.Atlas20231115014, nil
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	atlasV2, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	atlasV2 := client.Atlas20231115014
+	binding := idempotency.New(&req, currentModel)
+	defer binding.Done()
 	adminTags := NewResourceTags(currentModel.Tags)
 	projectInput := &admin.Group{
 		Name:                      *currentModel.Name,
@@ -74,13 +84,29 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	if currentModel.ProjectOwnerId != nil {
 		createProjectReq.ProjectOwnerId = currentModel.ProjectOwnerId
 	}
-	project, res, err := atlasV2.ProjectsApi.CreateProjectWithParams(context.Background(), &createProjectReq).Execute()
 
+	var project *admin.Group
+	var res *http.Response
+	projectID, _, err := binding.Create(func(id string) (bool, error) {
+		var err error
+		project, res, err = atlasV2.ProjectsApi.GetProject(context.Background(), id).Execute()
+		if progressevent.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}, func() (string, error) {
+		var err error
+		project, res, err = atlasV2.ProjectsApi.CreateProjectWithParams(context.Background(), &createProjectReq).Execute()
+		return project.GetId(), err
+	})
+	adopted := false
+	if progressevent.IsAlreadyExists(err) && progressevent.TypeAdoptIfExists(&req) {
+		project, res, err = adoptProject(atlasV2, currentModel)
+		projectID, adopted = project.GetId(), err == nil
+	}
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Failed to Create Project : %s", err.Error()),
-			res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
-	projectID := project.GetId()
 
 	if len(currentModel.ProjectApiKeys) > 0 {
 		for _, key := range currentModel.ProjectApiKeys {
@@ -93,18 +119,26 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 				Roles: &key.RoleNames,
 			}).Execute()
 			if err != nil {
-				return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error while Assigning Key to project : %s", err.Error()),
-					res), nil
+				return progressevent.GetFailedEventByError(err, res), nil
 			}
 		}
 	}
 
-	if len(currentModel.ProjectTeams) > 0 {
+	switch {
+	case adopted && currentModel.ProjectTeams != nil:
+		teamsAssigned, res, err := atlasV2.TeamsApi.ListProjectTeams(context.Background(), projectID).Execute()
+		if err != nil {
+			return progressevent.GetFailedEventByError(err, res), nil
+		}
+		newTeams, changedTeams, removeTeams := getChangeInTeams(currentModel.ProjectTeams, teamsAssigned.GetResults())
+		if errorMessage, err := changeProjectTeams(*atlasV2, projectID, newTeams, changedTeams, removeTeams); err != nil {
+			return progressevent.GetFailedEventByCode(fmt.Sprintf("%s: %s", errorMessage, err.Error()), cloudformation.HandlerErrorCodeInvalidRequest), nil
+		}
+	case len(currentModel.ProjectTeams) > 0:
 		teams := readTeams(currentModel.ProjectTeams)
 		_, _, err := atlasV2.TeamsApi.AddAllTeamsToProject(context.Background(), *project.Id, &teams).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error while adding teams to project : %s", err.Error()),
-				res), nil
+			return progressevent.GetFailedEventByError(err, res), nil
 		}
 	}
 
@@ -123,13 +157,35 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return event, err
 	}
 
+	message := "Create Complete"
+	if adopted {
+		message = progressevent.AdoptedMessage("project", *currentModel.Name)
+	}
+
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		Message:         "Create Complete",
+		Message:         message,
 		ResourceModel:   proj,
 	}, nil
 }
 
+// adoptProject returns the existing project with the name of the model, with its tags updated to match the template.
+func adoptProject(atlasV2 *admin.APIClient, currentModel *Model) (*admin.Group, *http.Response, error) {
+	project, res, err := atlasV2.ProjectsApi.GetProjectByName(context.Background(), *currentModel.Name).Execute()
+	if err != nil {
+		return nil, res, err
+	}
+	if project.OrgId != *currentModel.OrgId {
+		return nil, nil, fmt.Errorf("the existing project %s is in the organization %s, it can't be adopted", *currentModel.Name, project.OrgId)
+	}
+
+	adminTags := NewResourceTags(currentModel.Tags)
+	return atlasV2.ProjectsApi.UpdateProject(context.Background(), project.GetId(), &admin.GroupUpdate{
+		Name: currentModel.Name,
+		Tags: &adminTags,
+	}).Execute()
+}
+
 func updateProjectSettings(currentModel *Model, atlasV2 *admin.APIClient) (handler.ProgressEvent, error) {
 	if currentModel.ProjectSettings != nil {
 		projectSettings := admin.GroupSettings{
@@ -143,18 +199,14 @@ func updateProjectSettings(currentModel *Model, atlasV2 *admin.APIClient) (handl
 
 		_, res, err := atlasV2.ProjectsApi.UpdateProjectSettings(context.Background(), *currentModel.Id, &projectSettings).Execute()
 		if err != nil {
-			return progressevent.GetFailedEventByResponse(fmt.Sprintf("Failed to update Project settings : %s", err.Error()),
-				res), err
+			return progressevent.GetFailedEventByError(err, res), err
 		}
 	}
 	return handler.ProgressEvent{}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	atlasV2, peErr := initEnvWithLatestClient(req, currentModel, ReadUpdateDeleteRequiredFields)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	atlasV2 := client.Atlas20231115014
 
 	event, model, err := getProjectWithSettings(atlasV2, currentModel)
 	if err != nil {
@@ -168,11 +220,44 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (event handler.ProgressEvent, err error) {
-	atlasV2, peErr := initEnvWithLatestClient(req, currentModel, ReadUpdateDeleteRequiredFields)
+// list returns the projects the API key of the profile has access to, with the properties of the projects only: the
+// models are read with their settings, teams and API keys by Read.
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	projects, nextToken, peErr := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.Group, int, *http.Response, error) {
+		params := &admin.ListProjectsApiParams{
+			IncludeCount: admin.PtrBool(true),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+		}
+		page, res, err := client.Atlas20231115014.ProjectsApi.ListProjectsWithParams(context.Background(), params).Execute()
+		if err != nil {
+			return nil, 0, res, err
+		}
+		return page.GetResults(), page.GetTotalCount(), res, nil
+	})
 	if peErr != nil {
 		return *peErr, nil
 	}
+
+	models := make([]interface{}, 0)
+	for i := range projects {
+		model := Model{Profile: currentModel.Profile}
+		model.completeByProject(&projects[i])
+		models = append(models, model)
+	}
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "List Complete",
+		ResourceModels:  models,
+		NextToken:       nextToken,
+	}, nil
+}
+
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (event handler.ProgressEvent, err error) {
+	atlasV2 := client.Atlas20231115014
+	if plan.TypePlanOnly(&req) {
+		return planOnly(req, client, prevModel, currentModel), nil
+	}
 	var projectID string
 	if currentModel.Id != nil {
 		projectID = *currentModel.Id
@@ -183,13 +268,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (event h
 		return event, nil
 	}
 
-	if currentModel.Name != nil || !reflect.DeepEqual(prevModel.Tags, currentModel.Tags) {
-		event, _, err = updateProject(atlasV2, currentModel)
-		if err != nil {
-			return event, err
-		}
-	}
-
+	var assignedTeams []admin.TeamRole
 	if currentModel.ProjectTeams != nil {
 		teamsAssigned, _, err := atlasV2.TeamsApi.ListProjectTeams(context.Background(), projectID).Execute()
 		if err != nil {
@@ -198,53 +277,47 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (event h
 				Message:          "Error while finding teams in project",
 				HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, nil
 		}
-		if teamsAssigned != nil && teamsAssigned.Results != nil {
-			errorMessage, err := changeProjectTeams(*atlasV2, currentModel, teamsAssigned.GetResults())
-			if err != nil {
-				return handler.ProgressEvent{
-					OperationStatus:  handler.Failed,
-					Message:          errorMessage,
-					HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest,
-				}, nil
-			}
-		}
+		assignedTeams = teamsAssigned.GetResults()
 	}
+	changes := diffProject(prevModel, currentModel, assignedTeams)
 
-	if currentModel.ProjectApiKeys != nil {
-		newAPIKeys, changedKeys, removeKeys := GetChangeInAPIKeys(currentModel.ProjectApiKeys, prevModel.ProjectApiKeys)
+	if changes.project {
+		event, _, err = updateProject(atlasV2, currentModel)
+		if err != nil {
+			return event, err
+		}
+	}
 
-		for _, key := range removeKeys {
-			_, _, err = atlasV2.ProgrammaticAPIKeysApi.RemoveProjectApiKey(context.Background(), projectID, *key.Key).Execute()
-			if err != nil {
-				return handler.ProgressEvent{
-					OperationStatus:  handler.Failed,
-					Message:          fmt.Sprintf("Error while Un-assigning Key to project %s", err.Error()),
-					HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, nil
-			}
+	if changes.teams {
+		errorMessage, err := changeProjectTeams(*atlasV2, projectID, changes.newTeams, changes.changedTeams, changes.removeTeams)
+		if err != nil {
+			return handler.ProgressEvent{
+				OperationStatus:  handler.Failed,
+				Message:          errorMessage,
+				HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest,
+			}, nil
 		}
+	}
 
-		for _, key := range newAPIKeys {
-			_, _, err := atlasV2.ProgrammaticAPIKeysApi.UpdateApiKeyRoles(context.Background(), projectID, *key.Key, &admin.UpdateAtlasProjectApiKey{
-				Roles: &key.RoleNames,
-			}).Execute()
-			if err != nil {
-				return handler.ProgressEvent{
-					OperationStatus:  handler.Failed,
-					Message:          fmt.Sprintf("Error while Assigning Key to project %s", err.Error()),
-					HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, nil
-			}
+	for _, key := range changes.removeKeys {
+		_, _, err = atlasV2.ProgrammaticAPIKeysApi.RemoveProjectApiKey(context.Background(), projectID, *key.Key).Execute()
+		if err != nil {
+			return handler.ProgressEvent{
+				OperationStatus:  handler.Failed,
+				Message:          fmt.Sprintf("Error while Un-assigning Key to project %s", err.Error()),
+				HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, nil
 		}
+	}
 
-		for _, key := range changedKeys {
-			_, _, err := atlasV2.ProgrammaticAPIKeysApi.UpdateApiKeyRoles(context.Background(), projectID, *key.Key, &admin.UpdateAtlasProjectApiKey{
-				Roles: &key.RoleNames,
-			}).Execute()
-			if err != nil {
-				return handler.ProgressEvent{
-					OperationStatus:  handler.Failed,
-					Message:          fmt.Sprintf("Error while Assigning Key to project %s", err.Error()),
-					HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, nil
-			}
+	for _, key := range slices.Concat(changes.newKeys, changes.changedKeys) {
+		_, _, err := atlasV2.ProgrammaticAPIKeysApi.UpdateApiKeyRoles(context.Background(), projectID, *key.Key, &admin.UpdateAtlasProjectApiKey{
+			Roles: &key.RoleNames,
+		}).Execute()
+		if err != nil {
+			return handler.ProgressEvent{
+				OperationStatus:  handler.Failed,
+				Message:          fmt.Sprintf("Error while Assigning Key to project %s", err.Error()),
+				HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}, nil
 		}
 	}
 
@@ -265,11 +338,8 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (event h
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (event handler.ProgressEvent, err error) {
-	atlasV2, peErr := initEnvWithLatestClient(req, currentModel, ReadUpdateDeleteRequiredFields)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (event handler.ProgressEvent, err error) {
+	atlasV2 := client.Atlas20231115014
 	var id string
 	if currentModel.Id != nil {
 		id = *currentModel.Id
@@ -282,9 +352,9 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (event h
 
 	_, res, err := atlasV2.ProjectsApi.DeleteProject(context.Background(), id).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Failed to Create Project : %s", err.Error()),
-			res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
+	idempotency.Clear(&req)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -293,26 +363,27 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (event h
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-
 func getProject(client *admin.APIClient, currentModel *Model) (event handler.ProgressEvent, model *Model, err error) {
 	var project *admin.Group
 	event, project, err = getProjectByID(currentModel.Id, client)
 	if err != nil {
 		return event, nil, err
 	}
+	currentModel.completeByProject(project)
+	return handler.ProgressEvent{}, currentModel, nil
+}
+
+// completeByProject sets the properties of the model read from the project, without its settings, teams nor API keys.
+func (m *Model) completeByProject(project *admin.Group) {
 	formattedCreated := util.TimeToString(project.Created)
 
-	currentModel.Name = &project.Name
-	currentModel.OrgId = &project.OrgId
-	currentModel.Created = &formattedCreated
-	currentModel.ClusterCount = util.Int64PtrToIntPtr(&project.ClusterCount)
-	currentModel.Id = project.Id
-	currentModel.RegionUsageRestrictions = project.RegionUsageRestrictions
-	currentModel.Tags = NewCfnTags(project.GetTags())
-	return handler.ProgressEvent{}, currentModel, nil
+	m.Name = &project.Name
+	m.OrgId = &project.OrgId
+	m.Created = &formattedCreated
+	m.ClusterCount = util.Int64PtrToIntPtr(&project.ClusterCount)
+	m.Id = project.Id
+	m.RegionUsageRestrictions = project.RegionUsageRestrictions
+	m.Tags = NewCfnTags(project.GetTags())
 }
 
 func getProjectWithSettings(atlasV2 *admin.APIClient, currentModel *Model) (event handler.ProgressEvent, model *Model, err error) {
@@ -342,8 +413,7 @@ func updateProject(client *admin.APIClient, currentModel *Model) (event handler.
 				"Unauthorized Error: Unable to update project name. Please verify that the API keys provided in the profile have sufficient privileges to access the project.",
 				cloudformation.HandlerErrorCodeNotFound), nil, err
 		}
-		return progressevent.GetFailedEventByResponse(err.Error(),
-			res), project, err
+		return progressevent.GetFailedEventByError(err, res), project, err
 	}
 	return handler.ProgressEvent{}, project, err
 }
@@ -356,8 +426,7 @@ func getProjectByID(id *string, atlasV2 *admin.APIClient) (event handler.Progres
 				"Unauthorized Error: Unable to retrieve Project by ID. Please verify that the API keys provided in the profile have sufficient privileges to access the project.",
 				cloudformation.HandlerErrorCodeNotFound), nil, err
 		}
-		return progressevent.GetFailedEventByResponse(err.Error(),
-			res), project, err
+		return progressevent.GetFailedEventByError(err, res), project, err
 	}
 	return handler.ProgressEvent{}, project, err
 }
@@ -365,14 +434,12 @@ func getProjectByID(id *string, atlasV2 *admin.APIClient) (event handler.Progres
 func readProjectSettings(atlasV2 *admin.APIClient, id string, currentModel *Model) (event handler.ProgressEvent, model *Model, err error) {
 	teamsAssigned, res, err := atlasV2.TeamsApi.ListProjectTeams(context.Background(), id).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(),
-			res), nil, err
+		return progressevent.GetFailedEventByError(err, res), nil, err
 	}
 
 	projectSettings, _, err := atlasV2.ProjectsApi.GetProjectSettings(context.Background(), id).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(),
-			res), nil, err
+		return progressevent.GetFailedEventByError(err, res), nil, err
 	}
 	currentModel.ProjectSettings = &ProjectSettings{
 		IsCollectDatabaseSpecificsStatisticsEnabled: projectSettings.IsCollectDatabaseSpecificsStatisticsEnabled,
@@ -485,9 +552,7 @@ func GetChangeInAPIKeys(currentKeys []ProjectApiKey, previousKeys []ProjectApiKe
 	return newKeys, changedKeys, removeKeys
 }
 
-func changeProjectTeams(atlasV2 admin.APIClient, currentModel *Model, newTeams []admin.TeamRole) (errorMessage string, err error) {
-	newTeams, changedTeams, removeTeams := getChangeInTeams(currentModel.ProjectTeams, newTeams)
-	projectID := *currentModel.Id
+func changeProjectTeams(atlasV2 admin.APIClient, projectID string, newTeams, changedTeams, removeTeams []admin.TeamRole) (errorMessage string, err error) {
 	for _, team := range removeTeams {
 		_, err = atlasV2.TeamsApi.RemoveProjectTeam(context.Background(), projectID, util.SafeString(team.TeamId)).Execute()
 		if err != nil {
@@ -0,0 +1,121 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/search-deployment/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/test/e2e/utility"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/cassette"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+// TestCassette records the scenario of the search deployment e2e test.
+func TestCassette(t *testing.T) {
+	// the cassette isn't recorded against Atlas yet, commit it and remove this skip to replay the scenario
+	if !cassette.Recording() {
+		t.Skipf("cassette not recorded, run the test with %s=%s", cassette.ModeEnv, cassette.ModeRecord)
+	}
+	r := cassette.New(t, "search-deployment")
+	orgID := r.Env("MONGODB_ATLAS_ORG_ID", "5f0000000000000000000001")
+	client := r.AtlasClient()
+	projectID := utility.CreateProject(t, client, orgID, "cfn-cassette-searchdeployment")
+	t.Cleanup(func() {
+		utility.DeleteProject(t, client, projectID)
+	})
+	clusterName := "cfn-cassette-searchdeployment"
+	createCluster(t, r, client, projectID, clusterName)
+	reqCtx := handler.RequestContext{AccountID: "cassette", Region: "us-east-1"}
+	model := resource.Model{
+		Profile:     aws.String("default"),
+		ProjectId:   aws.String(projectID),
+		ClusterName: aws.String(clusterName),
+		Specs:       []resource.ApiSearchDeploymentSpec{{InstanceSize: aws.String("S20_HIGHCPU_NVME"), NodeCount: aws.Int(3)}},
+	}
+
+	event, created := testutil.Run(t, reqCtx, r.Recording(), resource.Create, nil, &model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	deployment, _, err := client.AtlasSearchApi.GetAtlasSearchDeployment(context.Background(), projectID, clusterName).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "S20_HIGHCPU_NVME", deployment.GetSpecs()[0].GetInstanceSize())
+	assert.Equal(t, 3, deployment.GetSpecs()[0].GetNodeCount())
+
+	updated := model
+	updated.Specs = []resource.ApiSearchDeploymentSpec{{InstanceSize: aws.String("S30_HIGHCPU_NVME"), NodeCount: aws.Int(2)}}
+	event, _ = testutil.Run(t, reqCtx, r.Recording(), resource.Update, created, &updated)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	deployment, _, err = client.AtlasSearchApi.GetAtlasSearchDeployment(context.Background(), projectID, clusterName).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "S30_HIGHCPU_NVME", deployment.GetSpecs()[0].GetInstanceSize())
+	assert.Equal(t, 2, deployment.GetSpecs()[0].GetNodeCount())
+
+	event, _ = testutil.Run(t, reqCtx, r.Recording(), resource.Delete, nil, created)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	deployment, _, _ = client.AtlasSearchApi.GetAtlasSearchDeployment(context.Background(), projectID, clusterName).Execute()
+	assert.Nil(t, deployment)
+}
+
+// createCluster creates the cluster of the search deployment and deletes it at the end of the test, the recorder
+// waits between the polls of the cluster state.
+func createCluster(t *testing.T, r *cassette.Recorder, client *admin.APIClient, projectID, name string) {
+	t.Helper()
+	_, _, err := client.ClustersApi.CreateCluster(context.Background(), projectID, &admin.AdvancedClusterDescription{
+		Name:        admin.PtrString(name),
+		ClusterType: admin.PtrString("REPLICASET"),
+		ReplicationSpecs: &[]admin.ReplicationSpec{{
+			NumShards: admin.PtrInt(1),
+			RegionConfigs: &[]admin.CloudRegionConfig{{
+				ProviderName:   admin.PtrString("AWS"),
+				RegionName:     admin.PtrString("US_EAST_1"),
+				Priority:       admin.PtrInt(7),
+				ElectableSpecs: &admin.HardwareSpec{InstanceSize: admin.PtrString("M10"), NodeCount: admin.PtrInt(3)},
+			}},
+		}},
+	}).Execute()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if _, err := client.ClustersApi.DeleteCluster(context.Background(), projectID, name).Execute(); err != nil {
+			t.Logf("deleting cluster: %v", err)
+			return
+		}
+		waitCluster(t, r, client, projectID, name)
+	})
+	waitCluster(t, r, client, projectID, name)
+}
+
+// waitCluster polls the cluster until it's IDLE or deleted.
+func waitCluster(t *testing.T, r *cassette.Recorder, client *admin.APIClient, projectID, name string) {
+	t.Helper()
+	for {
+		r.Wait(30 * time.Second)
+		cluster, resp, err := client.ClustersApi.GetCluster(context.Background(), projectID, name).Execute()
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return
+		}
+		require.NoError(t, err)
+		if cluster.GetStateName() == "IDLE" {
+			return
+		}
+	}
+}
@@ -26,12 +26,13 @@ func NewCFNSearchDeployment(prevModel *Model, apiResp *admin.ApiSearchDeployment
 		}
 	}
 	return Model{
-		Profile:     prevModel.Profile,
-		ClusterName: prevModel.ClusterName,
-		ProjectId:   prevModel.ProjectId,
-		Id:          apiResp.Id,
-		Specs:       resultSpecs,
-		StateName:   apiResp.StateName,
+		Profile:        prevModel.Profile,
+		ClusterName:    prevModel.ClusterName,
+		ProjectId:      prevModel.ProjectId,
+		Id:             apiResp.Id,
+		Specs:          resultSpecs,
+		StateName:      apiResp.StateName,
+		TimeoutOptions: prevModel.TimeoutOptions,
 	}
 }
 
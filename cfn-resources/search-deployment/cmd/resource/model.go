@@ -4,12 +4,13 @@ package resource
 
 // Model is autogenerated from the json schema
 type Model struct {
-	Profile     *string                   `json:",omitempty"`
-	ClusterName *string                   `json:",omitempty"`
-	ProjectId   *string                   `json:",omitempty"`
-	Id          *string                   `json:",omitempty"`
-	Specs       []ApiSearchDeploymentSpec `json:",omitempty"`
-	StateName   *string                   `json:",omitempty"`
+	Profile        *string                   `json:",omitempty"`
+	ClusterName    *string                   `json:",omitempty"`
+	ProjectId      *string                   `json:",omitempty"`
+	Id             *string                   `json:",omitempty"`
+	Specs          []ApiSearchDeploymentSpec `json:",omitempty"`
+	StateName      *string                   `json:",omitempty"`
+	TimeoutOptions *TimeoutOptions           `json:",omitempty"`
 }
 
 // ApiSearchDeploymentSpec is autogenerated from the json schema
@@ -17,3 +18,10 @@ type ApiSearchDeploymentSpec struct {
 	InstanceSize *string `json:",omitempty"`
 	NodeCount    *int    `json:",omitempty"`
 }
+
+// TimeoutOptions is autogenerated from the json schema
+type TimeoutOptions struct {
+	TimeOutInSeconds       *int  `json:",omitempty"`
+	CallbackDelaySeconds   *int  `json:",omitempty"`
+	ReturnSuccessIfTimeOut *bool `json:",omitempty"`
+}
@@ -16,17 +16,14 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"net/http"
-	"strings"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
-	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
 const (
@@ -40,27 +37,34 @@ var readRequiredFields = []string{constants.ProjectID, constants.ClusterName}
 var updateRequiredFields = []string{constants.ProjectID, constants.ClusterName, constants.Specs}
 var deleteRequiredFields = []string{constants.ProjectID, constants.ClusterName}
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-searchdeployment")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-search-deployment",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: createRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: readRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: updateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: deleteRequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	if modelValidation := validator.ValidateModel(createRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
-	client, progressErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if progressErr != nil {
-		return *progressErr, nil
-	}
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	connV2 := client.Atlas20231115014
 
 	// handling of subsequent retry calls
 	if _, ok := req.CallbackContext[constants.ID]; ok {
-		return HandleStateTransition(*connV2, currentModel, constants.IdleState), nil
+		return HandleStateTransition(*connV2, currentModel, &req, constants.IdleState), nil
 	}
 
 	projectID := util.SafeString(currentModel.ProjectId)
@@ -75,18 +79,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return inProgressEvent("Creating Search Deployment", &newModel), nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if modelValidation := validator.ValidateModel(readRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	client, progressErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if progressErr != nil {
-		return *progressErr, nil
-	}
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	connV2 := client.Atlas20231115014
 
 	projectID := util.SafeString(currentModel.ProjectId)
@@ -102,23 +95,12 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if modelValidation := validator.ValidateModel(updateRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	client, progressErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if progressErr != nil {
-		return *progressErr, nil
-	}
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	connV2 := client.Atlas20231115014
 
 	// handling of subsequent retry calls
 	if _, ok := req.CallbackContext[constants.ID]; ok {
-		return HandleStateTransition(*connV2, currentModel, constants.IdleState), nil
+		return HandleStateTransition(*connV2, currentModel, &req, constants.IdleState), nil
 	}
 
 	projectID := util.SafeString(currentModel.ProjectId)
@@ -133,23 +115,12 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return inProgressEvent("Updating Search Deployment", &newModel), nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	if modelValidation := validator.ValidateModel(deleteRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	client, progressErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if progressErr != nil {
-		return *progressErr, nil
-	}
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	connV2 := client.Atlas20231115014
 
 	// handling of subsequent retry calls
 	if _, ok := req.CallbackContext[constants.ID]; ok {
-		return HandleStateTransition(*connV2, currentModel, constants.DeletedState), nil
+		return HandleStateTransition(*connV2, currentModel, &req, constants.DeletedState), nil
 	}
 
 	projectID := util.SafeString(currentModel.ProjectId)
@@ -161,25 +132,9 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return inProgressEvent(constants.DeleteInProgress, currentModel), nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
-}
-
 // specific handling for search deployment API where 400 status code can include AlreadyExists or DoesNotExist that need specific mapping to CFN error codes
 func handleError(res *http.Response, err error) (handler.ProgressEvent, error) {
-	if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusBadRequest && strings.Contains(*apiError.ErrorCode, SearchDeploymentAlreadyExistsError) {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          err.Error(),
-			HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}, nil
-	}
-	if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusBadRequest && strings.Contains(*apiError.ErrorCode, SearchDeploymentDoesNotExistsError) {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          err.Error(),
-			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
-	}
-	return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+	return progressevent.GetFailedEventByError(err, res), nil
 }
 
 func inProgressEvent(message string, model *Model) handler.ProgressEvent {
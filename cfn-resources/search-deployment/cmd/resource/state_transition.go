@@ -16,8 +16,6 @@ package resource
 
 import (
 	"context"
-	"net/http"
-	"strings"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
@@ -26,29 +24,32 @@ import (
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
-func HandleStateTransition(connV2 admin.APIClient, currentModel *Model, targetState string) handler.ProgressEvent {
+func HandleStateTransition(connV2 admin.APIClient, currentModel *Model, req *handler.Request, targetState string) handler.ProgressEvent {
 	projectID := util.SafeString(currentModel.ProjectId)
 	clusterName := util.SafeString(currentModel.ClusterName)
 	apiResp, resp, err := connV2.AtlasSearchApi.GetAtlasSearchDeployment(context.Background(), projectID, clusterName).Execute()
 	if err != nil {
-		if targetState == constants.DeletedState && resp.StatusCode == http.StatusBadRequest && strings.Contains(err.Error(), SearchDeploymentDoesNotExistsError) {
+		if targetState == constants.DeletedState && progressevent.IsErrorCode(err, SearchDeploymentDoesNotExistsError) {
 			return handler.ProgressEvent{
 				OperationStatus: handler.Success,
 				ResourceModel:   nil,
 				Message:         constants.Complete,
 			}
 		}
-		return progressevent.GetFailedEventByResponse(err.Error(), resp)
+		return progressevent.GetFailedEventByError(err, resp)
 	}
 
 	newModel := NewCFNSearchDeployment(currentModel, apiResp)
-	if util.SafeString(newModel.StateName) == targetState {
-		return handler.ProgressEvent{
-			OperationStatus: handler.Success,
-			ResourceModel:   newModel,
-			Message:         constants.Complete,
-		}
+	poller := progressevent.Poller{
+		Resource:        "Search deployment of cluster " + clusterName,
+		TargetStates:    []string{targetState},
+		MinDelaySeconds: callBackSeconds,
+		MaxDelaySeconds: 4 * callBackSeconds,
 	}
-
-	return inProgressEvent(constants.Pending, &newModel)
+	timeoutOptions := (*progressevent.TimeoutOptions)(currentModel.TimeoutOptions)
+	event := poller.WithOptions(timeoutOptions, progressevent.TypeTimeoutOptions(req)).Poll(req.CallbackContext, progressevent.PollState{Name: util.SafeString(newModel.StateName), Model: &newModel})
+	if event.OperationStatus == handler.InProgress {
+		event.CallbackContext[constants.ID] = newModel.Id
+	}
+	return event
 }
@@ -15,13 +15,12 @@
 package resource_test
 
 import (
-	"errors"
 	"net/http"
 	"testing"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/search-deployment/cmd/resource"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/mocksvc"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/mocksvc/mockadmin20231115014"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -43,6 +42,13 @@ var prevModel = resource.Model{
 	ProjectId:   admin.PtrString(dummyProjectID),
 }
 
+func newAPIError(errorCode string) error {
+	err := new(admin.GenericOpenAPIError)
+	err.SetError(errorCode)
+	err.SetModel(admin.ApiError{Error: admin.PtrInt(http.StatusBadRequest), ErrorCode: admin.PtrString(errorCode)})
+	return err
+}
+
 func TestStateTransitionProgressEvents(t *testing.T) {
 	testCases := []stateTransitionTestCase{
 		{
@@ -75,7 +81,7 @@ func TestStateTransitionProgressEvents(t *testing.T) {
 			respHTTP: &http.Response{
 				StatusCode: 400,
 			},
-			respError:           errors.New(resource.SearchDeploymentDoesNotExistsError),
+			respError:           newAPIError(resource.SearchDeploymentDoesNotExistsError),
 			targetState:         constants.DeletedState,
 			expectedEventStatus: handler.Success,
 		},
@@ -107,12 +113,12 @@ func TestStateTransitionProgressEvents(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			m := mocksvc.NewAtlasSearchApi(t)
+			m := mockadmin20231115014.NewAtlasSearchApi(t)
 			m.EXPECT().GetAtlasSearchDeployment(mock.Anything, mock.Anything, mock.Anything).Return(admin.GetAtlasSearchDeploymentApiRequest{ApiService: m}).Once()
 			m.EXPECT().GetAtlasSearchDeploymentExecute(mock.Anything).Return(tc.respModel, tc.respHTTP, tc.respError).Once()
 
 			client := admin.APIClient{AtlasSearchApi: m}
-			eventResult := resource.HandleStateTransition(client, &prevModel, tc.targetState)
+			eventResult := resource.HandleStateTransition(client, &prevModel, &handler.Request{}, tc.targetState)
 			assert.Equal(t, tc.expectedEventStatus, eventResult.OperationStatus)
 		})
 	}
@@ -0,0 +1,108 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/search-index/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycle(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.TransitionReads = 2
+	projectID := s.AddProject("project")
+	s.AddCluster(projectID, "cluster")
+	model := &resource.Model{
+		ProjectId:      aws.String(projectID),
+		ClusterName:    aws.String("cluster"),
+		Database:       aws.String("db"),
+		CollectionName: aws.String("movies"),
+		Name:           aws.String("default"),
+		Mappings:       &resource.ApiAtlasFTSMappingsViewManual{Dynamic: aws.Bool(true)},
+	}
+
+	event, created := fakeatlas.Run(t, s, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	require.NotNil(t, created.IndexId)
+
+	key := &resource.Model{ProjectId: aws.String(projectID), ClusterName: aws.String("cluster"), IndexId: created.IndexId}
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, "STEADY", aws.StringValue(read.Status))
+	assert.Equal(t, "default", aws.StringValue(read.Name))
+
+	updated := *read
+	updated.SearchAnalyzer = aws.String("lucene.english")
+	event, _ = fakeatlas.Run(t, s, resource.Update, read, &updated)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, read = fakeatlas.Run(t, s, resource.Read, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, "STEADY", aws.StringValue(read.Status))
+	assert.Equal(t, "lucene.english", aws.StringValue(read.SearchAnalyzer))
+
+	event, _ = fakeatlas.Run(t, s, resource.List, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Len(t, event.ResourceModels, 1)
+
+	event, _ = fakeatlas.Run(t, s, resource.Delete, nil, key)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, _ = fakeatlas.Run(t, s, resource.Read, nil, key)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, event.HandlerErrorCode)
+}
+
+func TestIdentifiesIndexByURN(t *testing.T) {
+	s := fakeatlas.New(t)
+	projectID := s.AddProject("project")
+	s.AddCluster(projectID, "cluster")
+	model := &resource.Model{
+		ProjectId:      aws.String(projectID),
+		ClusterName:    aws.String("cluster"),
+		Database:       aws.String("db"),
+		CollectionName: aws.String("movies"),
+		Name:           aws.String("default"),
+		Mappings:       &resource.ApiAtlasFTSMappingsViewManual{Dynamic: aws.Bool(true)},
+	}
+	event, created := fakeatlas.Run(t, s, resource.Create, nil, model)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	urn := util.SearchIndexURN(projectID, "cluster", aws.StringValue(created.IndexId)).String()
+
+	event, read := fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{Urn: aws.String(urn)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, urn, aws.StringValue(read.Urn))
+	assert.Equal(t, "cluster", aws.StringValue(read.ClusterName))
+	assert.Equal(t, "default", aws.StringValue(read.Name))
+
+	event, _ = fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{Urn: aws.String("project/" + projectID)})
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeInvalidRequest, event.HandlerErrorCode)
+
+	event, _ = fakeatlas.Run(t, s, resource.Delete, nil, &resource.Model{Urn: aws.String(urn)})
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	event, _ = fakeatlas.Run(t, s, resource.Read, nil, &resource.Model{Urn: aws.String(urn)})
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, event.HandlerErrorCode)
+}
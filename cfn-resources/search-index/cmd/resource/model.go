@@ -19,6 +19,7 @@ type Model struct {
 	Status         *string                                   `json:",omitempty"`
 	Synonyms       []ApiAtlasFTSSynonymMappingDefinitionView `json:",omitempty"`
 	Fields         *string                                   `json:",omitempty"`
+	Urn            *string                                   `json:",omitempty"`
 }
 
 // ApiAtlasFTSAnalyzersViewManual is autogenerated from the json schema
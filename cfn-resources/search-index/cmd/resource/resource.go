@@ -20,19 +20,43 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"github.com/spf13/cast"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-search-index")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-search-index",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Identify: identify,
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	// a missing IndexId is reported as not found before the required fields are validated
+	Read:   handlerkit.Operation[Model]{Run: read},
+	Update: handlerkit.Operation[Model]{Run: update},
+	Delete: handlerkit.Operation[Model]{Run: deleteResource},
+	List:   handlerkit.Operation[Model]{Run: list, RequiredFields: UpdateRequiredFields},
+}
+
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
 var CreateRequiredFields = []string{constants.ProjectID, constants.ClusterName}
@@ -40,18 +64,7 @@ var ReadRequiredFields = []string{constants.ProjectID, constants.ClusterName, co
 var UpdateRequiredFields = []string{constants.ProjectID, constants.ClusterName, constants.IndexID}
 var DeleteRequiredFields = []string{constants.ProjectID, constants.ClusterName, constants.IndexID}
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if errEvent := validator.ValidateModel(CreateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
 
 	ctx := context.Background()
@@ -94,9 +107,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.IndexId == nil {
 		err := errors.New("no Id found in currentModel")
 		return handler.ProgressEvent{
@@ -108,11 +119,6 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		return *errEvent, nil
 	}
 
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
 	atlasV2 := client.Atlas20231115002
 
 	searchIndex, resp, err := atlasV2.AtlasSearchApi.GetAtlasSearchIndex(context.Background(), *currentModel.ProjectId, *currentModel.ClusterName, *currentModel.IndexId).Execute()
@@ -123,9 +129,14 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 				OperationStatus:  handler.Failed,
 				HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
 		}
+		return progressevent.GetFailedEventByError(err, resp), nil
+	}
+	if err := SetModel(currentModel, searchIndex); err != nil {
+		return handler.ProgressEvent{
+			OperationStatus:  handler.Failed,
+			Message:          err.Error(),
+			HandlerErrorCode: cloudformation.HandlerErrorCodeInternalFailure}, nil
 	}
-	currentModel.Status = searchIndex.Status
-	currentModel.Type = searchIndex.Type
 	return handler.ProgressEvent{
 		OperationStatus: cloudformation.OperationStatusSuccess,
 		Message:         "Read Complete",
@@ -133,9 +144,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.IndexId == nil {
 		err := errors.New("no Id found in currentModel")
 		return handler.ProgressEvent{
@@ -148,11 +157,6 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *errEvent, nil
 	}
 
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
 	atlasV2 := client.Atlas20231115002
 
 	ctx := context.Background()
@@ -175,17 +179,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	updatedSearchIndex, res, err := atlasV2.AtlasSearchApi.UpdateAtlasSearchIndex(
 		context.Background(), *currentModel.ProjectId, *currentModel.ClusterName, *currentModel.IndexId, searchIndex).Execute()
 	if err != nil {
-		// Log and handle 404 ok
-		if res != nil && res.StatusCode == http.StatusNotFound {
-			return handler.ProgressEvent{
-				OperationStatus:  handler.Failed,
-				Message:          err.Error(),
-				HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}, nil
-		}
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          err.Error(),
-			HandlerErrorCode: cloudformation.HandlerErrorCodeServiceInternalError}, nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 	currentModel.Status = updatedSearchIndex.Status
 	return handler.ProgressEvent{
@@ -200,9 +194,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.IndexId == nil {
 		err := errors.New("no Id found in currentModel")
 		return handler.ProgressEvent{
@@ -215,11 +207,6 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *errEvent, nil
 	}
 
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
 	atlasV2 := client.Atlas20231115002
 
 	ctx := context.Background()
@@ -256,18 +243,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if errEvent := validator.ValidateModel(UpdateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
 
 	ctx := context.Background()
@@ -286,7 +262,14 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}
 	response := make([]any, 0, len(indices))
 	for i := range indices {
-		response = append(response, indices[i])
+		model := Model{Profile: currentModel.Profile, ProjectId: currentModel.ProjectId, ClusterName: currentModel.ClusterName}
+		if err := SetModel(&model, &indices[i]); err != nil {
+			return handler.ProgressEvent{
+				Message:          err.Error(),
+				OperationStatus:  handler.Failed,
+				HandlerErrorCode: cloudformation.HandlerErrorCodeServiceInternalError}, nil
+		}
+		response = append(response, model)
 	}
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -295,6 +278,23 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
+// identify sets the project, cluster and index ID of a model identified by its Urn only.
+func identify(model *Model) error {
+	if model.Urn == nil || model.ProjectId != nil || model.ClusterName != nil || model.IndexId != nil {
+		return nil
+	}
+	urn, err := util.ParseURN(*model.Urn)
+	if err != nil {
+		return err
+	}
+	ids, err := urn.IDs(util.URNProject, util.URNCluster, util.URNSearchIndex)
+	if err != nil {
+		return err
+	}
+	model.ProjectId, model.ClusterName, model.IndexId = &ids[0], &ids[1], &ids[2]
+	return nil
+}
+
 func newSearchIndex(currentModel *Model) (*admin.ClusterSearchIndex, error) {
 	searchIndex := &admin.ClusterSearchIndex{
 		Analyzer:       currentModel.Analyzer,
@@ -359,6 +359,95 @@ func newSearchIndex(currentModel *Model) (*admin.ClusterSearchIndex, error) {
 	return searchIndex, nil
 }
 
+// SetModel sets the properties of the model from the search index. The JSON properties are marshaled again from
+// the definition returned by Atlas.
+func SetModel(currentModel *Model, searchIndex *admin.ClusterSearchIndex) error {
+	currentModel.IndexId = searchIndex.IndexID
+	if currentModel.ProjectId != nil && currentModel.ClusterName != nil && currentModel.IndexId != nil {
+		currentModel.Urn = aws.String(util.SearchIndexURN(*currentModel.ProjectId, *currentModel.ClusterName, *currentModel.IndexId).String())
+	}
+	currentModel.Name = &searchIndex.Name
+	currentModel.Database = &searchIndex.Database
+	currentModel.CollectionName = &searchIndex.CollectionName
+	currentModel.Status = searchIndex.Status
+	currentModel.Type = searchIndex.Type
+	currentModel.Analyzer = searchIndex.Analyzer
+	currentModel.SearchAnalyzer = searchIndex.SearchAnalyzer
+
+	currentModel.Fields = nil
+	if len(searchIndex.Fields) > 0 {
+		fields, err := json.Marshal(searchIndex.Fields)
+		if err != nil {
+			return err
+		}
+		currentModel.Fields = aws.String(string(fields))
+	}
+
+	currentModel.Mappings = nil
+	if searchIndex.Mappings != nil {
+		currentModel.Mappings = &ApiAtlasFTSMappingsViewManual{Dynamic: searchIndex.Mappings.Dynamic}
+		if len(searchIndex.Mappings.Fields) > 0 {
+			fields, err := json.Marshal(searchIndex.Mappings.Fields)
+			if err != nil {
+				return err
+			}
+			currentModel.Mappings.Fields = aws.String(string(fields))
+		}
+	}
+
+	var analyzers []ApiAtlasFTSAnalyzersViewManual
+	for i := range searchIndex.Analyzers {
+		a := &searchIndex.Analyzers[i]
+		charFilters, err := convertToStringSlice(a.CharFilters)
+		if err != nil {
+			return err
+		}
+		tokenFilters, err := convertToStringSlice(a.TokenFilters)
+		if err != nil {
+			return err
+		}
+		analyzers = append(analyzers, ApiAtlasFTSAnalyzersViewManual{
+			CharFilters:  charFilters,
+			Name:         &a.Name,
+			TokenFilters: tokenFilters,
+			Tokenizer: &ApiAtlasFTSAnalyzersTokenizer{
+				MaxGram:        a.Tokenizer.MaxGram,
+				MinGram:        a.Tokenizer.MinGram,
+				Type:           a.Tokenizer.Type,
+				Group:          a.Tokenizer.Group,
+				Pattern:        a.Tokenizer.Pattern,
+				MaxTokenLength: a.Tokenizer.MaxTokenLength,
+			},
+		})
+	}
+	currentModel.Analyzers = analyzers
+
+	var synonyms []ApiAtlasFTSSynonymMappingDefinitionView
+	for i := range searchIndex.Synonyms {
+		s := &searchIndex.Synonyms[i]
+		synonyms = append(synonyms, ApiAtlasFTSSynonymMappingDefinitionView{
+			Analyzer: &s.Analyzer,
+			Name:     &s.Name,
+			Source:   &SynonymSource{Collection: &s.Source.Collection},
+		})
+	}
+	currentModel.Synonyms = synonyms
+	return nil
+}
+
+// convertToStringSlice is the reverse of ConvertToAnySlice.
+func convertToStringSlice(input []any) ([]string, error) {
+	var result []string
+	for _, data := range input {
+		jsonStr, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, string(jsonStr))
+	}
+	return result, nil
+}
+
 func ConvertToAnySlice(input []string) ([]any, error) {
 	var result []any
 
@@ -110,3 +110,43 @@ func TestNewTokenizerModelWithPartialParameters(t *testing.T) {
 		t.Errorf("Expected: %v, but got: %v", expected, result)
 	}
 }
+
+func TestSetModelFromIdentifier(t *testing.T) {
+	searchIndex := &admin.ClusterSearchIndex{
+		IndexID:        ptr.String("index"),
+		Name:           "default",
+		Database:       "db",
+		CollectionName: "coll",
+		Status:         ptr.String("STEADY"),
+		Type:           ptr.String("search"),
+		Analyzer:       ptr.String("lucene.standard"),
+		Mappings:       &admin.ApiAtlasFTSMappings{Dynamic: ptr.Bool(false), Fields: map[string]any{"title": map[string]any{"type": "string"}}},
+		Analyzers: []admin.ApiAtlasFTSAnalyzers{{
+			Name:         "custom",
+			CharFilters:  []any{map[string]any{"type": "htmlStrip"}},
+			TokenFilters: []any{map[string]any{"type": "lowercase"}},
+			Tokenizer:    admin.ApiAtlasFTSAnalyzersTokenizer{Type: ptr.String("standard")},
+		}},
+		Synonyms: []admin.SearchSynonymMappingDefinition{{Analyzer: "lucene.standard", Name: "synonyms", Source: admin.SynonymSource{Collection: "synonyms"}}},
+	}
+	fromIdentifier := &resource.Model{Profile: ptr.String("default"), ProjectId: ptr.String("project"), ClusterName: ptr.String("cluster"), IndexId: ptr.String("index")}
+	fromModel := &resource.Model{Profile: ptr.String("default"), ProjectId: ptr.String("project"), ClusterName: ptr.String("cluster"), IndexId: ptr.String("index"),
+		Name: ptr.String("default"), Database: ptr.String("db"), CollectionName: ptr.String("coll"), SearchAnalyzer: ptr.String("lucene.english"),
+		Fields: ptr.String(`[{"type":"vector"}]`), Mappings: &resource.ApiAtlasFTSMappingsViewManual{Dynamic: ptr.Bool(true)}}
+
+	if err := resource.SetModel(fromIdentifier, searchIndex); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := resource.SetModel(fromModel, searchIndex); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromIdentifier, fromModel) {
+		t.Errorf("Reading from the identifier must return the same model as reading from the full model. Got: %+v, Expected: %+v", fromIdentifier, fromModel)
+	}
+	if got := *fromIdentifier.Mappings.Fields; got != `{"title":{"type":"string"}}` {
+		t.Errorf("Unexpected mapping fields: %s", got)
+	}
+	if got := fromIdentifier.Analyzers[0].CharFilters; !reflect.DeepEqual(got, []string{`{"type":"htmlStrip"}`}) {
+		t.Errorf("Unexpected char filters: %v", got)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The secret rotation Lambda rotates the Atlas API keys stored in Secrets Manager, see the README.
+package main
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/mongodb-forks/digest"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/secret-rotation/cmd/rotation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/auth"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/transport"
+)
+
+func main() {
+	util.SetupLogger("mongodb-atlas-secret-rotation")
+	rotator := &rotation.Rotator{
+		SecretsManager: secretsmanager.New(session.Must(session.NewSession())),
+		NewClient:      newClient,
+		AdminSecretID:  os.Getenv("MONGODB_ATLAS_ROTATION_ADMIN_SECRET"),
+	}
+	lambda.Start(rotator.Handle)
+}
+
+// newClient returns a client of the API key retrying the throttled and transient Atlas responses like the clients of
+// the handlers, so that a 429 or a 503 doesn't fail a rotation step.
+func newClient(publicKey, privateKey, baseURL string) (*admin.APIClient, error) {
+	if baseURL == "" {
+		baseURL = auth.DefaultBaseURL
+	}
+	c := util.Config{BaseURL: baseURL}
+	rt := transport.NewRetryTransport(transport.NewRequestIDTransport(digest.NewTransport(publicKey, privateKey)), transport.NewDefaultBudget())
+	return c.NewSDKv20231115014Client(&http.Client{Transport: rt})
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/secrets"
+)
+
+// The secret of a database user with a password managed by the DatabaseUser resource, see
+// secrets.DatabaseUserSecret, is rotated by changing the password of the user:
+//   - createSecret generates a new password and saves it, with the connection strings, in the AWSPENDING version.
+//   - setSecret sets the new password of the Atlas database user.
+//   - testSecret checks that the database user still exists, logging in to the clusters needs a MongoDB driver.
+//   - finishSecret makes the new password the AWSCURRENT version of the secret.
+//
+// Atlas is called with the API key of the AdminSecretID secret if set, else with the profile of the resource.
+
+const (
+	fieldProjectID = "ProjectId"
+	fieldUsername  = "Username"
+	fieldPassword  = "Password"
+)
+
+// isDatabaseUser reports if the secret holds the password of a database user instead of an API key.
+func isDatabaseUser(s secretValue) bool {
+	return s.field(fieldPublicKey) == "" && s.field(fieldProjectID) != "" && s.field(fieldUsername) != "" &&
+		s.field(fieldPassword) != ""
+}
+
+func (r *Rotator) handleDatabaseUser(ctx context.Context, event Event, current string) error {
+	switch event.Step {
+	case StepCreateSecret:
+		return r.createDatabaseUserSecret(ctx, event)
+	case StepSetSecret:
+		return r.setDatabaseUserSecret(ctx, event)
+	case StepTestSecret:
+		return r.testDatabaseUserSecret(ctx, event)
+	case StepFinishSecret:
+		return r.makeCurrent(ctx, event, current)
+	}
+	return fmt.Errorf("unknown rotation step %q", event.Step)
+}
+
+func (r *Rotator) createDatabaseUserSecret(ctx context.Context, event Event) error {
+	_, err := r.readSecret(ctx, event.SecretID, event.ClientRequestToken, stagePending)
+	if err == nil {
+		return nil
+	}
+	if !isSecretNotFound(err) {
+		return err
+	}
+
+	pending, err := r.getDatabaseUserSecret(ctx, event.SecretID, "", stageCurrent)
+	if err != nil {
+		return err
+	}
+	password, err := secrets.GeneratePassword()
+	if err != nil {
+		return fmt.Errorf("error generating the password: %w", err)
+	}
+	pending.SetPassword(password)
+	value, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+	_, err = r.SecretsManager.PutSecretValueWithContext(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:           aws.String(event.SecretID),
+		ClientRequestToken: aws.String(event.ClientRequestToken),
+		SecretString:       aws.String(string(value)),
+		VersionStages:      aws.StringSlice([]string{stagePending}),
+	})
+	return err
+}
+
+func (r *Rotator) setDatabaseUserSecret(ctx context.Context, event Event) error {
+	pending, err := r.getDatabaseUserSecret(ctx, event.SecretID, event.ClientRequestToken, stagePending)
+	if err != nil {
+		return err
+	}
+	client, err := r.databaseUserClient(ctx, pending)
+	if err != nil {
+		return err
+	}
+	_, _, err = client.DatabaseUsersApi.UpdateDatabaseUser(ctx, pending.ProjectID, pending.DatabaseName, pending.Username, &admin.CloudDatabaseUser{
+		GroupId:      pending.ProjectID,
+		DatabaseName: pending.DatabaseName,
+		Username:     pending.Username,
+		Password:     &pending.Password,
+	}).Execute()
+	if err != nil {
+		return fmt.Errorf("error setting the new password of the database user %s: %w", pending.Username, err)
+	}
+	_, _ = logger.Debugf("set the new password of the database user %s of the project %s", pending.Username, pending.ProjectID)
+	return nil
+}
+
+func (r *Rotator) testDatabaseUserSecret(ctx context.Context, event Event) error {
+	pending, err := r.getDatabaseUserSecret(ctx, event.SecretID, event.ClientRequestToken, stagePending)
+	if err != nil {
+		return err
+	}
+	client, err := r.databaseUserClient(ctx, pending)
+	if err != nil {
+		return err
+	}
+	if _, _, err := client.DatabaseUsersApi.GetDatabaseUser(ctx, pending.ProjectID, pending.DatabaseName, pending.Username).Execute(); err != nil {
+		return fmt.Errorf("error reading the database user %s: %w", pending.Username, err)
+	}
+	return nil
+}
+
+// databaseUserClient returns the client updating the database user of the secret.
+func (r *Rotator) databaseUserClient(ctx context.Context, s *secrets.DatabaseUserSecret) (*admin.APIClient, error) {
+	secretID := r.AdminSecretID
+	if secretID == "" {
+		name := s.Profile
+		if name == "" {
+			name = profile.DefaultProfile
+		}
+		secretID = profile.SecretNameWithPrefix(name)
+	}
+	keys, err := r.getSecret(ctx, secretID, "", stageCurrent)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the API key of the secret %s: %w", secretID, err)
+	}
+	return r.NewClient(keys.field(fieldPublicKey), keys.field(fieldPrivateKey), keys.field(fieldBaseURL))
+}
+
+func (r *Rotator) getDatabaseUserSecret(ctx context.Context, secretID, versionID, stage string) (*secrets.DatabaseUserSecret, error) {
+	input := &secretsmanager.GetSecretValueInput{SecretId: aws.String(secretID), VersionStage: aws.String(stage)}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+	output, err := r.SecretsManager.GetSecretValueWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	s := &secrets.DatabaseUserSecret{}
+	if err := json.Unmarshal([]byte(aws.StringValue(output.SecretString)), s); err != nil {
+		return nil, fmt.Errorf("the secret %s isn't a database user secret: %w", secretID, err)
+	}
+	return s, nil
+}
@@ -0,0 +1,480 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rotation rotates the Atlas programmatic API keys stored in Secrets Manager, e.g. by the APIKey and
+// Organization resources, following the four steps of a Secrets Manager rotation:
+//   - createSecret creates a new Atlas key with the organization roles and the description of the current one, and
+//     saves it in the AWSPENDING version of the secret.
+//   - setSecret gives the new key the project roles and the access list entries of the current one.
+//   - testSecret calls Atlas with the new key.
+//   - finishSecret makes the new key the AWSCURRENT version of the secret and deletes the previous key from Atlas.
+//
+// The fields of the secret other than the key, e.g. the OrgID or the BaseURL of an organization profile, are kept.
+//
+// The secrets of the database users with a password managed by the DatabaseUser resource are rotated as well, see
+// database_user.go.
+package rotation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+)
+
+// Steps of a Secrets Manager rotation.
+const (
+	StepCreateSecret = "createSecret"
+	StepSetSecret    = "setSecret"
+	StepTestSecret   = "testSecret"
+	StepFinishSecret = "finishSecret"
+)
+
+const (
+	stageCurrent  = "AWSCURRENT"
+	stagePending  = "AWSPENDING"
+	stagePrevious = "AWSPREVIOUS"
+
+	fieldPublicKey  = "PublicKey"
+	fieldPrivateKey = "PrivateKey"
+	fieldAPIUserID  = "APIUserID"
+	fieldOrgID      = "OrgID"
+	fieldBaseURL    = "BaseURL"
+)
+
+var errKeyNotFound = errors.New("no Atlas API key with the public key of the secret")
+
+// Event is the event sent by Secrets Manager to the rotation Lambda for each step of a rotation.
+type Event struct {
+	SecretID           string `json:"SecretId"`
+	ClientRequestToken string `json:"ClientRequestToken"`
+	Step               string `json:"Step"`
+}
+
+// ClientFunc returns an Atlas client authenticated with an API key, baseURL is empty for the default Atlas URL.
+type ClientFunc func(publicKey, privateKey, baseURL string) (*admin.APIClient, error)
+
+// Rotator runs the steps of the rotation of the Atlas API keys stored in Secrets Manager.
+type Rotator struct {
+	SecretsManager secretsmanageriface.SecretsManagerAPI
+	NewClient      ClientFunc
+	// AdminSecretID is the secret of the Atlas API key creating and deleting the rotated keys, it needs the
+	// Organization Owner role. If empty, the rotated keys manage themselves, e.g. the Organization Owner key created
+	// by the Organization resource.
+	AdminSecretID string
+}
+
+// Handle runs the step of the event. The steps can be retried, a step already done does nothing.
+func (r *Rotator) Handle(ctx context.Context, event Event) error {
+	secret, err := r.SecretsManager.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{SecretId: aws.String(event.SecretID)})
+	if err != nil {
+		return err
+	}
+	if !aws.BoolValue(secret.RotationEnabled) {
+		return fmt.Errorf("rotation isn't enabled for the secret %s", event.SecretID)
+	}
+	current, err := r.readSecret(ctx, event.SecretID, "", stageCurrent)
+	if err != nil {
+		return err
+	}
+	databaseUser := isDatabaseUser(current)
+	stages, ok := secret.VersionIdsToStages[event.ClientRequestToken]
+	if !ok {
+		return fmt.Errorf("the secret %s has no version %s to rotate", event.SecretID, event.ClientRequestToken)
+	}
+	if slices.Contains(aws.StringValueSlice(stages), stageCurrent) {
+		_, _ = logger.Debugf("version %s of the secret %s is already AWSCURRENT", event.ClientRequestToken, event.SecretID)
+		if event.Step == StepFinishSecret && !databaseUser {
+			// a previous finishSecret can have failed once the version was made current
+			return r.deletePreviousKey(ctx, event.SecretID)
+		}
+		return nil
+	}
+	if !slices.Contains(aws.StringValueSlice(stages), stagePending) {
+		return fmt.Errorf("version %s of the secret %s isn't AWSPENDING", event.ClientRequestToken, event.SecretID)
+	}
+
+	if databaseUser {
+		return r.handleDatabaseUser(ctx, event, currentVersion(secret.VersionIdsToStages))
+	}
+	switch event.Step {
+	case StepCreateSecret:
+		return r.createSecret(ctx, event)
+	case StepSetSecret:
+		return r.setSecret(ctx, event)
+	case StepTestSecret:
+		return r.testSecret(ctx, event)
+	case StepFinishSecret:
+		return r.finishSecret(ctx, event, currentVersion(secret.VersionIdsToStages))
+	}
+	return fmt.Errorf("unknown rotation step %q", event.Step)
+}
+
+func (r *Rotator) createSecret(ctx context.Context, event Event) error {
+	_, err := r.getSecret(ctx, event.SecretID, event.ClientRequestToken, stagePending)
+	if err == nil {
+		return nil
+	}
+	if !isSecretNotFound(err) {
+		return err
+	}
+
+	current, err := r.getSecret(ctx, event.SecretID, "", stageCurrent)
+	if err != nil {
+		return err
+	}
+	client, orgID, err := r.adminClient(ctx, current)
+	if err != nil {
+		return err
+	}
+	currentKey, err := findKey(ctx, client, orgID, current)
+	if err != nil {
+		return err
+	}
+
+	var orgRoles []string
+	for _, role := range currentKey.GetRoles() {
+		if role.GetOrgId() != "" {
+			orgRoles = append(orgRoles, role.GetRoleName())
+		}
+	}
+	newKey, _, err := client.ProgrammaticAPIKeysApi.CreateApiKey(ctx, orgID, &admin.CreateAtlasOrganizationApiKey{
+		Desc:  currentKey.GetDesc(),
+		Roles: orgRoles,
+	}).Execute()
+	if err != nil {
+		return fmt.Errorf("error creating the new API key: %w", err)
+	}
+	_, _ = logger.Debugf("created the API key %s to replace %s", newKey.GetId(), currentKey.GetId())
+
+	pending := current.withKey(newKey)
+	_, err = r.SecretsManager.PutSecretValueWithContext(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:           aws.String(event.SecretID),
+		ClientRequestToken: aws.String(event.ClientRequestToken),
+		SecretString:       aws.String(pending.marshal()),
+		VersionStages:      aws.StringSlice([]string{stagePending}),
+	})
+	if err != nil {
+		// the private key is lost, the key is created again by the retry
+		_, _, _ = client.ProgrammaticAPIKeysApi.DeleteApiKey(ctx, orgID, newKey.GetId()).Execute()
+		return err
+	}
+	return nil
+}
+
+func (r *Rotator) setSecret(ctx context.Context, event Event) error {
+	current, err := r.getSecret(ctx, event.SecretID, "", stageCurrent)
+	if err != nil {
+		return err
+	}
+	pending, err := r.getSecret(ctx, event.SecretID, event.ClientRequestToken, stagePending)
+	if err != nil {
+		return err
+	}
+	client, orgID, err := r.adminClient(ctx, current)
+	if err != nil {
+		return err
+	}
+	currentKey, err := findKey(ctx, client, orgID, current)
+	if err != nil {
+		return err
+	}
+	pendingKey, err := findKey(ctx, client, orgID, pending)
+	if err != nil {
+		return err
+	}
+
+	pendingRoles := projectRoles(pendingKey)
+	for projectID, roles := range projectRoles(currentKey) {
+		if util.SameStringSliceWithoutOrder(roles, pendingRoles[projectID]) {
+			continue
+		}
+		_, _, err := client.ProgrammaticAPIKeysApi.UpdateApiKeyRoles(ctx, projectID, pendingKey.GetId(), &admin.UpdateAtlasProjectApiKey{
+			Roles: &roles,
+		}).Execute()
+		if err != nil {
+			return fmt.Errorf("error assigning the project %s to the new API key: %w", projectID, err)
+		}
+	}
+
+	currentEntries, err := accessList(ctx, client, orgID, currentKey.GetId())
+	if err != nil {
+		return err
+	}
+	pendingEntries, err := accessList(ctx, client, orgID, pendingKey.GetId())
+	if err != nil {
+		return err
+	}
+	missing := slices.DeleteFunc(currentEntries, func(entry admin.UserAccessListRequest) bool {
+		return slices.ContainsFunc(pendingEntries, func(e admin.UserAccessListRequest) bool {
+			return e.GetIpAddress() == entry.GetIpAddress() && e.GetCidrBlock() == entry.GetCidrBlock()
+		})
+	})
+	if len(missing) == 0 {
+		return nil
+	}
+	if _, _, err := client.ProgrammaticAPIKeysApi.CreateApiKeyAccessList(ctx, orgID, pendingKey.GetId(), &missing).Execute(); err != nil {
+		return fmt.Errorf("error copying the access list to the new API key: %w", err)
+	}
+	return nil
+}
+
+func (r *Rotator) testSecret(ctx context.Context, event Event) error {
+	pending, err := r.getSecret(ctx, event.SecretID, event.ClientRequestToken, stagePending)
+	if err != nil {
+		return err
+	}
+	client, err := r.NewClient(pending.field(fieldPublicKey), pending.field(fieldPrivateKey), pending.field(fieldBaseURL))
+	if err != nil {
+		return err
+	}
+	if _, _, err := client.OrganizationsApi.ListOrganizations(ctx).Execute(); err != nil {
+		return fmt.Errorf("error calling Atlas with the new API key: %w", err)
+	}
+	return nil
+}
+
+func (r *Rotator) finishSecret(ctx context.Context, event Event, current string) error {
+	if err := r.makeCurrent(ctx, event, current); err != nil {
+		return err
+	}
+	return r.deletePreviousKey(ctx, event.SecretID)
+}
+
+// makeCurrent moves the AWSCURRENT stage of the secret from the current version to the version of the event.
+func (r *Rotator) makeCurrent(ctx context.Context, event Event, current string) error {
+	_, err := r.SecretsManager.UpdateSecretVersionStageWithContext(ctx, &secretsmanager.UpdateSecretVersionStageInput{
+		SecretId:            aws.String(event.SecretID),
+		VersionStage:        aws.String(stageCurrent),
+		MoveToVersionId:     aws.String(event.ClientRequestToken),
+		RemoveFromVersionId: aws.String(current),
+	})
+	return err
+}
+
+// deletePreviousKey deletes the key of the AWSPREVIOUS version of the secret, if it still exists.
+func (r *Rotator) deletePreviousKey(ctx context.Context, secretID string) error {
+	previous, err := r.getSecret(ctx, secretID, "", stagePrevious)
+	if isSecretNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	current, err := r.getSecret(ctx, secretID, "", stageCurrent)
+	if err != nil {
+		return err
+	}
+	if previous.field(fieldPublicKey) == current.field(fieldPublicKey) {
+		return nil
+	}
+
+	// a self managed key is deleted by the new one
+	client, orgID, err := r.adminClient(ctx, current)
+	if err != nil {
+		return err
+	}
+	previousKey, err := findKey(ctx, client, orgID, previous)
+	if errors.Is(err, errKeyNotFound) || progressevent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, _, err = client.ProgrammaticAPIKeysApi.DeleteApiKey(ctx, orgID, previousKey.GetId()).Execute()
+	if err != nil && !progressevent.IsNotFound(err) {
+		return fmt.Errorf("error deleting the previous API key: %w", err)
+	}
+	_, _ = logger.Debugf("deleted the previous API key %s", previousKey.GetId())
+	return nil
+}
+
+// adminClient returns the client managing the key of the secret and the ID of the organization of the key.
+func (r *Rotator) adminClient(ctx context.Context, s secretValue) (*admin.APIClient, string, error) {
+	keyClient, err := r.NewClient(s.field(fieldPublicKey), s.field(fieldPrivateKey), s.field(fieldBaseURL))
+	if err != nil {
+		return nil, "", err
+	}
+	orgID := s.field(fieldOrgID)
+	if orgID == "" {
+		// an organization API key only sees its organization
+		orgs, _, err := keyClient.OrganizationsApi.ListOrganizations(ctx).Execute()
+		if err != nil {
+			return nil, "", fmt.Errorf("error finding the organization of the API key: %w", err)
+		}
+		if len(orgs.GetResults()) != 1 {
+			return nil, "", fmt.Errorf("the API key sees %d organizations, set the OrgID field of the secret", len(orgs.GetResults()))
+		}
+		orgID = orgs.GetResults()[0].GetId()
+	}
+	if r.AdminSecretID == "" {
+		return keyClient, orgID, nil
+	}
+
+	adminSecret, err := r.getSecret(ctx, r.AdminSecretID, "", stageCurrent)
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading the admin secret %s: %w", r.AdminSecretID, err)
+	}
+	client, err := r.NewClient(adminSecret.field(fieldPublicKey), adminSecret.field(fieldPrivateKey), adminSecret.field(fieldBaseURL))
+	return client, orgID, err
+}
+
+// getSecret reads a secret holding an API key.
+func (r *Rotator) getSecret(ctx context.Context, secretID, versionID, stage string) (secretValue, error) {
+	s, err := r.readSecret(ctx, secretID, versionID, stage)
+	if err != nil {
+		return nil, err
+	}
+	if s.field(fieldPublicKey) == "" || s.field(fieldPrivateKey) == "" {
+		return nil, fmt.Errorf("the secret %s has no PublicKey and PrivateKey", secretID)
+	}
+	return s, nil
+}
+
+func (r *Rotator) readSecret(ctx context.Context, secretID, versionID, stage string) (secretValue, error) {
+	input := &secretsmanager.GetSecretValueInput{SecretId: aws.String(secretID), VersionStage: aws.String(stage)}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+	output, err := r.SecretsManager.GetSecretValueWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	s := secretValue{}
+	if err := json.Unmarshal([]byte(aws.StringValue(output.SecretString)), &s); err != nil {
+		return nil, fmt.Errorf("the secret %s isn't a JSON object: %w", secretID, err)
+	}
+	return s, nil
+}
+
+// findKey returns the Atlas key of the secret, from its APIUserID or else its PublicKey.
+func findKey(ctx context.Context, client *admin.APIClient, orgID string, s secretValue) (*admin.ApiKeyUserDetails, error) {
+	if id := s.field(fieldAPIUserID); id != "" {
+		key, _, err := client.ProgrammaticAPIKeysApi.GetApiKey(ctx, orgID, id).Execute()
+		return key, err
+	}
+	keys, _, err := util.ListAllPages(0, func(pageNum, itemsPerPage int) ([]admin.ApiKeyUserDetails, int, *http.Response, error) {
+		page, resp, err := client.ProgrammaticAPIKeysApi.ListApiKeys(ctx, orgID).PageNum(pageNum).ItemsPerPage(itemsPerPage).IncludeCount(true).Execute()
+		return page.GetResults(), page.GetTotalCount(), resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range keys {
+		if keys[i].GetPublicKey() == s.field(fieldPublicKey) {
+			return &keys[i], nil
+		}
+	}
+	return nil, errKeyNotFound
+}
+
+func projectRoles(key *admin.ApiKeyUserDetails) map[string][]string {
+	roles := map[string][]string{}
+	for _, role := range key.GetRoles() {
+		if projectID := role.GetGroupId(); projectID != "" {
+			roles[projectID] = append(roles[projectID], role.GetRoleName())
+		}
+	}
+	return roles
+}
+
+func accessList(ctx context.Context, client *admin.APIClient, orgID, keyID string) ([]admin.UserAccessListRequest, error) {
+	entries, _, err := util.ListAllPages(0, func(pageNum, itemsPerPage int) ([]admin.UserAccessListResponse, int, *http.Response, error) {
+		page, resp, err := client.ProgrammaticAPIKeysApi.ListApiKeyAccessListsEntries(ctx, orgID, keyID).
+			PageNum(pageNum).ItemsPerPage(itemsPerPage).IncludeCount(true).Execute()
+		return page.GetResults(), page.GetTotalCount(), resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading the access list of the API key %s: %w", keyID, err)
+	}
+	requests := make([]admin.UserAccessListRequest, 0, len(entries))
+	for _, entry := range entries {
+		// the access list returns the CIDR block of single IP addresses as well
+		if entry.IpAddress != nil {
+			requests = append(requests, admin.UserAccessListRequest{IpAddress: entry.IpAddress})
+		} else {
+			requests = append(requests, admin.UserAccessListRequest{CidrBlock: entry.CidrBlock})
+		}
+	}
+	return requests, nil
+}
+
+func currentVersion(versions map[string][]*string) string {
+	for id, stages := range versions {
+		if slices.Contains(aws.StringValueSlice(stages), stageCurrent) {
+			return id
+		}
+	}
+	return ""
+}
+
+func isSecretNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException
+}
+
+// secretValue is the JSON object of a secret, its field names are matched ignoring the case like encoding/json does.
+type secretValue map[string]any
+
+func (s secretValue) field(name string) string {
+	for k, v := range s {
+		if strings.EqualFold(k, name) {
+			str, _ := v.(string)
+			return str
+		}
+	}
+	return ""
+}
+
+func (s secretValue) setField(name, value string) {
+	for k := range s {
+		if strings.EqualFold(k, name) {
+			s[k] = value
+			return
+		}
+	}
+	s[name] = value
+}
+
+// withKey returns a copy of the secret with the given key, the APIUserID is only set if the secret has one.
+func (s secretValue) withKey(key *admin.ApiKeyUserDetails) secretValue {
+	c := secretValue{}
+	for k, v := range s {
+		c[k] = v
+	}
+	c.setField(fieldPublicKey, key.GetPublicKey())
+	c.setField(fieldPrivateKey, key.GetPrivateKey())
+	if c.field(fieldAPIUserID) != "" {
+		c.setField(fieldAPIUserID, key.GetId())
+	}
+	return c
+}
+
+func (s secretValue) marshal() string {
+	b, _ := json.Marshal(map[string]any(s))
+	return string(b)
+}
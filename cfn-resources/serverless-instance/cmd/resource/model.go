@@ -19,6 +19,7 @@ type Model struct {
 	TerminationProtectionEnabled *bool                                `json:",omitempty"`
 	TotalCount                   *float64                             `json:",omitempty"`
 	Profile                      *string                              `json:",omitempty"`
+	TimeoutOptions               *TimeoutOptions                      `json:",omitempty"`
 }
 
 // ServerlessInstanceConnectionStrings is autogenerated from the json schema
@@ -46,3 +47,10 @@ type ServerlessInstanceProviderSettings struct {
 	ProviderName *string `json:",omitempty"`
 	RegionName   *string `json:",omitempty"`
 }
+
+// TimeoutOptions is autogenerated from the json schema
+type TimeoutOptions struct {
+	TimeOutInSeconds       *int  `json:",omitempty"`
+	CallbackDelaySeconds   *int  `json:",omitempty"`
+	ReturnSuccessIfTimeOut *bool `json:",omitempty"`
+}
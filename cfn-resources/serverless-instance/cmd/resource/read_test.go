@@ -0,0 +1,53 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/serverless-instance/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/serverless/{name}", admin.ServerlessInstanceDescription{
+		Id:                           admin.PtrString("650000000000000000000002"),
+		GroupId:                      admin.PtrString("650000000000000000000001"),
+		Name:                         admin.PtrString("serverless"),
+		StateName:                    admin.PtrString("IDLE"),
+		MongoDBVersion:               admin.PtrString("7.0.0"),
+		TerminationProtectionEnabled: admin.PtrBool(false),
+		ProviderSettings:             admin.ServerlessProviderSettings{BackingProviderName: "AWS", ProviderName: admin.PtrString("SERVERLESS"), RegionName: "US_EAST_1"},
+		ServerlessBackupOptions:      &admin.ClusterServerlessBackupOptions{ServerlessContinuousBackupEnabled: admin.PtrBool(true)},
+		ConnectionStrings:            &admin.ServerlessInstanceDescriptionConnectionStrings{StandardSrv: admin.PtrString("mongodb+srv://serverless.fake.mongodb.net")},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "serverless instance",
+		Schema:         "../../mongodb-atlas-serverlessinstance.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectID": "650000000000000000000001", "Name": "serverless",
+				"ProviderSettings": {"ProviderName": "SERVERLESS", "RegionName": "US_EAST_1"},
+				"ContinuousBackupEnabled": true, "TerminationProtectionEnabled": false}`,
+		}},
+	})
+}
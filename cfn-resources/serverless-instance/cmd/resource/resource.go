@@ -18,15 +18,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	log "github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
@@ -40,34 +40,33 @@ var UpdateRequiredFields = []string{constants.ProjID, constants.Name}
 var DeleteRequiredFields = []string{constants.ProjID, constants.Name}
 var ListRequiredFields = []string{constants.ProjID}
 
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-serverless-instance",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-ServerlessInstance")
-}
-
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	// Validation
-	modelValidation := validateModel(CreateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	// Create atlas client
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
-	// Callback
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if stateName, ok := req.CallbackContext[constants.StateName]; ok {
 		_, _ = log.Debugf("Callback state: %s", stateName)
-		return serverlessCallback(client, currentModel, constants.IdleState)
+		return serverlessCallback(&req, client, currentModel, constants.IdleState)
 	}
 
 	serverlessInstanceRequest := &admin.ServerlessInstanceDescriptionCreate{
@@ -79,15 +78,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	serverless, res, err := client.Atlas20231115002.ServerlessInstancesApi.CreateServerlessInstance(context.Background(), *currentModel.ProjectID, serverlessInstanceRequest).Execute()
 	if err != nil {
-		if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusBadRequest && strings.Contains(*apiError.ErrorCode, constants.Duplicate) {
-			_, _ = log.Debugf("Serverless - Create() - error 400: %+v", err)
-			return handler.ProgressEvent{
-				OperationStatus:  handler.Failed,
-				Message:          err.Error(),
-				HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}, nil
-		}
-
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	return handler.ProgressEvent{
@@ -101,24 +92,10 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	// Validation
-	modelValidation := validateModel(ReadRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	// Create atlas client
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	cluster, res, err := client.Atlas20231115002.ServerlessInstancesApi.GetServerlessInstance(context.Background(), *currentModel.ProjectID, *currentModel.Name).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 	// Read Instance
 	model := readServerlessInstance(cluster, currentModel.Profile)
@@ -130,30 +107,15 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	// Validation
-	modelValidation := validateModel(UpdateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
-	// Callback
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if _, ok := req.CallbackContext[constants.StateName]; ok {
-		return serverlessCallback(client, currentModel, constants.IdleState)
+		return serverlessCallback(&req, client, currentModel, constants.IdleState)
 	}
 
 	// CFN TEST : currently Update is throwing 500 Error instead of 404 if resource not exists
 	_, res, err := client.Atlas20231115002.ServerlessInstancesApi.GetServerlessInstance(context.Background(), *currentModel.ProjectID, *currentModel.Name).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	serverlessInstanceRequest := &admin.UpdateServerlessInstanceApiParams{
@@ -169,7 +131,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	serverless, res, err := client.Atlas20231115002.ServerlessInstancesApi.UpdateServerlessInstanceWithParams(context.Background(), serverlessInstanceRequest).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 	// Response
 	return handler.ProgressEvent{
@@ -184,28 +146,14 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	// Validation
-	modelValidation := validateModel(DeleteRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if _, ok := req.CallbackContext[constants.StateName]; ok {
-		return serverlessCallback(client, currentModel, constants.DeletedState)
+		return serverlessCallback(&req, client, currentModel, constants.DeletedState)
 	}
 
 	_, res, err := client.Atlas20231115002.ServerlessInstancesApi.DeleteServerlessInstance(context.Background(), *currentModel.ProjectID, *currentModel.Name).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	// Response
@@ -220,40 +168,34 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	// Validation
-	modelValidation := validateModel(ListRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	results, nextToken, peErr := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.ServerlessInstanceDescription, int, *http.Response, error) {
+		listOptions := &admin.ListServerlessInstancesApiParams{
+			GroupId:      *currentModel.ProjectID,
+			IncludeCount: admin.PtrBool(true),
+			PageNum:      admin.PtrInt(pageNum),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+		}
+		clustersResp, res, err := client.Atlas20231115002.ServerlessInstancesApi.ListServerlessInstancesWithParams(context.Background(), listOptions).Execute()
+		if err != nil {
+			return nil, 0, res, err
+		}
+		return clustersResp.GetResults(), clustersResp.GetTotalCount(), res, nil
+	})
 	if peErr != nil {
 		return *peErr, nil
 	}
 
-	listOptions := &admin.ListServerlessInstancesApiParams{
-		GroupId:      *currentModel.ProjectID,
-		PageNum:      admin.PtrInt(0),
-		ItemsPerPage: admin.PtrInt(1000),
-	}
-	clustersResp, res, err := client.Atlas20231115002.ServerlessInstancesApi.ListServerlessInstancesWithParams(context.Background(), listOptions).Execute()
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
-	}
-
 	instances := []interface{}{} // cfn test needs empty array instead nil, when items entries found
-	for i := range clustersResp.Results {
-		cluster := readServerlessInstance(&clustersResp.Results[i], currentModel.Profile)
+	for i := range results {
+		cluster := readServerlessInstance(&results[i], currentModel.Profile)
 		instances = append(instances, cluster)
 	}
 	// Response
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		ResourceModel:   instances,
+		ResourceModels:  instances,
+		NextToken:       nextToken,
 	}, nil
 }
 
@@ -339,39 +281,31 @@ func readPrivateEndpointEndpoints(peEndpoints []admin.ServerlessConnectionString
 	return
 }
 
-func serverlessCallback(client *util.MongoDBClient, currentModel *Model, targtStatus string) (progressEvent handler.ProgressEvent, err error) {
+func serverlessCallback(req *handler.Request, client *util.MongoDBClient, currentModel *Model, targetState string) (progressEvent handler.ProgressEvent, err error) {
+	poller := progressevent.Poller{
+		Resource:        "Serverless instance " + *currentModel.Name,
+		TargetStates:    []string{targetState},
+		Timeout:         time.Hour,
+		MinDelaySeconds: CallBackSeconds,
+		MaxDelaySeconds: 4 * CallBackSeconds,
+	}.WithOptions((*progressevent.TimeoutOptions)(currentModel.TimeoutOptions), progressevent.TypeTimeoutOptions(req))
+	if targetState == constants.IdleState {
+		poller.FailureStates = []string{constants.DeletingState, constants.DeletedState}
+	}
+
 	serverless, resp, err := client.Atlas20231115002.ServerlessInstancesApi.GetServerlessInstance(context.Background(), *currentModel.ProjectID, *currentModel.Name).Execute()
 	if err != nil {
 		if apiError, ok := admin.AsError(err); ok && *apiError.Error == http.StatusNotFound {
 			_, _ = log.Debugf("404: No instance found")
-			return handler.ProgressEvent{
-				OperationStatus: handler.Success,
-				Message:         "Deleted ServerlessInstance",
-				ResourceModel:   nil,
-			}, nil
+			return poller.Poll(req.CallbackContext, progressevent.PollState{Name: constants.DeletedState}), nil
 		}
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevent.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel.Id = serverless.Id
-	if *serverless.StateName != constants.IdleState {
-		return handler.ProgressEvent{
-			OperationStatus:      handler.InProgress,
-			Message:              fmt.Sprintf("Create ServerlessInstance `%s`", *serverless.StateName),
-			ResourceModel:        currentModel,
-			CallbackDelaySeconds: CallBackSeconds,
-			CallbackContext: map[string]interface{}{
-				constants.StateName: serverless.StateName,
-			},
-		}, nil
+	state := progressevent.PollState{Name: serverless.GetStateName(), Model: currentModel}
+	if state.Name == targetState {
+		state.Model = readServerlessInstance(serverless, currentModel.Profile)
 	}
-
-	model := readServerlessInstance(serverless, currentModel.Profile)
-
-	// Response
-	return handler.ProgressEvent{
-		OperationStatus: handler.Success,
-		Message:         fmt.Sprintf("Create ServerlessInstance `%s`", *serverless.StateName),
-		ResourceModel:   model,
-	}, nil
+	return poller.Poll(req.CallbackContext, state), nil
 }
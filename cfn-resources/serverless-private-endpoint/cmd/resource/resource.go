@@ -18,19 +18,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/serverless-private-endpoint/cmd/resource/enums"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	aws_utils "github.com/mongodb/mongodbatlas-cloudformation-resources/util/aws"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
@@ -48,22 +50,30 @@ const (
 	AwsPrivateEndpointMetaData = "AwsPrivateEndpointMetaData"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-serverless-private-endpoint")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-serverless-private-endpoint",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: CreateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ReadRequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(CreateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	errorProgressEvent := currentModel.validateAwsPrivateEndpointProperties()
 	if errorProgressEvent != nil {
 		return *errorProgressEvent, nil
@@ -73,11 +83,6 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		currentModel.ProviderName = aws.String(constants.AWS)
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	status, pe := getProcessStatus(req)
 	if pe != nil {
 		return *pe, nil
@@ -98,7 +103,6 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	case enums.CreatingPrivateEndpoint:
 		progressEvent := validateCompletion(req, currentModel, client, enums.Reserved, constants.CREATE)
 		if progressEvent.OperationStatus != handler.Success {
-			progressEvent.CallbackContext = req.CallbackContext
 			return progressEvent, nil
 		}
 
@@ -118,7 +122,6 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	default:
 		progressEvent := validateCompletion(req, currentModel, client, enums.Available, constants.CREATE)
 		if progressEvent.OperationStatus == handler.InProgress {
-			progressEvent.CallbackContext = req.CallbackContext
 			progressEvent.CallbackContext[stateName] = enums.InitiatingPrivateEndpoint
 		}
 
@@ -126,23 +129,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(ReadRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	createServerlessPrivateEndpointRequest := client.Atlas20231115014.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(context.Background(),
 		*currentModel.ProjectId, *currentModel.InstanceName, *currentModel.Id)
 	serverlessPrivateEndpoint, response, err := createServerlessPrivateEndpointRequest.Execute()
@@ -151,8 +138,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		if isTenantPrivateEndpointNotFound(response) {
 			return progressevents.GetFailedEventByCode(fmt.Sprintf("error getting Serverless Private Endpoint %s", err.Error()), cloudformation.HandlerErrorCodeNotFound), nil
 		}
-		return progressevents.GetFailedEventByResponse(fmt.Sprintf("error getting Serverless Private Endpoint %s",
-			err.Error()), response), nil
+		return progressevents.GetFailedEventByError(err, response), nil
 	}
 
 	if serverlessPrivateEndpoint == nil {
@@ -168,27 +154,11 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		ResourceModel:   currentModel}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(CreateRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.ProviderName == nil {
 		currentModel.ProviderName = aws.String(constants.AWS)
 	}
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
 	if isRequestInProgress(req) {
 		return validateCompletion(req, currentModel, client, enums.Available, constants.UPDATE), nil
 	}
@@ -208,8 +178,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		if isTenantPrivateEndpointNotFound(response) {
 			return progressevents.GetFailedEventByCode(fmt.Sprintf("error updating Serverless Private Endpoint %s", err.Error()), cloudformation.HandlerErrorCodeNotFound), nil
 		}
-		return progressevents.GetFailedEventByResponse(fmt.Sprintf("error updating Serverless Private Endpoint %s",
-			err.Error()), response), nil
+		return progressevents.GetFailedEventByError(err, response), nil
 	}
 
 	if serverlessPrivateEndpoint == nil {
@@ -232,25 +201,10 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return progressevents.GetInProgressProgressEvent("Update in progress", callbackContext, currentModel, callbackDelayInSeconds), nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	currentModel.validateAwsPrivateEndpointProperties()
 
 	// Check if the model is valid for deletion
-	modelValidation := validator.ValidateModel(DeleteRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
 
 	createAndAssignAWSPrivateEndpoint, region := unmarshallAwsMetadata(*currentModel.AwsPrivateEndpointMetaData)
 	if !isRequestInProgress(req) && createAndAssignAWSPrivateEndpoint {
@@ -277,36 +231,19 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			}
 			return progressevents.GetFailedEventByCode(fmt.Sprintf("error deleting Serverless Private Endpoint %s", err.Error()), cloudformation.HandlerErrorCodeNotFound), nil
 		}
-		return progressevents.GetFailedEventByResponse(fmt.Sprintf("error deleting Serverless Private Endpoint %s", err.Error()), response), nil
+		return progressevents.GetFailedEventByError(err, response), nil
 	}
 
 	return progressevents.GetInProgressProgressEvent("Create in progress", getCallbackContext(*currentModel.Id, aws.String("")), currentModel, callbackDelayInSeconds), nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	modelValidation := validator.ValidateModel(ReadRequiredFields, currentModel)
-	if modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	if currentModel.Profile == nil || *currentModel.Profile == "" {
-		currentModel.Profile = aws.String(profile.DefaultProfile)
-	}
-
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	listServerlessPrivateEndpointRequest := client.Atlas20231115014.ServerlessPrivateEndpointsApi.ListServerlessPrivateEndpoints(context.Background(),
 		*currentModel.ProjectId, *currentModel.InstanceName)
 	serverlessPrivateEndpoints, response, err := listServerlessPrivateEndpointRequest.Execute()
 	defer response.Body.Close()
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(fmt.Sprintf("error getting Serverless Private Endpoint %s",
-			err.Error()), response), nil
+		return progressevents.GetFailedEventByError(err, response), nil
 	}
 
 	return handler.ProgressEvent{
@@ -341,8 +278,7 @@ func deleteAwsPrivateEndpoint(currentModel *Model, region string, client *util.M
 			pe := progressevents.GetFailedEventByCode(fmt.Sprintf("error getting Serverless Private Endpoint %s", err.Error()), cloudformation.HandlerErrorCodeNotFound)
 			return &pe
 		}
-		pe := progressevents.GetFailedEventByResponse(fmt.Sprintf("error getting Serverless Private Endpoint %s",
-			err.Error()), response)
+		pe := progressevents.GetFailedEventByError(err, response)
 		return &pe
 	}
 
@@ -367,8 +303,7 @@ func createAtlasPrivateEndpoint(currentModel *Model, client *util.MongoDBClient)
 	serverlessPrivateEndpoint, response, err := createServerlessPrivateEndpointRequest.Execute()
 	defer response.Body.Close()
 	if err != nil {
-		errPe := progressevents.GetFailedEventByResponse(fmt.Sprintf("error creating Serverless Private Endpoint %s",
-			err.Error()), response)
+		errPe := progressevents.GetFailedEventByError(err, response)
 		return nil, &errPe
 	}
 
@@ -399,8 +334,7 @@ func assignAwsPrivateEndpoint(req handler.Request, client *util.MongoDBClient, a
 		if isTenantPrivateEndpointNotFound(response) {
 			return progressevents.GetFailedEventByCode(fmt.Sprintf("error updating Serverless Private Endpoint %s", err.Error()), cloudformation.HandlerErrorCodeNotFound)
 		}
-		return progressevents.GetFailedEventByResponse(fmt.Sprintf("error updating Serverless Private Endpoint %s",
-			err.Error()), response)
+		return progressevents.GetFailedEventByError(err, response)
 	}
 
 	if serverlessPrivateEndpoint == nil {
@@ -475,20 +409,30 @@ func validateCompletion(req handler.Request, currentModel *Model, client *util.M
 			cloudformation.HandlerErrorCodeServiceInternalError)
 	}
 
-	switch *serverlessPrivateEndpoint.Status {
-	case string(targetStatus):
+	if *serverlessPrivateEndpoint.Status == string(targetStatus) {
 		currentModel.completeWithAtlasModel(*serverlessPrivateEndpoint)
-		return handler.ProgressEvent{
-			OperationStatus: handler.Success,
-			Message:         fmt.Sprintf("%s Completed", string(cfnFunction)),
-			ResourceModel:   currentModel}
-	case string(enums.Failed):
-		return progressevents.GetFailedEventByCode(fmt.Sprintf("%s : the serverless private endpoint is in a Failed AtlasPrivateEndpointStatus, error: %s", string(cfnFunction),
-			*serverlessPrivateEndpoint.ErrorMessage), cloudformation.HandlerErrorCodeServiceInternalError)
-	default:
-		return progressevents.GetInProgressProgressEvent(fmt.Sprintf("%s in progress", string(cfnFunction)),
-			getCallbackContext(privateEndpointID, serverlessPrivateEndpoint.EndpointServiceName), currentModel, callbackDelayInSeconds)
 	}
+	poller := progressevents.Poller{
+		Resource:        fmt.Sprintf("%s: the serverless private endpoint", string(cfnFunction)),
+		Message:         fmt.Sprintf("%s in progress", string(cfnFunction)),
+		TargetStates:    []string{string(targetStatus)},
+		FailureStates:   []string{string(enums.Failed)},
+		Timeout:         30 * time.Minute,
+		MinDelaySeconds: callbackDelayInSeconds,
+		MaxDelaySeconds: 30,
+	}
+	event := poller.Poll(req.CallbackContext, progressevents.PollState{
+		Name:           *serverlessPrivateEndpoint.Status,
+		Model:          currentModel,
+		FailureMessage: util.SafeString(serverlessPrivateEndpoint.ErrorMessage),
+	})
+	switch event.OperationStatus {
+	case handler.Success:
+		event.Message = fmt.Sprintf("%s Completed", string(cfnFunction))
+	case handler.InProgress:
+		maps.Copy(event.CallbackContext, getCallbackContext(privateEndpointID, serverlessPrivateEndpoint.EndpointServiceName))
+	}
+	return event
 }
 
 func getCallbackContext(privateEndpointID string, serviceName *string) map[string]interface{} {
@@ -508,7 +452,13 @@ func (currentModel *Model) completeWithAtlasModel(atlasModel admin.ServerlessTen
 	currentModel.ProviderName = atlasModel.ProviderName
 	currentModel.ErrorMessage = atlasModel.ErrorMessage
 	currentModel.EndpointServiceName = atlasModel.EndpointServiceName
-	currentModel.completeAwsPrivateEndpointMetaData()
+	currentModel.Comment = atlasModel.Comment
+	currentModel.CloudProviderEndpointId = atlasModel.CloudProviderEndpointId
+	currentModel.PrivateEndpointIpAddress = atlasModel.PrivateEndpointIpAddress
+	// the metadata of an existing endpoint is part of its identifier, Atlas doesn't return it
+	if currentModel.AwsPrivateEndpointMetaData == nil {
+		currentModel.completeAwsPrivateEndpointMetaData()
+	}
 }
 
 func (currentModel *Model) validateAwsPrivateEndpointProperties() *handler.ProgressEvent {
@@ -0,0 +1,50 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/stream-connection/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/streams/{tenantName}/connections/{connectionName}", admin.StreamsConnection{
+		Name:             admin.PtrString("kafka"),
+		Type:             admin.PtrString("Kafka"),
+		BootstrapServers: admin.PtrString("broker:9092"),
+		Authentication:   &admin.StreamsKafkaAuthentication{Mechanism: admin.PtrString("PLAIN"), Username: admin.PtrString("user")},
+		Security:         &admin.StreamsKafkaSecurity{Protocol: admin.PtrString("PLAINTEXT")},
+		Config:           &map[string]string{"auto.offset.reset": "earliest"},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "stream connection",
+		Schema:         "../../mongodb-atlas-streamconnection.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "InstanceName": "instance", "ConnectionName": "kafka",
+				"Type": "Kafka", "BootstrapServers": "broker:9092", "Authentication": {"Mechanism": "PLAIN", "Username": "user", "Password": "password"},
+				"Security": {"Protocol": "PLAINTEXT"}, "Config": {"auto.offset.reset": "earliest"}}`,
+		}},
+	})
+}
@@ -21,12 +21,13 @@ import (
 
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 )
 
 const (
@@ -40,27 +41,31 @@ var UpdateRequiredFields = []string{constants.ProjectID, constants.InstanceName,
 var DeleteRequiredFields = []string{constants.ProjectID, constants.InstanceName, constants.ConnectionName}
 var ListRequiredFields = []string{constants.ProjectID, constants.InstanceName}
 
-func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
-	util.SetupLogger("mongodb-atlas-stream-connection")
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-stream-connection",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
+}
 
-	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
-		return nil, errEvent
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return nil, peErr
-	}
-	return client.Atlas20231115014, nil
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn := client.Atlas20231115014
 
 	ctx := context.Background()
 
@@ -82,11 +87,8 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn := client.Atlas20231115014
 
 	projectID := currentModel.ProjectId
 	instanceName := currentModel.InstanceName
@@ -104,11 +106,8 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	conn, peErr := initEnvWithLatestClient(req, currentModel, UpdateRequiredFields)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn := client.Atlas20231115014
 
 	ctx := context.Background()
 
@@ -130,11 +129,8 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn := client.Atlas20231115014
 
 	ctx := context.Background()
 
@@ -151,25 +147,24 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		ResourceModel:   nil}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	conn, peErr := initEnvWithLatestClient(req, currentModel, ListRequiredFields)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn := client.Atlas20231115014
 
 	ctx := context.Background()
 
 	projectID := currentModel.ProjectId
 	instanceName := currentModel.InstanceName
 
-	accumulatedStreamConns, apiResp, err := getAllStreamConnections(ctx, conn, *projectID, *instanceName)
-	if err != nil {
-		return handleError(apiResp, constants.LIST, err)
+	streamConns, nextToken, peErr := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.StreamsConnection, int, *http.Response, error) {
+		return listStreamConnections(ctx, conn, *projectID, *instanceName, pageNum, itemsPerPage)
+	})
+	if peErr != nil {
+		return *peErr, nil
 	}
 
 	response := make([]interface{}, 0)
-	for i := range accumulatedStreamConns {
-		model := GetStreamConnectionModel(&accumulatedStreamConns[i], nil)
+	for i := range streamConns {
+		model := GetStreamConnectionModel(&streamConns[i], nil)
 		model.ProjectId = currentModel.ProjectId
 		model.InstanceName = currentModel.InstanceName
 		model.Profile = currentModel.Profile
@@ -180,34 +175,25 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		ResourceModels:  response,
+		NextToken:       nextToken,
 	}, nil
 }
 
-func getAllStreamConnections(ctx context.Context, conn *admin.APIClient, projectID, instanceName string) ([]admin.StreamsConnection, *http.Response, error) {
-	pageNum := 1
-	accumulatedStreamConns := make([]admin.StreamsConnection, 0)
-
-	for allRecordsRetrieved := false; !allRecordsRetrieved; {
-		streamConns, apiResp, err := conn.StreamsApi.ListStreamConnectionsWithParams(ctx, &admin.ListStreamConnectionsApiParams{
-			GroupId:      projectID,
-			TenantName:   instanceName,
-			ItemsPerPage: util.Pointer(constants.DefaultListItemsPerPage),
-			PageNum:      util.Pointer(pageNum),
-		}).Execute()
-
-		if err != nil {
-			return nil, apiResp, err
-		}
-		accumulatedStreamConns = append(accumulatedStreamConns, streamConns.GetResults()...)
-		allRecordsRetrieved = streamConns.GetTotalCount() <= len(accumulatedStreamConns)
-		pageNum++
+func listStreamConnections(ctx context.Context, conn *admin.APIClient, projectID, instanceName string, pageNum, itemsPerPage int) ([]admin.StreamsConnection, int, *http.Response, error) {
+	streamConns, apiResp, err := conn.StreamsApi.ListStreamConnectionsWithParams(ctx, &admin.ListStreamConnectionsApiParams{
+		GroupId:      projectID,
+		TenantName:   instanceName,
+		ItemsPerPage: util.Pointer(itemsPerPage),
+		PageNum:      util.Pointer(pageNum),
+	}).Execute()
+	if err != nil {
+		return nil, 0, apiResp, err
 	}
-
-	return accumulatedStreamConns, nil, nil
+	return streamConns.GetResults(), streamConns.GetTotalCount(), apiResp, nil
 }
 
 func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
-	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
-
-	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+	event := progress_events.GetFailedEventByError(err, response)
+	event.Message = fmt.Sprintf("%s error:%s", method, event.Message)
+	return event, nil
 }
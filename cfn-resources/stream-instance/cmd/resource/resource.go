@@ -16,23 +16,41 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
 
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-stream-instance")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-stream-instance",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: UpdateRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: DeleteRequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
+}
+
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
+
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
 }
 
 var CreateRequiredFields = []string{constants.InstanceName, constants.ProjectID, constants.DataProcessRegion}
@@ -45,19 +63,7 @@ const Kafka = "Kafka"
 const Cluster = "Cluster"
 const defaultItemsPerPage = 100
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if errEvent := validator.ValidateModel(CreateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
-
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	streamInstanceCreateReq := NewStreamsTenant(currentModel)
 
 	atlasV2 := client.Atlas20231115014
@@ -76,19 +82,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if errEvent := validator.ValidateModel(ReadRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 
 	streamInstance, resp, err := atlasV2.StreamsApi.GetStreamInstance(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName).Execute()
@@ -105,19 +99,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if errEvent := validator.ValidateModel(UpdateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
-
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	updateRequest := &admin.StreamsDataProcessRegion{
 		CloudProvider: *currentModel.DataProcessRegion.CloudProvider,
 		Region:        *currentModel.DataProcessRegion.Region,
@@ -139,19 +121,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if errEvent := validator.ValidateModel(DeleteRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 
 	_, resp, err := atlasV2.StreamsApi.DeleteStreamInstance(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName).Execute()
@@ -164,27 +134,17 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		Message:         "Delete success"}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	if errEvent := validator.ValidateModel(ListRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
-
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115014
 
-	accumulatedStreamInstances, apiResp, err := getAllStreamInstances(context.Background(), atlasV2, *currentModel.ProjectId)
-	if err != nil {
-		return handleError(apiResp, constants.LIST, err)
+	streamInstances, nextToken, peErr := util.ListPage(&req, defaultItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.StreamsTenant, int, *http.Response, error) {
+		return listStreamInstances(context.Background(), atlasV2, *currentModel.ProjectId, pageNum, itemsPerPage)
+	})
+	if peErr != nil {
+		return *peErr, nil
 	}
 	response := make([]interface{}, 0)
-	for _, stream := range accumulatedStreamInstances {
+	for _, stream := range streamInstances {
 		response = append(response, newCFNModelFromStreamInstance(currentModel, stream))
 	}
 
@@ -192,35 +152,24 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "List Complete",
 		ResourceModels:  response,
+		NextToken:       nextToken,
 	}, nil
 }
 
-func getAllStreamInstances(ctx context.Context, conn *admin.APIClient, projectID string) ([]admin.StreamsTenant, *http.Response, error) {
-	pageNum := 1
-	accumulatedStreamInstances := make([]admin.StreamsTenant, 0)
-	for allStreamInstancesRetrieved := false; !allStreamInstancesRetrieved; {
-		listStreamInstancesRequest := conn.StreamsApi.ListStreamInstances(ctx, projectID)
-		listStreamInstancesRequest.PageNum(pageNum)
-		listStreamInstancesRequest.ItemsPerPage(defaultItemsPerPage)
-		streamInstances, resp, err := listStreamInstancesRequest.Execute()
-		if err != nil {
-			return nil, resp, err
-		}
-		accumulatedStreamInstances = append(accumulatedStreamInstances, streamInstances.GetResults()...)
-		allStreamInstancesRetrieved = streamInstances.GetTotalCount() <= len(accumulatedStreamInstances)
-		pageNum++
+func listStreamInstances(ctx context.Context, conn *admin.APIClient, projectID string, pageNum, itemsPerPage int) ([]admin.StreamsTenant, int, *http.Response, error) {
+	streamInstances, resp, err := conn.StreamsApi.ListStreamInstances(ctx, projectID).
+		PageNum(pageNum).
+		ItemsPerPage(itemsPerPage).
+		Execute()
+	if err != nil {
+		return nil, 0, resp, err
 	}
-	return accumulatedStreamInstances, nil, nil
+	return streamInstances.GetResults(), streamInstances.GetTotalCount(), resp, nil
 }
 
 func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
-	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
-	_, _ = logger.Warn(errMsg)
-	if response.StatusCode == http.StatusConflict {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          errMsg,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}, nil
-	}
-	return progressevent.GetFailedEventByResponse(errMsg, response), nil
+	_, _ = logger.Warnf("%s error:%s", method, err.Error())
+	event := progressevent.GetFailedEventByError(err, response)
+	event.Message = fmt.Sprintf("%s error:%s", method, event.Message)
+	return event, nil
 }
@@ -20,14 +20,15 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	teamuser "github.com/mongodb/mongodbatlas-cloudformation-resources/teams/cmd/resource/team-user"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 	"github.com/spf13/cast"
 	atlasv2 "go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
@@ -35,20 +36,30 @@ import (
 var CreateRequiredFields = []string{constants.OrgID}
 var ReadRequiredFields = []string{constants.OrgID, constants.TeamID}
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup() // logger setup
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-teams",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: ReadRequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: ReadRequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource},
+	List:     handlerkit.Operation[Model]{Run: list},
+}
 
-	// Validate required fields in the request
-	if modelValidation := validateModel(CreateRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, errors.New("required field not found")
-	}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
+
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
 
 	// API call to create team
@@ -63,7 +74,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		}).Execute()
 
 		if err != nil {
-			return progressevents.GetFailedEventByResponse(fmt.Sprintf("unable to create team %v", err), resp), nil
+			return progressevents.GetFailedEventByError(err, resp), nil
 		}
 		teamID = util.SafeString(teamResponse.Id)
 		currentModel = convertTeamToModel(teamResponse, currentModel)
@@ -86,20 +97,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		ResourceModel:   currentModel,
 	}, nil
 }
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup() // logger setup
-
-	// Validate required fields in the request
-	if modelValidation := validateModel(ReadRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
 
 	// API call to read snapshot to read using ID field
@@ -118,7 +116,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}
 
 	if err != nil {
-		return progressevents.GetFailedEventByResponse(err.Error(), resp), nil
+		return progressevents.GetFailedEventByError(err, resp), nil
 	}
 
 	currentModel = convertTeamResponseToModel(team, currentModel)
@@ -176,27 +174,13 @@ func newAtlasRoles(roles []atlasv2.CloudAccessRoleAssignment) []AtlasRole {
 	return modelRole
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup() // logger setup
-
-	// Validate required fields in the request
-	if modelValidation := validateModel(ReadRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, errors.New("required field not found")
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
 
 	team, res, err := getTeam(atlasV2, currentModel)
 	if err != nil && res != nil {
 		_, _ = logger.Debugf("error getting Team information: %s", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevents.GetFailedEventByError(err, res), nil
 	} else if err != nil {
 		_, _ = logger.Debugf("error getting Team information: %s", *currentModel.TeamId)
 		return handler.ProgressEvent{
@@ -266,48 +250,47 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	return event, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup() // logger setup
-
-	_, _ = logger.Debugf("List Teams  Request :%+v", currentModel)
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
 
 	// Create Atlas API Request Object
 	orgID := cast.ToString(currentModel.OrgId)
 	projectID := cast.ToString(currentModel.ProjectId)
 	var models []interface{}
-	var resp *http.Response
-	var err error
+	var nextToken string
+	var peErr *handler.ProgressEvent
 	// API call to get teams for project id
 	if projectID != "" {
-		var teamsAssigned *atlasv2.PaginatedTeamRole
-		teamsAssigned, resp, err = atlasV2.TeamsApi.ListProjectTeams(context.Background(), projectID).Execute()
-
-		if err != nil {
-			return progressevents.GetFailedEventByResponse(err.Error(), resp), nil
+		var teamsProjectList []atlasv2.TeamRole
+		teamsProjectList, nextToken, peErr = util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]atlasv2.TeamRole, int, *http.Response, error) {
+			teamsAssigned, resp, err := atlasV2.TeamsApi.ListProjectTeams(context.Background(), projectID).
+				PageNum(pageNum).ItemsPerPage(itemsPerPage).IncludeCount(true).Execute()
+			if err != nil {
+				return nil, 0, resp, err
+			}
+			return teamsAssigned.GetResults(), teamsAssigned.GetTotalCount(), resp, nil
+		})
+		if peErr != nil {
+			return *peErr, nil
 		}
 
-		teamsProjectList := teamsAssigned.Results
 		for i := 0; i < len(teamsProjectList); i++ {
 			models = append(models, convertProjectTeamToModel(teamsProjectList[i]))
 		}
 	} else {
 		// API call to get teams from organization
-		var paginatedResp *atlasv2.PaginatedTeam
-		paginatedResp, resp, err = atlasV2.TeamsApi.ListOrganizationTeams(context.Background(), orgID).Execute()
-
-		if err != nil {
-			return progressevents.GetFailedEventByResponse(err.Error(), resp), nil
+		var teams []atlasv2.TeamResponse
+		teams, nextToken, peErr = util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]atlasv2.TeamResponse, int, *http.Response, error) {
+			paginatedResp, resp, err := atlasV2.TeamsApi.ListOrganizationTeams(context.Background(), orgID).
+				PageNum(pageNum).ItemsPerPage(itemsPerPage).IncludeCount(true).Execute()
+			if err != nil {
+				return nil, 0, resp, err
+			}
+			return paginatedResp.GetResults(), paginatedResp.GetTotalCount(), resp, nil
+		})
+		if peErr != nil {
+			return *peErr, nil
 		}
-		teams := paginatedResp.Results
 		for i := 0; i < len(teams); i++ {
 			models = append(models, convertTeamResponseToModel(&teams[i], nil))
 		}
@@ -317,21 +300,11 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "List Complete",
 		ResourceModels:  models,
+		NextToken:       nextToken,
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup() // logger setup
-
-	_, _ = logger.Debugf("Delete Team  Request() :%+v", currentModel)
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-
-	client, handlerError := util.NewAtlasClient(&req, currentModel.Profile)
-	if handlerError != nil {
-		_, _ = logger.Warnf("CreateMongoDBClient error: %v", handlerError)
-		return *handlerError, errors.New(handlerError.Message)
-	}
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	atlasV2 := client.Atlas20231115002
 
 	team, _, _ := getTeam(atlasV2, currentModel)
@@ -354,7 +327,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	if err := removeFromOrganization(atlasV2, currentModel); err != nil {
 		// if team is assigned to project then first delete from project
-		if atlasv2.IsErrorCode(err, "CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT") {
+		if progressevents.IsErrorCode(err, "CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT") {
 			if err := removeFromProject(atlasV2, currentModel); err != nil {
 				return handler.ProgressEvent{
 					OperationStatus:  handler.Failed,
@@ -379,9 +352,6 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		Message:         "Delete Complete",
 	}, nil
 }
-func setup() {
-	util.SetupLogger("mongodb-atlas-teams")
-}
 func removeFromProject(atlasV2 *atlasv2.APIClient, currentModel *Model) error {
 	teamID := cast.ToString(currentModel.TeamId)
 	projectID, err := getProjectIDByTeamID(context.Background(), atlasV2, teamID)
@@ -445,9 +415,6 @@ func getProjectIDByTeamID(ctx context.Context, atlasV2 *atlasv2.APIClient, teamI
 }
 
 // function to validate inputs to all actions
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
-}
 
 func convertProjectTeamToModel(team atlasv2.TeamRole) *Model {
 	return &Model{
@@ -98,6 +98,10 @@ func TestClusterCFN(t *testing.T) {
 		testCreateStack(t, testCtx)
 	})
 
+	t.Run("Read From Identifier", func(t *testing.T) {
+		testReadFromIdentifier(t, testCtx)
+	})
+
 	t.Run("Update Stack", func(t *testing.T) {
 		testUpdateStack(t, testCtx)
 	})
@@ -141,6 +145,18 @@ func testCreateStack(t *testing.T, c *localTestContext) {
 	c.replicationIDCreate = replicationSpecs[0].GetId()
 }
 
+func testReadFromIdentifier(t *testing.T, c *localTestContext) {
+	t.Helper()
+
+	model := &resource.Model{
+		ProjectId:        &c.clusterTmplObj.ProjectID,
+		Name:             &c.clusterTmplObj.Name,
+		Profile:          &c.clusterTmplObj.Profile,
+		ReplicationSpecs: c.clusterTmplObj.ReplicationSpecs,
+	}
+	utility.TestReadFromIdentifier(t, resource.Read, model, "ProjectId", "Name", "Profile")
+}
+
 func testUpdateStack(t *testing.T, c *localTestContext) {
 	t.Helper()
 
@@ -20,6 +20,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/project/cmd/resource"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/test/e2e/utility"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/atlas-sdk/v20231115014/admin"
@@ -73,6 +74,10 @@ func TestProjectCFN(t *testing.T) {
 		testCreateStack(t, testCtx)
 	})
 
+	t.Run("Read From Identifier", func(t *testing.T) {
+		testReadFromIdentifier(t, testCtx)
+	})
+
 	t.Run("Update Stack", func(t *testing.T) {
 		testUpdateStack(t, testCtx)
 	})
@@ -119,6 +124,19 @@ func testCreateStack(t *testing.T, c *localTestContext) {
 	a.Equal(tagsCreate, tags)
 }
 
+func testReadFromIdentifier(t *testing.T, c *localTestContext) {
+	t.Helper()
+
+	model := &resource.Model{
+		Id:      &c.projectTmplObj.ProjectID,
+		Profile: &c.projectTmplObj.Profile,
+		Name:    &c.projectTmplObj.Name,
+		OrgId:   &c.projectTmplObj.OrgID,
+		Tags:    c.projectTmplObj.Tags,
+	}
+	utility.TestReadFromIdentifier(t, resource.Read, model, "Id", "Profile")
+}
+
 func testUpdateStack(t *testing.T, c *localTestContext) {
 	t.Helper()
 
@@ -0,0 +1,71 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utility
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ReadFunc is the signature of the Read handler of the resource packages.
+type ReadFunc[M any] func(req handler.Request, prevModel, currentModel *M) (handler.ProgressEvent, error)
+
+// TestReadFromIdentifier checks that the Read handler returns the same model from the primary identifier alone, as
+// given by CloudFormation for an IMPORT or a drift detection, as from the full model of the resource.
+// The handler runs locally, reading the profile of the model with the AWS credentials of the test.
+func TestReadFromIdentifier[M any](t *testing.T, read ReadFunc[M], model *M, primaryIdentifier ...string) {
+	t.Helper()
+
+	fromModel := runRead(t, read, model, nil)
+	fromIdentifier := runRead(t, read, model, primaryIdentifier)
+	assert.JSONEq(t, fromModel, fromIdentifier, "Read from the primary identifier must return the same model as Read from the full model")
+}
+
+// runRead runs the Read handler with a copy of the model restricted to the given properties, all of them if nil,
+// and returns the JSON of the model read.
+func runRead[M any](t *testing.T, read ReadFunc[M], model *M, properties []string) string {
+	t.Helper()
+
+	body, err := json.Marshal(model)
+	require.NoError(t, err)
+	if properties != nil {
+		all := map[string]json.RawMessage{}
+		require.NoError(t, json.Unmarshal(body, &all))
+		for name := range all {
+			if !slices.Contains(properties, name) {
+				delete(all, name)
+			}
+		}
+		body, err = json.Marshal(all)
+		require.NoError(t, err)
+	}
+	currentModel := new(M)
+	require.NoError(t, json.Unmarshal(body, currentModel))
+
+	req := handler.NewRequest("", map[string]any{}, handler.RequestContext{}, session.Must(session.NewSession()), nil, body, nil)
+	event, err := read(req, new(M), currentModel)
+	require.NoError(t, err)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+
+	result, err := json.Marshal(event.ResourceModel)
+	require.NoError(t, err)
+	return string(result)
+}
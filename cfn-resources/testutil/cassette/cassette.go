@@ -0,0 +1,300 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cassette records the Atlas calls of handler tests in a cassette file and replays them offline, so that
+// scenarios written against a real Atlas organization run deterministically in go test.
+//
+// The recorder is an http.RoundTripper wrapping the authenticated transport of the Atlas clients, see
+// util.Config.WrapTransport, so the cassettes contain neither the challenges nor the credentials of the digest
+// authentication. Before a cassette is saved, the sensitive fields of the bodies are redacted and the Atlas IDs are
+// replaced by fake ones, consistently so that the replayed responses lead to the recorded requests.
+//
+// The tests replay their cassette from testdata/cassettes by default. With MONGODB_ATLAS_CASSETTE_MODE=record they
+// call Atlas with the profile of the MONGODB_ATLAS_PUBLIC_KEY and MONGODB_ATLAS_PRIVATE_KEY environment variables
+// and overwrite the cassette.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mongodb-forks/digest"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+const (
+	// ModeEnv is the environment variable selecting the mode of the recorders.
+	ModeEnv = "MONGODB_ATLAS_CASSETTE_MODE"
+	// ModeRecord records the cassettes against Atlas, the default is to replay them.
+	ModeRecord = "record"
+
+	dir = "testdata/cassettes"
+	// baseURL is the profile base URL when replaying, only the paths of the requests are recorded.
+	baseURL = "https://cloud.mongodb.com/"
+)
+
+// Cassette is the content of a cassette file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded Atlas call.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request, matched on all its fields when replaying.
+type Request struct {
+	Method string `json:"method"`
+	// Path includes the query of the request.
+	Path string `json:"path"`
+	Body string `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	ContentType string `json:"contentType,omitempty"`
+	Body        string `json:"body,omitempty"`
+	Status      int    `json:"status"`
+}
+
+// Recorder records or replays the Atlas calls of a test.
+type Recorder struct {
+	t        testing.TB
+	scrubber *scrubber
+	path     string
+	cassette Cassette
+	used     []bool
+	mu       sync.Mutex
+}
+
+// New returns the recorder of the cassette with the given name, e.g. the name of the test, and sets it as the
+// WrapTransport of the Atlas clients of the handlers until the end of the test. It also selects the Environment
+// profile backend, with fake credentials when replaying.
+//
+// Replaying a cassette that is not recorded fails the test.
+func New(t testing.TB, name string) *Recorder {
+	t.Helper()
+	r := &Recorder{t: t, scrubber: newScrubber(), path: filepath.Join(dir, name+".json")}
+	setenv := t.(interface{ Setenv(key, value string) }).Setenv
+	setenv("MONGODB_ATLAS_PROFILE_BACKEND", profile.BackendEnvironment)
+	if r.Recording() {
+		t.Cleanup(r.save)
+	} else {
+		data, err := os.ReadFile(r.path)
+		if errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("cassette %s is not recorded, record it with %s=%s", r.path, ModeEnv, ModeRecord)
+		}
+		if err != nil {
+			t.Fatalf("reading cassette: %v", err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			t.Fatalf("parsing cassette %s: %v", r.path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+		setenv("MONGODB_ATLAS_PUBLIC_KEY", "cassette-public")
+		setenv("MONGODB_ATLAS_PRIVATE_KEY", "cassette-private")
+		setenv("MONGODB_ATLAS_BASE_URL", baseURL)
+		t.Cleanup(r.checkUsed)
+	}
+	t.Cleanup(util.UseTransportWrapper(r.wrap))
+	return r
+}
+
+// Recording reports if the recorder calls Atlas rather than replaying its cassette.
+func (r *Recorder) Recording() bool {
+	return Recording()
+}
+
+// Recording reports if the tests record their cassettes against Atlas, e.g. to run a scenario whose cassette isn't
+// recorded yet only when recording it.
+func Recording() bool {
+	return os.Getenv(ModeEnv) == ModeRecord
+}
+
+// Env returns the value of an environment variable needed to record the cassette, e.g. the ID of the organization,
+// or the given value when replaying. The recorded value is replaced by the replayed one in the cassette.
+func (r *Recorder) Env(name, replayValue string) string {
+	r.t.Helper()
+	if !r.Recording() {
+		return replayValue
+	}
+	value := os.Getenv(name)
+	if value == "" {
+		r.t.Fatalf("%s is required to record the cassette", name)
+	}
+	r.scrubber.replace(value, replayValue)
+	return value
+}
+
+// Wait sleeps while recording, e.g. between the polls of an Atlas object, the replays don't wait.
+func (r *Recorder) Wait(d time.Duration) {
+	if r.Recording() {
+		time.Sleep(d)
+	}
+}
+
+// AtlasClient returns a client of the profile of the test going through the recorder, e.g. to create the
+// prerequisites of the resource.
+func (r *Recorder) AtlasClient() *admin.APIClient {
+	r.t.Helper()
+	c := util.Config{BaseURL: baseURL, WrapTransport: r.wrap}
+	if url := os.Getenv("MONGODB_ATLAS_BASE_URL"); url != "" {
+		c.BaseURL = url
+	}
+	auth := digest.NewTransport(os.Getenv("MONGODB_ATLAS_PUBLIC_KEY"), os.Getenv("MONGODB_ATLAS_PRIVATE_KEY"))
+	client, err := c.NewSDKv20231115014Client(&http.Client{Transport: c.WrapTransport(auth)})
+	if err != nil {
+		r.t.Fatalf("creating Atlas client: %v", err)
+	}
+	return client
+}
+
+func (r *Recorder) wrap(base http.RoundTripper) http.RoundTripper {
+	return &transport{recorder: r, base: base}
+}
+
+type transport struct {
+	recorder *Recorder
+	base     http.RoundTripper
+}
+
+// RoundTrip calls Atlas and records the interaction, or returns the response of the first unused interaction
+// matching the request. Replaying a request not in the cassette fails the test.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := t.recorder
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Recording() {
+		return t.record(req, body)
+	}
+	recorded := Request{Method: req.Method, Path: req.URL.RequestURI(), Body: r.scrubber.redact(body)}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if !r.used[i] && interaction.Request == recorded {
+			r.used[i] = true
+			return newResponse(req, interaction.Response), nil
+		}
+	}
+	r.t.Errorf("cassette %s: no interaction for %s %s %s", r.path, recorded.Method, recorded.Path, recorded.Body)
+	return nil, fmt.Errorf("cassette %s: no interaction for %s %s", r.path, recorded.Method, recorded.Path)
+}
+
+func (t *transport) record(req *http.Request, body string) (*http.Response, error) {
+	r := t.recorder
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: Request{Method: req.Method, Path: req.URL.RequestURI(), Body: body},
+		Response: Response{
+			Status:      resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        string(data),
+		},
+	})
+	return resp, nil
+}
+
+// save writes the scrubbed cassette at the end of a recording.
+func (r *Recorder) save() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.cassette.Interactions {
+		interaction := &r.cassette.Interactions[i]
+		interaction.Request.Path = r.scrubber.scrub(interaction.Request.Path)
+		interaction.Request.Body = r.scrubber.scrub(r.scrubber.redact(interaction.Request.Body))
+		interaction.Response.Body = r.scrubber.scrub(r.scrubber.redact(interaction.Response.Body))
+	}
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		r.t.Errorf("marshaling cassette: %v", err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		r.t.Errorf("creating cassette directory: %v", err)
+		return
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o600); err != nil {
+		r.t.Errorf("writing cassette: %v", err)
+	}
+}
+
+// checkUsed fails the test if an interaction isn't replayed, the handlers no longer make the recorded calls.
+func (r *Recorder) checkUsed() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, used := range r.used {
+		if !used {
+			request := r.cassette.Interactions[i].Request
+			r.t.Errorf("cassette %s: interaction %d not replayed: %s %s", r.path, i, request.Method, request.Path)
+		}
+	}
+}
+
+func readBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+	data, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return string(data), nil
+}
+
+func newResponse(req *http.Request, recorded Response) *http.Response {
+	header := http.Header{}
+	if recorded.ContentType != "" {
+		header.Set("Content-Type", recorded.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
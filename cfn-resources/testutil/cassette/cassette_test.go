@@ -0,0 +1,153 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cassette_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/cassette"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+const (
+	orgID       = "5f1234567890abcdef123456"
+	replayOrgID = "5f0000000000000000000001"
+	projectID   = "65aaaaaaaaaaaaaaaaaaaaaa"
+	apiKey      = "8f9a6c2e-private-key"
+)
+
+// errorsT records the errors of the recorder.
+type errorsT struct {
+	*testing.T
+	errors []string
+}
+
+func (t *errorsT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+// Fatalf records the error and stops the test without failing it.
+func (t *errorsT) Fatalf(format string, args ...any) {
+	t.Errorf(format, args...)
+	t.SkipNow()
+}
+
+// newAtlas returns a server creating and returning a project, the response has a credential.
+func newAtlas(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	project := func(w http.ResponseWriter, name string) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": projectID, "orgId": orgID, "name": name, "privateKey": apiKey})
+	}
+	mux.HandleFunc("POST /api/atlas/v2/groups", func(w http.ResponseWriter, r *http.Request) {
+		var group admin.Group
+		_ = json.NewDecoder(r.Body).Decode(&group)
+		project(w, group.Name)
+	})
+	mux.HandleFunc("GET /api/atlas/v2/groups/{groupId}", func(w http.ResponseWriter, r *http.Request) {
+		project(w, "project")
+	})
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// chdir changes the working directory until the end of the test, the cassettes are relative to it.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
+
+func TestRecordReplay(t *testing.T) {
+	chdir(t, t.TempDir())
+	s := newAtlas(t)
+
+	t.Run("record", func(t *testing.T) {
+		t.Setenv(cassette.ModeEnv, cassette.ModeRecord)
+		t.Setenv("MONGODB_ATLAS_BASE_URL", s.URL)
+		t.Setenv("MONGODB_ATLAS_ORG_ID", orgID)
+		r := cassette.New(t, "project")
+		client := r.AtlasClient()
+		org := r.Env("MONGODB_ATLAS_ORG_ID", replayOrgID)
+		assert.Equal(t, orgID, org)
+
+		created, _, err := client.ProjectsApi.CreateProject(context.Background(), &admin.Group{Name: "project", OrgId: org}).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, projectID, created.GetId())
+		_, _, err = client.ProjectsApi.GetProject(context.Background(), created.GetId()).Execute()
+		require.NoError(t, err)
+	})
+
+	data, err := os.ReadFile(filepath.Join("testdata", "cassettes", "project.json"))
+	require.NoError(t, err)
+	for _, recorded := range []string{orgID, projectID, apiKey} {
+		assert.NotContains(t, string(data), recorded)
+	}
+	assert.Contains(t, string(data), replayOrgID)
+
+	t.Run("replay", func(t *testing.T) {
+		t.Setenv(cassette.ModeEnv, "")
+		r := cassette.New(t, "project")
+		client := r.AtlasClient()
+		org := r.Env("MONGODB_ATLAS_ORG_ID", replayOrgID)
+
+		created, _, err := client.ProjectsApi.CreateProject(context.Background(), &admin.Group{Name: "project", OrgId: org}).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, replayOrgID, created.GetOrgId())
+		assert.NotEqual(t, projectID, created.GetId())
+		read, _, err := client.ProjectsApi.GetProject(context.Background(), created.GetId()).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, created.GetId(), read.GetId())
+	})
+
+	rt := &errorsT{}
+	t.Run("replay unmatched", func(t *testing.T) {
+		rt.T = t
+		t.Setenv(cassette.ModeEnv, "")
+		r := cassette.New(rt, "project")
+		_, _, err := r.AtlasClient().ProjectsApi.GetProject(context.Background(), projectID).Execute()
+		require.Error(t, err)
+	})
+	require.Len(t, rt.errors, 3)
+	assert.Contains(t, rt.errors[0], "no interaction for GET /api/atlas/v2/groups/"+projectID)
+	assert.Contains(t, rt.errors[1], "interaction 0 not replayed: POST /api/atlas/v2/groups")
+	assert.Contains(t, rt.errors[2], "interaction 1 not replayed: GET /api/atlas/v2/groups/")
+}
+
+func TestReplayFailsMissingCassette(t *testing.T) {
+	chdir(t, t.TempDir())
+	t.Setenv(cassette.ModeEnv, "")
+	rt := &errorsT{}
+	t.Run("replay", func(t *testing.T) {
+		rt.T = t
+		cassette.New(rt, "missing")
+		t.Error("the test must stop")
+	})
+	require.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0], "cassette testdata/cassettes/missing.json is not recorded")
+}
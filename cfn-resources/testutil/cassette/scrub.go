@@ -0,0 +1,141 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cassette
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// redacted replaces the values of the sensitive fields.
+const redacted = "REDACTED"
+
+// sensitiveFields are the JSON fields holding credentials or personal data in the Atlas requests and responses,
+// in lower case.
+var sensitiveFields = map[string]bool{
+	"emailaddress":  true,
+	"firstname":     true,
+	"lastname":      true,
+	"mobilenumber":  true,
+	"username":      true,
+	"usernames":     true,
+	"password":      true,
+	"privatekey":    true,
+	"secret":        true,
+	"clientsecret":  true,
+	"access_token":  true,
+	"accesstoken":   true,
+	"refreshtoken":  true,
+	"refresh_token": true,
+}
+
+// objectID matches the IDs of the Atlas objects.
+var objectID = regexp.MustCompile(`\b[0-9a-f]{24}\b`)
+
+// scrubber replaces the recorded values, each value always by the same replacement.
+type scrubber struct {
+	replacements map[string]string
+	// fakes are the replacements, they're not replaced again.
+	fakes map[string]bool
+}
+
+func newScrubber() *scrubber {
+	return &scrubber{replacements: map[string]string{}, fakes: map[string]bool{}}
+}
+
+// replace registers the replacement of a value, e.g. the ID of the organization.
+func (s *scrubber) replace(value, replacement string) {
+	s.replacements[value] = replacement
+	s.fakes[replacement] = true
+}
+
+// scrub replaces the registered values and the Atlas IDs, the IDs not registered get a fake ID in order of
+// appearance.
+func (s *scrubber) scrub(text string) string {
+	// the longest values first, in case a value contains another one
+	values := slices.SortedFunc(maps.Keys(s.replacements), func(a, b string) int { return cmp.Or(len(b)-len(a), strings.Compare(a, b)) })
+	for _, value := range values {
+		if !objectID.MatchString(value) {
+			text = strings.ReplaceAll(text, value, s.replacements[value])
+		}
+	}
+	return objectID.ReplaceAllStringFunc(text, func(id string) string {
+		if s.fakes[id] {
+			return id
+		}
+		if replacement, ok := s.replacements[id]; ok {
+			return replacement
+		}
+		fake := fmt.Sprintf("ca55e77e%016x", len(s.replacements)+1)
+		s.replace(id, fake)
+		return fake
+	})
+}
+
+// redact replaces the values of the sensitive fields of a JSON body, other bodies are returned as is. The JSON is
+// re-encoded so that the recorded and the replayed bodies have the same format.
+func (s *scrubber) redact(body string) string {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil || decoder.More() {
+		return body
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(v)); err != nil {
+		return body
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, value := range v {
+			if sensitiveFields[strings.ToLower(k)] {
+				v[k] = redactSensitive(value)
+			} else {
+				v[k] = redactValue(value)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+	return v
+}
+
+// redactSensitive replaces the value of a sensitive field, or each string of a list like the user names of a team.
+func redactSensitive(v any) any {
+	switch v := v.(type) {
+	case string:
+		return redacted
+	case []any:
+		for i := range v {
+			v[i] = redactSensitive(v[i])
+		}
+		return v
+	}
+	return redactValue(v)
+}
@@ -0,0 +1,466 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// maxCallbacks stops the handlers never leaving IN_PROGRESS.
+const maxCallbacks = 100
+
+// schema is the part of the resource schema used by the contract tests.
+type schema struct {
+	PrimaryIdentifier   []string `json:"primaryIdentifier"`
+	ReadOnlyProperties  []string `json:"readOnlyProperties"`
+	WriteOnlyProperties []string `json:"writeOnlyProperties"`
+}
+
+// contractRun is the state of a TestCase while its steps run: the model of the resource as last read.
+type contractRun struct {
+	c      *TestCase
+	schema schema
+	model  map[string]any
+}
+
+// Test runs the steps of the test case against its handler with the contract semantics of 'cfn test':
+//   - every operation is called again with its callback context until it returns SUCCESS or FAILED.
+//   - Create and Update must succeed and return the primary identifier, then a Read of the returned model must
+//     return the properties of the input model, except the read-only and write-only ones.
+//   - every Read is done again with only the primary identifier of the model, like the Read of a resource import
+//     or of a drift detection, and must return the same properties, except the write-only ones.
+//   - Delete must succeed and a Read of the deleted model must fail with NotFound.
+//   - List must succeed and contain the primary identifier of the resource.
+//
+// The violations of a step are reported with the name of the 'cfn test' contract they break, then the
+// remaining steps are skipped because the state of the resource is unknown.
+func Test(t TestT, c TestCase) {
+	r := &contractRun{c: &c}
+	if c.Schema != "" {
+		data, err := os.ReadFile(c.Schema)
+		if err != nil {
+			t.Fatal(fmt.Sprintf("%s: reading the resource schema: %v", c.Name, err))
+			return
+		}
+		if err := json.Unmarshal(data, &r.schema); err != nil {
+			t.Fatal(fmt.Sprintf("%s: parsing the resource schema: %v", c.Name, err))
+			return
+		}
+	}
+	for i, step := range c.Steps {
+		checked, violations := r.runStep(step)
+		if len(violations) == 0 && step.Check != nil {
+			if err := step.Check(checked); err != nil {
+				violations = append(violations, fmt.Sprintf("check: %v", err))
+			}
+		}
+		if len(violations) > 0 {
+			for _, v := range violations {
+				t.Error(fmt.Sprintf("%s: step %d (%s): %s", c.Name, i+1, step.Operation, v))
+			}
+			t.Fatal(fmt.Sprintf("%s: step %d (%s) failed, skipping the remaining steps", c.Name, i+1, step.Operation))
+			return
+		}
+	}
+}
+
+// runStep returns the model passed to the Check of the step and the contract violations of the step.
+func (r *contractRun) runStep(step TestStep) (any, []string) {
+	var config map[string]any
+	if step.Config != "" {
+		if err := json.Unmarshal([]byte(step.Config), &config); err != nil {
+			return nil, []string{fmt.Sprintf("invalid Config: %v", err)}
+		}
+	}
+	switch step.Operation {
+	case OperationCreate:
+		return r.create(config)
+	case OperationRead:
+		if config != nil {
+			// the resource exists beforehand, e.g. in the responses stubbed by the test, and the Config is its state
+			return r.readEqual("contract_read", config, config)
+		}
+		return r.read("contract_read", r.model)
+	case OperationUpdate:
+		return r.update(config)
+	case OperationDelete:
+		return r.delete()
+	case OperationList:
+		return r.list(config)
+	default:
+		return nil, []string{fmt.Sprintf("unknown operation %d", step.Operation)}
+	}
+}
+
+func (r *contractRun) create(config map[string]any) (any, []string) {
+	if config == nil {
+		return nil, []string{"contract_create: Config is required"}
+	}
+	event, model, violations := r.invoke("contract_create", r.c.TestHandler.Create, nil, config)
+	if violations != nil {
+		return nil, violations
+	}
+	if violations = r.expectSuccess("contract_create", event, model); violations != nil {
+		return nil, violations
+	}
+	for _, p := range r.schema.PrimaryIdentifier {
+		if _, ok := lookup(model, p); !ok {
+			violations = append(violations, fmt.Sprintf("contract_create: primary identifier %s missing from the returned model", p))
+		}
+	}
+	if violations != nil {
+		return nil, violations
+	}
+	return r.readEqual("contract_create_read", config, model)
+}
+
+func (r *contractRun) update(config map[string]any) (any, []string) {
+	if config == nil {
+		return nil, []string{"contract_update: Config is required"}
+	}
+	if r.model == nil {
+		return nil, []string{"contract_update: no resource, the test case must create it first"}
+	}
+	// like 'cfn test', the desired state has the identifiers and the read-only properties of the current state
+	for _, p := range slices.Concat(r.schema.PrimaryIdentifier, r.schema.ReadOnlyProperties) {
+		if v, ok := lookup(r.model, p); ok {
+			if _, set := lookup(config, p); !set {
+				store(config, p, v)
+			}
+		}
+	}
+	event, model, violations := r.invoke("contract_update", r.c.TestHandler.Update, r.model, config)
+	if violations != nil {
+		return nil, violations
+	}
+	if violations = r.expectSuccess("contract_update", event, model); violations != nil {
+		return nil, violations
+	}
+	return r.readEqual("contract_update_read", config, model)
+}
+
+func (r *contractRun) delete() (any, []string) {
+	if r.model == nil {
+		return nil, []string{"contract_delete: no resource, the test case must create it first"}
+	}
+	deleted := r.model
+	event, model, violations := r.invoke("contract_delete", r.c.TestHandler.Delete, nil, deleted)
+	if violations != nil {
+		return nil, violations
+	}
+	if event.OperationStatus != handler.Success {
+		return nil, []string{unexpectedStatus("contract_delete", handler.Success, event)}
+	}
+	r.model = nil
+	event, _, violations = r.invoke("contract_delete_read", r.c.TestHandler.Read, nil, deleted)
+	if violations != nil {
+		return nil, violations
+	}
+	if event.OperationStatus != handler.Failed || event.HandlerErrorCode != cloudformation.HandlerErrorCodeNotFound {
+		return nil, []string{fmt.Sprintf("contract_delete_read: expected FAILED with %s, got %s with %q: %s",
+			cloudformation.HandlerErrorCodeNotFound, event.OperationStatus, event.HandlerErrorCode, event.Message)}
+	}
+	return model, nil
+}
+
+func (r *contractRun) list(config map[string]any) (any, []string) {
+	if config == nil {
+		config = r.model
+	}
+	event, _, violations := r.invoke("contract_list", r.c.TestHandler.List, nil, config)
+	if violations != nil {
+		return nil, violations
+	}
+	if event.OperationStatus != handler.Success {
+		return nil, []string{unexpectedStatus("contract_list", handler.Success, event)}
+	}
+	if r.model == nil || len(r.schema.PrimaryIdentifier) == 0 {
+		return event.ResourceModels, nil
+	}
+	for _, m := range event.ResourceModels {
+		listed, err := toJSONObject(m)
+		if err != nil {
+			return nil, []string{fmt.Sprintf("contract_list: %v", err)}
+		}
+		if r.samePrimaryIdentifier(listed) {
+			return event.ResourceModels, nil
+		}
+	}
+	return nil, []string{fmt.Sprintf("contract_list: primary identifier %s not in the %d listed models",
+		r.primaryIdentifier(r.model), len(event.ResourceModels))}
+}
+
+// read reads the model and makes it the current state.
+func (r *contractRun) read(contract string, model map[string]any) (any, []string) {
+	if model == nil {
+		return nil, []string{contract + ": no resource, the test case must create it first"}
+	}
+	event, read, violations := r.invoke(contract, r.c.TestHandler.Read, nil, model)
+	if violations != nil {
+		return nil, violations
+	}
+	if violations = r.expectSuccess(contract, event, read); violations != nil {
+		return nil, violations
+	}
+	r.model = read
+	if violations = r.readIdentifier(contract + "_identifier"); violations != nil {
+		return nil, violations
+	}
+	return event.ResourceModel, nil
+}
+
+// readIdentifier reads the current state with only its primary identifier and compares the read model with the
+// current state, the write-only properties aside.
+func (r *contractRun) readIdentifier(contract string) []string {
+	if len(r.schema.PrimaryIdentifier) == 0 {
+		return nil
+	}
+	identifier := map[string]any{}
+	for _, p := range r.schema.PrimaryIdentifier {
+		if v, ok := lookup(r.model, p); ok {
+			store(identifier, p, v)
+		}
+	}
+	event, read, violations := r.invoke(contract, r.c.TestHandler.Read, nil, identifier)
+	if violations != nil {
+		return violations
+	}
+	if violations = r.expectSuccess(contract, event, read); violations != nil {
+		return violations
+	}
+	expected := clone(r.model)
+	for _, p := range r.schema.WriteOnlyProperties {
+		remove(expected, p)
+	}
+	for _, diff := range differences("", expected, read) {
+		violations = append(violations, fmt.Sprintf("%s: %s", contract, diff))
+	}
+	return violations
+}
+
+// readEqual reads the model returned by a Create or Update and compares the read model with the input like
+// 'cfn test' does: the read-only properties are set by the handler and the write-only ones are not returned.
+func (r *contractRun) readEqual(contract string, input, model map[string]any) (any, []string) {
+	checked, violations := r.read(contract, model)
+	if violations != nil {
+		return nil, violations
+	}
+	expected := clone(input)
+	for _, p := range slices.Concat(r.schema.ReadOnlyProperties, r.schema.WriteOnlyProperties) {
+		remove(expected, p)
+	}
+	for _, diff := range differences("", expected, r.model) {
+		violations = append(violations, fmt.Sprintf("%s: %s", contract, diff))
+	}
+	return checked, violations
+}
+
+// invoke calls the operation until it's no longer IN_PROGRESS, like CloudFormation does with the callback context
+// and resource model of the event.
+func (r *contractRun) invoke(contract string, op Operation, prevModel, model map[string]any) (handler.ProgressEvent, map[string]any, []string) {
+	var prevBody []byte
+	if prevModel != nil {
+		var err error
+		if prevBody, err = json.Marshal(prevModel); err != nil {
+			return handler.ProgressEvent{}, nil, []string{fmt.Sprintf("%s: %v", contract, err)}
+		}
+	}
+	var callbackContext map[string]any
+	for range maxCallbacks {
+		body, err := json.Marshal(model)
+		if err != nil {
+			return handler.ProgressEvent{}, nil, []string{fmt.Sprintf("%s: %v", contract, err)}
+		}
+		event := op(handler.NewRequest(r.c.Name, callbackContext, r.c.RequestContext, r.c.Session, prevBody, body, nil))
+		returned, err := toJSONObject(event.ResourceModel)
+		if err != nil {
+			return event, nil, []string{fmt.Sprintf("%s: %v", contract, err)}
+		}
+		switch event.OperationStatus {
+		case handler.Success, handler.Failed:
+			return event, returned, nil
+		case handler.InProgress:
+		default:
+			return event, nil, []string{fmt.Sprintf("%s: invalid status %q", contract, event.OperationStatus)}
+		}
+		if callbackContext, err = toJSONObject(event.CallbackContext); err != nil {
+			return event, nil, []string{fmt.Sprintf("%s: callback context: %v", contract, err)}
+		}
+		if returned != nil {
+			model = returned
+		}
+	}
+	return handler.ProgressEvent{}, nil, []string{fmt.Sprintf("%s: still IN_PROGRESS after %d callbacks", contract, maxCallbacks)}
+}
+
+// expectSuccess returns the violations of an event which must be successful and return the resource model.
+func (r *contractRun) expectSuccess(contract string, event handler.ProgressEvent, model map[string]any) []string {
+	if event.OperationStatus != handler.Success {
+		return []string{unexpectedStatus(contract, handler.Success, event)}
+	}
+	if model == nil {
+		return []string{contract + ": SUCCESS without resource model"}
+	}
+	return nil
+}
+
+func (r *contractRun) samePrimaryIdentifier(model map[string]any) bool {
+	for _, p := range r.schema.PrimaryIdentifier {
+		want, _ := lookup(r.model, p)
+		got, _ := lookup(model, p)
+		if !reflect.DeepEqual(want, got) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *contractRun) primaryIdentifier(model map[string]any) string {
+	values := make([]string, 0, len(r.schema.PrimaryIdentifier))
+	for _, p := range r.schema.PrimaryIdentifier {
+		v, _ := lookup(model, p)
+		values = append(values, fmt.Sprintf("%s=%v", p, v))
+	}
+	return strings.Join(values, ",")
+}
+
+func unexpectedStatus(contract string, want handler.Status, event handler.ProgressEvent) string {
+	return fmt.Sprintf("%s: expected %s, got %s with %q: %s", contract, want, event.OperationStatus, event.HandlerErrorCode, event.Message)
+}
+
+// differences returns the properties of expected missing or different in actual. Objects are compared property
+// by property and arrays of the same length item by item, e.g. the read items may have the IDs given by Atlas,
+// other values must be equal.
+func differences(path string, expected, actual any) []string {
+	if expectedArray, ok := expected.([]any); ok {
+		if actualArray, ok := actual.([]any); ok && len(actualArray) == len(expectedArray) {
+			var diffs []string
+			for i := range expectedArray {
+				diffs = append(diffs, differences(fmt.Sprintf("%s/%d", path, i), expectedArray[i], actualArray[i])...)
+			}
+			return diffs
+		}
+	}
+	expectedObject, ok := expected.(map[string]any)
+	if !ok {
+		if reflect.DeepEqual(expected, actual) {
+			return nil
+		}
+		return []string{fmt.Sprintf("%s: expected %s, read %s", path, toJSON(expected), toJSON(actual))}
+	}
+	actualObject, ok := actual.(map[string]any)
+	if !ok {
+		return []string{fmt.Sprintf("%s: expected %s, read %s", path, toJSON(expected), toJSON(actual))}
+	}
+	var diffs []string
+	for _, k := range slices.Sorted(maps.Keys(expectedObject)) {
+		p := path + "/" + k
+		if path == "" {
+			p = "/properties/" + k
+		}
+		if _, ok := actualObject[k]; !ok {
+			diffs = append(diffs, fmt.Sprintf("%s: expected %s, missing from the read model", p, toJSON(expectedObject[k])))
+			continue
+		}
+		diffs = append(diffs, differences(p, expectedObject[k], actualObject[k])...)
+	}
+	return diffs
+}
+
+// lookup returns the value of a schema property path like /properties/Name/Key.
+func lookup(model map[string]any, path string) (any, bool) {
+	var v any = model
+	for _, k := range propertyPath(path) {
+		object, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if v, ok = object[k]; !ok {
+			return nil, false
+		}
+	}
+	return v, v != nil
+}
+
+// store sets the value of a schema property path, creating the intermediate objects.
+func store(model map[string]any, path string, v any) {
+	keys := propertyPath(path)
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := model[k].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			model[k] = next
+		}
+		model = next
+	}
+	model[keys[len(keys)-1]] = v
+}
+
+// remove deletes a schema property path from the model.
+func remove(model map[string]any, path string) {
+	keys := propertyPath(path)
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := model[k].(map[string]any)
+		if !ok {
+			return
+		}
+		model = next
+	}
+	delete(model, keys[len(keys)-1])
+}
+
+func propertyPath(path string) []string {
+	return strings.Split(strings.TrimPrefix(path, "/properties/"), "/")
+}
+
+// toJSONObject returns v as decoded from its JSON, the way CloudFormation passes models and callback contexts.
+func toJSONObject(v any) (map[string]any, error) {
+	if v == nil || reflect.ValueOf(v).Kind() == reflect.Pointer && reflect.ValueOf(v).IsNil() {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling %T: %w", v, err)
+	}
+	var object map[string]any
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("unmarshaling %T: %w", v, err)
+	}
+	return object, nil
+}
+
+func clone(model map[string]any) map[string]any {
+	data, _ := json.Marshal(model)
+	var cloned map[string]any
+	_ = json.Unmarshal(data, &cloned)
+	return cloned
+}
+
+func toJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
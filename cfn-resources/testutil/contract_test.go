@@ -0,0 +1,252 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const schema = `{
+  "primaryIdentifier": ["/properties/Name"],
+  "readOnlyProperties": ["/properties/Status"],
+  "writeOnlyProperties": ["/properties/Password"]
+}`
+
+type model struct {
+	Name     *string `json:",omitempty"`
+	Value    *string `json:",omitempty"`
+	Password *string `json:",omitempty"`
+	Status   *string `json:",omitempty"`
+}
+
+// store is a TestHandler keeping the models in memory, Create returns IN_PROGRESS once.
+type store struct {
+	models map[string]model
+	// readValue overrides the Value returned by Read.
+	readValue *string
+	// deleteKeeps doesn't remove the model on Delete.
+	deleteKeeps bool
+	// listEmpty makes List return no model.
+	listEmpty bool
+	// readEchoes makes Read return the Value of the request instead of the stored one.
+	readEchoes bool
+}
+
+func newStore() *store {
+	return &store{models: map[string]model{}}
+}
+
+func (s *store) Create(req handler.Request) handler.ProgressEvent {
+	var m model
+	if err := req.Unmarshal(&m); err != nil {
+		return handler.NewFailedEvent(err)
+	}
+	if req.CallbackContext["created"] == nil {
+		return handler.ProgressEvent{OperationStatus: handler.InProgress, ResourceModel: &m, CallbackContext: map[string]any{"created": true}}
+	}
+	m.Status = ptr("ACTIVE")
+	s.models[*m.Name] = m
+	return handler.ProgressEvent{OperationStatus: handler.Success, ResourceModel: &m}
+}
+
+func (s *store) Read(req handler.Request) handler.ProgressEvent {
+	var m model
+	if err := req.Unmarshal(&m); err != nil {
+		return handler.NewFailedEvent(err)
+	}
+	stored, ok := s.models[*m.Name]
+	if !ok {
+		return handler.ProgressEvent{OperationStatus: handler.Failed, HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}
+	}
+	stored.Password = nil
+	if s.readValue != nil {
+		stored.Value = s.readValue
+	}
+	if s.readEchoes {
+		stored.Value = m.Value
+	}
+	return handler.ProgressEvent{OperationStatus: handler.Success, ResourceModel: &stored}
+}
+
+func (s *store) Update(req handler.Request) handler.ProgressEvent {
+	var prev, m model
+	if err := req.UnmarshalPrevious(&prev); err != nil {
+		return handler.NewFailedEvent(err)
+	}
+	if err := req.Unmarshal(&m); err != nil {
+		return handler.NewFailedEvent(err)
+	}
+	if *prev.Name != *m.Name {
+		return handler.NewFailedEvent(errors.New("the name can't change"))
+	}
+	s.models[*m.Name] = m
+	return handler.ProgressEvent{OperationStatus: handler.Success, ResourceModel: &m}
+}
+
+func (s *store) Delete(req handler.Request) handler.ProgressEvent {
+	var m model
+	if err := req.Unmarshal(&m); err != nil {
+		return handler.NewFailedEvent(err)
+	}
+	if !s.deleteKeeps {
+		delete(s.models, *m.Name)
+	}
+	return handler.ProgressEvent{OperationStatus: handler.Success}
+}
+
+func (s *store) List(req handler.Request) handler.ProgressEvent {
+	models := []any{}
+	if !s.listEmpty {
+		for _, m := range s.models {
+			models = append(models, &m)
+		}
+	}
+	return handler.ProgressEvent{OperationStatus: handler.Success, ResourceModels: models}
+}
+
+// recorder is a TestT recording the reported errors.
+type recorder struct {
+	errors []string
+	fatal  string
+}
+
+func (r *recorder) Error(args ...any) { r.errors = append(r.errors, fmt.Sprint(args...)) }
+func (r *recorder) Fatal(args ...any) { r.fatal = fmt.Sprint(args...) }
+func (r *recorder) Skip(...any)       {}
+func (r *recorder) Name() string      { return "recorder" }
+func (r *recorder) Parallel()         {}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func writeSchema(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "schema.json")
+	require.NoError(t, os.WriteFile(path, []byte(schema), 0o600))
+	return path
+}
+
+func lifecycle(t *testing.T, h testutil.TestHandler, checked *[]any) testutil.TestCase {
+	t.Helper()
+	check := func(m any) error {
+		*checked = append(*checked, m)
+		return nil
+	}
+	return testutil.TestCase{
+		Name:        "store",
+		Schema:      writeSchema(t),
+		TestHandler: h,
+		Steps: []testutil.TestStep{
+			{Operation: testutil.OperationCreate, Config: `{"Name": "a", "Value": "1", "Password": "secret"}`, Check: check},
+			{Operation: testutil.OperationUpdate, Config: `{"Name": "a", "Value": "2"}`, Check: check},
+			{Operation: testutil.OperationRead, Check: check},
+			{Operation: testutil.OperationList, Check: check},
+			{Operation: testutil.OperationDelete, Check: check},
+		},
+	}
+}
+
+func TestContract(t *testing.T) {
+	var checked []any
+	r := &recorder{}
+	testutil.Test(r, lifecycle(t, newStore(), &checked))
+	assert.Empty(t, r.errors)
+	assert.Empty(t, r.fatal)
+	require.Len(t, checked, 5)
+	assert.Equal(t, &model{Name: ptr("a"), Value: ptr("1"), Status: ptr("ACTIVE")}, checked[0], "the model read after create")
+	assert.Equal(t, &model{Name: ptr("a"), Value: ptr("2"), Status: ptr("ACTIVE")}, checked[1], "read-only properties are kept on update")
+	assert.Len(t, checked[3], 1)
+}
+
+func TestContractViolations(t *testing.T) {
+	testCases := map[string]struct {
+		store     *store
+		violation string
+	}{
+		"create read": {
+			store:     &store{models: map[string]model{}, readValue: ptr("other")},
+			violation: `store: step 1 (CREATE): contract_create_read: /properties/Value: expected "1", read "other"`,
+		},
+		"read from identifier": {
+			store:     &store{models: map[string]model{}, readEchoes: true},
+			violation: `store: step 1 (CREATE): contract_create_read_identifier: /properties/Value: expected "1", missing from the read model`,
+		},
+		"list": {
+			store:     &store{models: map[string]model{}, listEmpty: true},
+			violation: "store: step 4 (LIST): contract_list: primary identifier /properties/Name=a not in the 0 listed models",
+		},
+		"delete read": {
+			store:     &store{models: map[string]model{}, deleteKeeps: true},
+			violation: `store: step 5 (DELETE): contract_delete_read: expected FAILED with NotFound, got SUCCESS with "": `,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var checked []any
+			r := &recorder{}
+			testutil.Test(r, lifecycle(t, tc.store, &checked))
+			assert.Equal(t, []string{tc.violation}, r.errors)
+			assert.Contains(t, r.fatal, "failed, skipping the remaining steps")
+		})
+	}
+}
+
+func TestContractCheck(t *testing.T) {
+	r := &recorder{}
+	testutil.Test(r, testutil.TestCase{
+		Name:        "store",
+		Schema:      writeSchema(t),
+		TestHandler: newStore(),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationCreate,
+			Config:    `{"Name": "a"}`,
+			Check:     func(any) error { return errors.New("unexpected") },
+		}},
+	})
+	assert.Equal(t, []string{"store: step 1 (CREATE): check: unexpected"}, r.errors)
+}
+
+func TestContractReadExisting(t *testing.T) {
+	s := newStore()
+	s.models["a"] = model{Name: ptr("a"), Value: ptr("1"), Status: ptr("ACTIVE")}
+	var checked []any
+	r := &recorder{}
+	testutil.Test(r, testutil.TestCase{
+		Name:        "store",
+		Schema:      writeSchema(t),
+		TestHandler: s,
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config:    `{"Name": "a", "Value": "1"}`,
+			Check: func(m any) error {
+				checked = append(checked, m)
+				return nil
+			},
+		}},
+	})
+	assert.Empty(t, r.errors)
+	assert.Equal(t, []any{&model{Name: ptr("a"), Value: ptr("1"), Status: ptr("ACTIVE")}}, checked)
+}
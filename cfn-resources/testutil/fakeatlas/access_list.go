@@ -0,0 +1,107 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+func (s *Server) registerAccessList() {
+	s.mux.HandleFunc("GET "+api+"/groups/{groupId}/accessList", s.withProject(s.listAccessList))
+	s.mux.HandleFunc("POST "+api+"/groups/{groupId}/accessList", s.withProject(s.addAccessListEntries))
+	s.mux.HandleFunc("GET "+api+"/groups/{groupId}/accessList/{entryValue}", s.withProject(s.getAccessListEntry))
+	s.mux.HandleFunc("DELETE "+api+"/groups/{groupId}/accessList/{entryValue}", s.withProject(s.deleteAccessListEntry))
+}
+
+// accessListKey returns the key of the entry in the access list: its CIDR block, an IP address being the /32 block,
+// or its security group.
+func accessListKey(entry admin.NetworkPermissionEntry) string {
+	switch {
+	case entry.GetCidrBlock() != "":
+		return entry.GetCidrBlock()
+	case entry.GetIpAddress() != "":
+		return entry.GetIpAddress() + "/32"
+	default:
+		return entry.GetAwsSecurityGroup()
+	}
+}
+
+// accessListEntry returns the key of the entry of the path, which can be an IP address, a CIDR block or a security
+// group.
+func accessListEntry(r *http.Request, p *project) (string, bool) {
+	value := r.PathValue("entryValue")
+	for _, key := range []string{value, value + "/32"} {
+		if _, ok := p.accessList[key]; ok {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func writeAccessListEntryNotFound(w http.ResponseWriter, r *http.Request, p *project) {
+	writeError(w, http.StatusNotFound, "ATLAS_NETWORK_PERMISSION_ENTRY_NOT_FOUND",
+		fmt.Sprintf("IP Address %s not on Atlas access list for group %s.", r.PathValue("entryValue"), p.group.GetId()))
+}
+
+func (s *Server) listAccessList(w http.ResponseWriter, r *http.Request, p *project) {
+	results, totalCount := page(r, sortedValues(p.accessList))
+	writeJSON(w, http.StatusOK, admin.PaginatedNetworkAccess{Results: &results, TotalCount: totalCount})
+}
+
+// addAccessListEntries adds the entries of the request to the access list, the existing entries are updated.
+func (s *Server) addAccessListEntries(w http.ResponseWriter, r *http.Request, p *project) {
+	var entries []admin.NetworkPermissionEntry
+	if !decode(w, r, &entries) {
+		return
+	}
+	for _, entry := range entries {
+		if accessListKey(entry) == "" {
+			writeError(w, http.StatusBadRequest, "INVALID_ATTRIBUTE",
+				"An access list entry needs one of cidrBlock, ipAddress or awsSecurityGroup.")
+			return
+		}
+	}
+	for _, entry := range entries {
+		entry.GroupId = p.group.Id
+		if entry.GetIpAddress() != "" {
+			entry.CidrBlock = admin.PtrString(accessListKey(entry))
+		}
+		p.accessList[accessListKey(entry)] = entry
+	}
+	results := sortedValues(p.accessList)
+	writeJSON(w, http.StatusCreated, admin.PaginatedNetworkAccess{Results: &results, TotalCount: admin.PtrInt(len(results))})
+}
+
+func (s *Server) getAccessListEntry(w http.ResponseWriter, r *http.Request, p *project) {
+	key, ok := accessListEntry(r, p)
+	if !ok {
+		writeAccessListEntryNotFound(w, r, p)
+		return
+	}
+	writeJSON(w, http.StatusOK, p.accessList[key])
+}
+
+func (s *Server) deleteAccessListEntry(w http.ResponseWriter, r *http.Request, p *project) {
+	key, ok := accessListEntry(r, p)
+	if !ok {
+		writeAccessListEntryNotFound(w, r, p)
+		return
+	}
+	delete(p.accessList, key)
+	writeJSON(w, http.StatusNoContent, nil)
+}
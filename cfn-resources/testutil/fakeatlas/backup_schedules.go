@@ -0,0 +1,94 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+// defaultBackupSchedule returns the schedule of a new cluster: a single policy with the hourly, daily, weekly and
+// monthly snapshots Atlas schedules by default.
+func (s *Server) defaultBackupSchedule(c *cluster) admin.DiskBackupSnapshotSchedule {
+	self := fmt.Sprintf("%s%s/groups/%s/clusters/%s/backup/schedule", s.URL, api, c.description.GetGroupId(), c.description.GetName())
+	items := []admin.DiskBackupApiPolicyItem{
+		{Id: admin.PtrString(s.newID()), FrequencyType: "hourly", FrequencyInterval: 6, RetentionUnit: "days", RetentionValue: 2},
+		{Id: admin.PtrString(s.newID()), FrequencyType: "daily", FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: 7},
+		{Id: admin.PtrString(s.newID()), FrequencyType: "weekly", FrequencyInterval: 6, RetentionUnit: "weeks", RetentionValue: 4},
+		{Id: admin.PtrString(s.newID()), FrequencyType: "monthly", FrequencyInterval: 40, RetentionUnit: "months", RetentionValue: 12},
+	}
+	nextSnapshot := now().Add(time.Hour)
+	return admin.DiskBackupSnapshotSchedule{
+		AutoExportEnabled:                 admin.PtrBool(false),
+		ClusterId:                         c.description.Id,
+		ClusterName:                       c.description.Name,
+		CopySettings:                      &[]admin.DiskBackupCopySetting{},
+		Links:                             &[]admin.Link{{Href: admin.PtrString(self), Rel: admin.PtrString("self")}},
+		NextSnapshot:                      &nextSnapshot,
+		Policies:                          &[]admin.AdvancedDiskBackupSnapshotSchedulePolicy{{Id: admin.PtrString(s.newID()), PolicyItems: &items}},
+		ReferenceHourOfDay:                admin.PtrInt(0),
+		ReferenceMinuteOfHour:             admin.PtrInt(0),
+		RestoreWindowDays:                 admin.PtrInt(7),
+		UseOrgAndGroupNamesInExportPrefix: admin.PtrBool(false),
+	}
+}
+
+func (s *Server) registerBackupSchedules() {
+	schedule := api + "/groups/{groupId}/clusters/{clusterName}/backup/schedule"
+	s.mux.HandleFunc("GET "+schedule, s.withCluster(s.getBackupSchedule))
+	s.mux.HandleFunc("PATCH "+schedule, s.withCluster(s.updateBackupSchedule))
+	s.mux.HandleFunc("DELETE "+schedule, s.withCluster(s.deleteBackupSchedules))
+}
+
+func (s *Server) getBackupSchedule(w http.ResponseWriter, _ *http.Request, _ *project, c *cluster) {
+	writeJSON(w, http.StatusOK, c.backupSchedule)
+}
+
+// updateBackupSchedule changes the fields of the request, the policy items without ID are new items.
+func (s *Server) updateBackupSchedule(w http.ResponseWriter, r *http.Request, _ *project, c *cluster) {
+	schedule := c.backupSchedule
+	policyID := schedule.GetPolicies()[0].Id
+	if !patch(w, r, &schedule) {
+		return
+	}
+	if len(schedule.GetPolicies()) != 1 {
+		writeError(w, http.StatusBadRequest, "INVALID_ATTRIBUTE", "A backup schedule has a single policy.")
+		return
+	}
+	policy := &schedule.GetPolicies()[0]
+	if policy.GetId() != *policyID {
+		writeError(w, http.StatusBadRequest, "INVALID_ATTRIBUTE", "The ID of the policy of the backup schedule is invalid.")
+		return
+	}
+	if policy.PolicyItems == nil {
+		policy.PolicyItems = &[]admin.DiskBackupApiPolicyItem{}
+	}
+	for i := range policy.GetPolicyItems() {
+		if item := &policy.GetPolicyItems()[i]; item.GetId() == "" {
+			item.Id = admin.PtrString(s.newID())
+		}
+	}
+	c.backupSchedule = schedule
+	writeJSON(w, http.StatusOK, c.backupSchedule)
+}
+
+// deleteBackupSchedules removes the items of the policy of the schedule, the policy itself is kept.
+func (s *Server) deleteBackupSchedules(w http.ResponseWriter, _ *http.Request, _ *project, c *cluster) {
+	(*c.backupSchedule.Policies)[0].PolicyItems = &[]admin.DiskBackupApiPolicyItem{}
+	writeJSON(w, http.StatusOK, c.backupSchedule)
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+const (
+	stateCreating = "CREATING"
+	stateUpdating = "UPDATING"
+	stateDeleting = "DELETING"
+	stateIdle     = "IDLE"
+)
+
+type cluster struct {
+	description    admin.AdvancedClusterDescription
+	processArgs    admin.ClusterDescriptionProcessArgs
+	backupSchedule admin.DiskBackupSnapshotSchedule
+	searchIndexes  map[string]*searchIndex
+	// searchDeployment is the search deployment of the cluster, nil if it has none.
+	searchDeployment *searchDeployment
+	// reads is the number of reads of the cluster in its current transitional state.
+	reads int
+}
+
+// AddCluster creates an IDLE replica set in the project and returns its ID, e.g. for the resources of a cluster.
+func (s *Server) AddCluster(projectID, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.projects[projectID]
+	if !ok {
+		panic(fmt.Sprintf("fakeatlas: no project %s", projectID))
+	}
+	c := s.addCluster(p, admin.AdvancedClusterDescription{Name: admin.PtrString(name)})
+	c.description.StateName = admin.PtrString(stateIdle)
+	return c.description.GetId()
+}
+
+func (s *Server) addCluster(p *project, description admin.AdvancedClusterDescription) *cluster {
+	description.Id = admin.PtrString(s.newID())
+	description.GroupId = p.group.Id
+	description.CreateDate = now()
+	description.StateName = admin.PtrString(stateCreating)
+	description.ConnectionStrings = &admin.ClusterConnectionStrings{
+		Standard:    admin.PtrString(fmt.Sprintf("mongodb://%s-shard-00-00.fake.mongodb.net:27017", description.GetName())),
+		StandardSrv: admin.PtrString(fmt.Sprintf("mongodb+srv://%s.fake.mongodb.net", description.GetName())),
+	}
+	if description.ClusterType == nil {
+		description.ClusterType = admin.PtrString("REPLICASET")
+	}
+	if description.MongoDBMajorVersion == nil {
+		description.MongoDBMajorVersion = admin.PtrString("7.0")
+	}
+	description.MongoDBVersion = admin.PtrString(description.GetMongoDBMajorVersion() + ".0")
+	if description.Paused == nil {
+		description.Paused = admin.PtrBool(false)
+	}
+	if description.BackupEnabled == nil {
+		description.BackupEnabled = admin.PtrBool(false)
+	}
+	if description.PitEnabled == nil {
+		description.PitEnabled = admin.PtrBool(false)
+	}
+	if description.TerminationProtectionEnabled == nil {
+		description.TerminationProtectionEnabled = admin.PtrBool(false)
+	}
+	s.setReplicationSpecIDs(&description)
+	c := &cluster{
+		description: description,
+		processArgs: admin.ClusterDescriptionProcessArgs{
+			JavascriptEnabled:         admin.PtrBool(true),
+			MinimumEnabledTlsProtocol: admin.PtrString("TLS1_2"),
+			NoTableScan:               admin.PtrBool(false),
+		},
+		searchIndexes: map[string]*searchIndex{},
+	}
+	c.backupSchedule = s.defaultBackupSchedule(c)
+	p.clusters[description.GetName()] = c
+	return c
+}
+
+// setReplicationSpecIDs gives an ID to the replication specs without one, like Atlas does for new zones.
+func (s *Server) setReplicationSpecIDs(description *admin.AdvancedClusterDescription) {
+	for i := range description.GetReplicationSpecs() {
+		spec := &(*description.ReplicationSpecs)[i]
+		if spec.GetId() == "" {
+			spec.Id = admin.PtrString(s.newID())
+		}
+		if spec.NumShards == nil {
+			spec.NumShards = admin.PtrInt(1)
+		}
+	}
+}
+
+func (s *Server) registerClusters() {
+	s.mux.HandleFunc("GET "+api+"/groups/{groupId}/clusters", s.withProject(s.listClusters))
+	s.mux.HandleFunc("POST "+api+"/groups/{groupId}/clusters", s.withProject(s.createCluster))
+	s.mux.HandleFunc("GET "+api+"/groups/{groupId}/clusters/{clusterName}", s.withCluster(s.getCluster))
+	s.mux.HandleFunc("PATCH "+api+"/groups/{groupId}/clusters/{clusterName}", s.withCluster(s.updateCluster))
+	s.mux.HandleFunc("DELETE "+api+"/groups/{groupId}/clusters/{clusterName}", s.withCluster(s.deleteCluster))
+	s.mux.HandleFunc("GET "+api+"/groups/{groupId}/clusters/{clusterName}/processArgs", s.withCluster(s.getProcessArgs))
+	s.mux.HandleFunc("PATCH "+api+"/groups/{groupId}/clusters/{clusterName}/processArgs", s.withCluster(s.updateProcessArgs))
+}
+
+// withCluster calls the handler with the cluster of the clusterName of the path, or answers CLUSTER_NOT_FOUND.
+func (s *Server) withCluster(h func(http.ResponseWriter, *http.Request, *project, *cluster)) http.HandlerFunc {
+	return s.withProject(func(w http.ResponseWriter, r *http.Request, p *project) {
+		c, ok := p.clusters[r.PathValue("clusterName")]
+		if !ok {
+			writeClusterNotFound(w, p, r.PathValue("clusterName"))
+			return
+		}
+		h(w, r, p, c)
+	})
+}
+
+func writeClusterNotFound(w http.ResponseWriter, p *project, name string) {
+	writeError(w, http.StatusNotFound, "CLUSTER_NOT_FOUND", fmt.Sprintf("No cluster named %s exists in group %s.", name, p.group.GetId()))
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request, p *project) {
+	descriptions := make([]admin.AdvancedClusterDescription, 0, len(p.clusters))
+	for _, c := range sortedValues(p.clusters) {
+		descriptions = append(descriptions, c.description)
+	}
+	results, totalCount := page(r, descriptions)
+	writeJSON(w, http.StatusOK, admin.PaginatedAdvancedClusterDescription{Results: &results, TotalCount: totalCount})
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request, p *project) {
+	var description admin.AdvancedClusterDescription
+	if !decode(w, r, &description) {
+		return
+	}
+	if description.GetName() == "" {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attribute name was not specified.")
+		return
+	}
+	if _, ok := p.clusters[description.GetName()]; ok {
+		writeError(w, http.StatusBadRequest, "DUPLICATE_CLUSTER_NAME",
+			fmt.Sprintf("Cluster %s already exists in group %s.", description.GetName(), p.group.GetId()))
+		return
+	}
+	writeJSON(w, http.StatusCreated, s.addCluster(p, description).description)
+}
+
+// getCluster moves a cluster in a transitional state to its next state when it has been read TransitionReads times:
+// IDLE, or deleted for a DELETING cluster.
+func (s *Server) getCluster(w http.ResponseWriter, _ *http.Request, p *project, c *cluster) {
+	if c.description.GetStateName() != stateIdle {
+		if c.reads++; s.settled(c.reads) {
+			c.reads = 0
+			if c.description.GetStateName() == stateDeleting {
+				delete(p.clusters, c.description.GetName())
+				writeClusterNotFound(w, p, c.description.GetName())
+				return
+			}
+			c.description.StateName = admin.PtrString(stateIdle)
+		}
+	}
+	writeJSON(w, http.StatusOK, c.description)
+}
+
+func (s *Server) updateCluster(w http.ResponseWriter, r *http.Request, p *project, c *cluster) {
+	if c.description.GetStateName() == stateDeleting {
+		writeError(w, http.StatusBadRequest, "CLUSTER_ALREADY_REQUESTED_DELETION",
+			fmt.Sprintf("Cluster %s has already been requested for deletion.", c.description.GetName()))
+		return
+	}
+	description := c.description
+	if !patch(w, r, &description) {
+		return
+	}
+	if description.GetName() != c.description.GetName() {
+		writeError(w, http.StatusBadRequest, "CANNOT_UPDATE_CLUSTER_NAME", "The cluster name can't be changed.")
+		return
+	}
+	s.setReplicationSpecIDs(&description)
+	description.StateName = admin.PtrString(stateUpdating)
+	c.description, c.reads = description, 0
+	writeJSON(w, http.StatusOK, c.description)
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, r *http.Request, p *project, c *cluster) {
+	if c.description.GetTerminationProtectionEnabled() {
+		writeError(w, http.StatusBadRequest, "CANNOT_TERMINATE_CLUSTER_WHEN_TERMINATION_PROTECTION_ENABLED",
+			fmt.Sprintf("Cluster %s can't be terminated while its termination protection is enabled.", c.description.GetName()))
+		return
+	}
+	c.description.StateName, c.reads = admin.PtrString(stateDeleting), 0
+	writeJSON(w, http.StatusAccepted, nil)
+}
+
+func (s *Server) getProcessArgs(w http.ResponseWriter, _ *http.Request, _ *project, c *cluster) {
+	writeJSON(w, http.StatusOK, c.processArgs)
+}
+
+func (s *Server) updateProcessArgs(w http.ResponseWriter, r *http.Request, _ *project, c *cluster) {
+	if !patch(w, r, &c.processArgs) {
+		return
+	}
+	writeJSON(w, http.StatusOK, c.processArgs)
+}
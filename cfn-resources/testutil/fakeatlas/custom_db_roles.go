@@ -0,0 +1,90 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+func (s *Server) registerCustomDBRoles() {
+	roles := api + "/groups/{groupId}/customDBRoles/roles"
+	s.mux.HandleFunc("GET "+roles, s.withProject(s.listCustomDBRoles))
+	s.mux.HandleFunc("POST "+roles, s.withProject(s.createCustomDBRole))
+	s.mux.HandleFunc("GET "+roles+"/{roleName}", s.withCustomDBRole(s.getCustomDBRole))
+	s.mux.HandleFunc("PATCH "+roles+"/{roleName}", s.withCustomDBRole(s.updateCustomDBRole))
+	s.mux.HandleFunc("DELETE "+roles+"/{roleName}", s.withCustomDBRole(s.deleteCustomDBRole))
+}
+
+// withCustomDBRole calls the handler with the role of the path, or answers ATLAS_CUSTOM_ROLE_NOT_FOUND.
+func (s *Server) withCustomDBRole(h func(http.ResponseWriter, *http.Request, *project, admin.UserCustomDBRole)) http.HandlerFunc {
+	return s.withProject(func(w http.ResponseWriter, r *http.Request, p *project) {
+		role, ok := p.customDBRoles[r.PathValue("roleName")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "ATLAS_CUSTOM_ROLE_NOT_FOUND",
+				fmt.Sprintf("The specified custom role %s does not exist.", r.PathValue("roleName")))
+			return
+		}
+		h(w, r, p, role)
+	})
+}
+
+// listCustomDBRoles answers the roles without pagination, like Atlas.
+func (s *Server) listCustomDBRoles(w http.ResponseWriter, _ *http.Request, p *project) {
+	writeJSON(w, http.StatusOK, sortedValues(p.customDBRoles))
+}
+
+func (s *Server) createCustomDBRole(w http.ResponseWriter, r *http.Request, p *project) {
+	var role admin.UserCustomDBRole
+	if !decode(w, r, &role) {
+		return
+	}
+	if role.RoleName == "" {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attribute roleName is missing.")
+		return
+	}
+	if _, ok := p.customDBRoles[role.RoleName]; ok {
+		writeError(w, http.StatusConflict, "DUPLICATE_DATABASE_ROLE", fmt.Sprintf("A role with name %s already exists.", role.RoleName))
+		return
+	}
+	p.customDBRoles[role.RoleName] = role
+	writeJSON(w, http.StatusOK, role)
+}
+
+func (s *Server) getCustomDBRole(w http.ResponseWriter, _ *http.Request, _ *project, role admin.UserCustomDBRole) {
+	writeJSON(w, http.StatusOK, role)
+}
+
+func (s *Server) updateCustomDBRole(w http.ResponseWriter, r *http.Request, p *project, role admin.UserCustomDBRole) {
+	var update admin.UpdateCustomDBRole
+	if !decode(w, r, &update) {
+		return
+	}
+	if update.Actions != nil {
+		role.Actions = update.Actions
+	}
+	if update.InheritedRoles != nil {
+		role.InheritedRoles = update.InheritedRoles
+	}
+	p.customDBRoles[role.RoleName] = role
+	writeJSON(w, http.StatusOK, role)
+}
+
+func (s *Server) deleteCustomDBRole(w http.ResponseWriter, _ *http.Request, p *project, role admin.UserCustomDBRole) {
+	delete(p.customDBRoles, role.RoleName)
+	writeJSON(w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+func (s *Server) registerDatabaseUsers() {
+	s.mux.HandleFunc("GET "+api+"/groups/{groupId}/databaseUsers", s.withProject(s.listDatabaseUsers))
+	s.mux.HandleFunc("POST "+api+"/groups/{groupId}/databaseUsers", s.withProject(s.createDatabaseUser))
+	s.mux.HandleFunc("GET "+api+"/groups/{groupId}/databaseUsers/{databaseName}/{username}", s.withDatabaseUser(s.getDatabaseUser))
+	s.mux.HandleFunc("PATCH "+api+"/groups/{groupId}/databaseUsers/{databaseName}/{username}", s.withDatabaseUser(s.updateDatabaseUser))
+	s.mux.HandleFunc("DELETE "+api+"/groups/{groupId}/databaseUsers/{databaseName}/{username}", s.withDatabaseUser(s.deleteDatabaseUser))
+}
+
+func databaseUserKey(databaseName, username string) string {
+	return databaseName + "/" + username
+}
+
+// withDatabaseUser calls the handler with the user of the path, or answers USERNAME_NOT_FOUND.
+func (s *Server) withDatabaseUser(h func(http.ResponseWriter, *http.Request, *project, admin.CloudDatabaseUser)) http.HandlerFunc {
+	return s.withProject(func(w http.ResponseWriter, r *http.Request, p *project) {
+		user, ok := p.databaseUsers[databaseUserKey(r.PathValue("databaseName"), r.PathValue("username"))]
+		if !ok {
+			writeError(w, http.StatusNotFound, "USERNAME_NOT_FOUND",
+				fmt.Sprintf("No user with username %s exists.", r.PathValue("username")))
+			return
+		}
+		h(w, r, p, user)
+	})
+}
+
+// withoutPassword returns the user as Atlas returns it, without its password.
+func withoutPassword(user admin.CloudDatabaseUser) admin.CloudDatabaseUser {
+	user.Password = nil
+	return user
+}
+
+func (s *Server) listDatabaseUsers(w http.ResponseWriter, r *http.Request, p *project) {
+	users := make([]admin.CloudDatabaseUser, 0, len(p.databaseUsers))
+	for _, user := range sortedValues(p.databaseUsers) {
+		users = append(users, withoutPassword(user))
+	}
+	results, totalCount := page(r, users)
+	writeJSON(w, http.StatusOK, admin.PaginatedApiAtlasDatabaseUser{Results: &results, TotalCount: totalCount})
+}
+
+func (s *Server) createDatabaseUser(w http.ResponseWriter, r *http.Request, p *project) {
+	var user admin.CloudDatabaseUser
+	if !decode(w, r, &user) {
+		return
+	}
+	if user.Username == "" || user.DatabaseName == "" {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attributes username and databaseName are missing.")
+		return
+	}
+	key := databaseUserKey(user.DatabaseName, user.Username)
+	if _, ok := p.databaseUsers[key]; ok {
+		writeError(w, http.StatusConflict, "USER_ALREADY_EXISTS", fmt.Sprintf("The specified user %s already exists.", user.Username))
+		return
+	}
+	user.GroupId = p.group.GetId()
+	p.databaseUsers[key] = user
+	writeJSON(w, http.StatusCreated, withoutPassword(user))
+}
+
+func (s *Server) getDatabaseUser(w http.ResponseWriter, _ *http.Request, _ *project, user admin.CloudDatabaseUser) {
+	writeJSON(w, http.StatusOK, withoutPassword(user))
+}
+
+func (s *Server) updateDatabaseUser(w http.ResponseWriter, r *http.Request, p *project, user admin.CloudDatabaseUser) {
+	key := databaseUserKey(user.DatabaseName, user.Username)
+	if !patch(w, r, &user) {
+		return
+	}
+	if databaseUserKey(user.DatabaseName, user.Username) != key {
+		writeError(w, http.StatusBadRequest, "INVALID_ATTRIBUTE", "The username and databaseName of a user can't be changed.")
+		return
+	}
+	p.databaseUsers[key] = user
+	writeJSON(w, http.StatusOK, withoutPassword(user))
+}
+
+func (s *Server) deleteDatabaseUser(w http.ResponseWriter, _ *http.Request, p *project, user admin.CloudDatabaseUser) {
+	delete(p.databaseUsers, databaseUserKey(user.DatabaseName, user.Username))
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+// DatabaseUserPassword returns the password of the user, which Atlas doesn't return, e.g. to check the password
+// generated by a handler.
+func (s *Server) DatabaseUserPassword(projectID, databaseName, username string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.projects[projectID]
+	if !ok {
+		return "", false
+	}
+	user, ok := p.databaseUsers[databaseUserKey(databaseName, username)]
+	return user.GetPassword(), ok
+}
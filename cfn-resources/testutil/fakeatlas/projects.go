@@ -0,0 +1,250 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+const api = "/api/atlas/v2"
+
+type project struct {
+	group         admin.Group
+	settings      admin.GroupSettings
+	teams         map[string]admin.TeamRole
+	apiKeys       map[string][]string
+	clusters      map[string]*cluster
+	databaseUsers map[string]admin.CloudDatabaseUser
+	customDBRoles map[string]admin.UserCustomDBRole
+	accessList    map[string]admin.NetworkPermissionEntry
+}
+
+// AddProject creates a project of the organization OrgID and returns its ID, e.g. for the resources of a project.
+func (s *Server) AddProject(name string) string {
+	return s.AddProjectInOrg(OrgID, name)
+}
+
+// AddProjectInOrg creates a project of another organization the API key has access to, and returns its ID.
+func (s *Server) AddProjectInOrg(orgID, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addProject(admin.Group{Name: name, OrgId: orgID}).group.GetId()
+}
+
+func (s *Server) addProject(group admin.Group) *project {
+	group.Id = admin.PtrString(s.newID())
+	group.Created = *now()
+	p := &project{
+		group: group,
+		settings: admin.GroupSettings{
+			IsCollectDatabaseSpecificsStatisticsEnabled: admin.PtrBool(true),
+			IsDataExplorerEnabled:                       admin.PtrBool(true),
+			IsExtendedStorageSizesEnabled:               admin.PtrBool(false),
+			IsPerformanceAdvisorEnabled:                 admin.PtrBool(true),
+			IsRealtimePerformancePanelEnabled:           admin.PtrBool(true),
+			IsSchemaAdvisorEnabled:                      admin.PtrBool(true),
+		},
+		teams:         map[string]admin.TeamRole{},
+		apiKeys:       map[string][]string{},
+		clusters:      map[string]*cluster{},
+		databaseUsers: map[string]admin.CloudDatabaseUser{},
+		customDBRoles: map[string]admin.UserCustomDBRole{},
+		accessList:    map[string]admin.NetworkPermissionEntry{},
+	}
+	s.projects[group.GetId()] = p
+	return p
+}
+
+func (s *Server) registerProjects() {
+	s.mux.HandleFunc("GET "+api+"/groups", s.listProjects)
+	s.mux.HandleFunc("POST "+api+"/groups", s.createProject)
+	s.mux.HandleFunc("GET "+api+"/groups/{groupId}", s.withProject(s.getProject))
+	s.mux.HandleFunc("PATCH "+api+"/groups/{groupId}", s.withProject(s.updateProject))
+	s.mux.HandleFunc("DELETE "+api+"/groups/{groupId}", s.withProject(s.deleteProject))
+	s.mux.HandleFunc("GET "+api+"/groups/{groupId}/settings", s.withProject(s.getProjectSettings))
+	s.mux.HandleFunc("PATCH "+api+"/groups/{groupId}/settings", s.withProject(s.updateProjectSettings))
+	s.mux.HandleFunc("GET "+api+"/groups/{groupId}/teams", s.withProject(s.listProjectTeams))
+	s.mux.HandleFunc("POST "+api+"/groups/{groupId}/teams", s.withProject(s.addProjectTeams))
+	s.mux.HandleFunc("PATCH "+api+"/groups/{groupId}/teams/{teamId}", s.withProject(s.updateProjectTeam))
+	s.mux.HandleFunc("DELETE "+api+"/groups/{groupId}/teams/{teamId}", s.withProject(s.removeProjectTeam))
+	s.mux.HandleFunc("PATCH "+api+"/groups/{groupId}/apiKeys/{apiUserId}", s.withProject(s.updateProjectAPIKey))
+	s.mux.HandleFunc("DELETE "+api+"/groups/{groupId}/apiKeys/{apiUserId}", s.withProject(s.removeProjectAPIKey))
+}
+
+// withProject calls the handler with the project of the groupId of the path, or answers GROUP_NOT_FOUND.
+func (s *Server) withProject(h func(http.ResponseWriter, *http.Request, *project)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		p, ok := s.projects[r.PathValue("groupId")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "GROUP_NOT_FOUND", fmt.Sprintf("No group with ID %s exists.", r.PathValue("groupId")))
+			return
+		}
+		h(w, r, p)
+	}
+}
+
+func (p *project) response() admin.Group {
+	group := p.group
+	group.ClusterCount = int64(len(p.clusters))
+	return group
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	projects := sortedValues(s.projects)
+	groups := make([]admin.Group, 0, len(projects))
+	for _, p := range projects {
+		groups = append(groups, p.response())
+	}
+	results, totalCount := page(r, groups)
+	writeJSON(w, http.StatusOK, admin.PaginatedAtlasGroup{Results: &results, TotalCount: totalCount})
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var group admin.Group
+	if !decode(w, r, &group) {
+		return
+	}
+	if group.Name == "" || group.OrgId == "" {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attributes name and orgId are missing.")
+		return
+	}
+	for _, p := range s.projects {
+		if p.group.OrgId == group.OrgId && p.group.Name == group.Name {
+			writeError(w, http.StatusConflict, "GROUP_ALREADY_EXISTS", fmt.Sprintf("A group with name %s already exists.", group.Name))
+			return
+		}
+	}
+	if group.Tags == nil {
+		group.Tags = &[]admin.ResourceTag{}
+	}
+	writeJSON(w, http.StatusOK, s.addProject(group).response())
+}
+
+func (s *Server) getProject(w http.ResponseWriter, _ *http.Request, p *project) {
+	writeJSON(w, http.StatusOK, p.response())
+}
+
+// getProjectByName answers the oldest project with the name when several organizations have one.
+func (s *Server) getProjectByName(w http.ResponseWriter, name string) {
+	for _, p := range sortedValues(s.projects) {
+		if p.group.Name == name {
+			writeJSON(w, http.StatusOK, p.response())
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "GROUP_NAME_NOT_FOUND", fmt.Sprintf("No group with name %s exists.", name))
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request, p *project) {
+	var update admin.GroupUpdate
+	if !decode(w, r, &update) {
+		return
+	}
+	if update.Name != nil {
+		p.group.Name = *update.Name
+	}
+	if update.Tags != nil {
+		p.group.Tags = update.Tags
+	}
+	writeJSON(w, http.StatusOK, p.response())
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, _ *http.Request, p *project) {
+	if len(p.clusters) > 0 {
+		writeError(w, http.StatusConflict, "CANNOT_CLOSE_GROUP_ACTIVE_ATLAS_CLUSTERS",
+			"We could not remove this organization or project because there are active Atlas clusters in it.")
+		return
+	}
+	delete(s.projects, p.group.GetId())
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (s *Server) getProjectSettings(w http.ResponseWriter, _ *http.Request, p *project) {
+	writeJSON(w, http.StatusOK, p.settings)
+}
+
+func (s *Server) updateProjectSettings(w http.ResponseWriter, r *http.Request, p *project) {
+	if !patch(w, r, &p.settings) {
+		return
+	}
+	writeJSON(w, http.StatusOK, p.settings)
+}
+
+func (s *Server) listProjectTeams(w http.ResponseWriter, r *http.Request, p *project) {
+	results, totalCount := page(r, sortedValues(p.teams))
+	writeJSON(w, http.StatusOK, admin.PaginatedTeamRole{Results: &results, TotalCount: totalCount})
+}
+
+func (s *Server) addProjectTeams(w http.ResponseWriter, r *http.Request, p *project) {
+	var teams []admin.TeamRole
+	if !decode(w, r, &teams) {
+		return
+	}
+	for _, team := range teams {
+		p.teams[team.GetTeamId()] = team
+	}
+	results := sortedValues(p.teams)
+	writeJSON(w, http.StatusOK, admin.PaginatedTeamRole{Results: &results, TotalCount: admin.PtrInt(len(results))})
+}
+
+func (s *Server) updateProjectTeam(w http.ResponseWriter, r *http.Request, p *project) {
+	team, ok := p.teams[r.PathValue("teamId")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "TEAM_NOT_FOUND", fmt.Sprintf("Team %s not found in the group.", r.PathValue("teamId")))
+		return
+	}
+	var update admin.TeamRole
+	if !decode(w, r, &update) {
+		return
+	}
+	team.RoleNames = update.RoleNames
+	p.teams[team.GetTeamId()] = team
+	results := []admin.TeamRole{team}
+	writeJSON(w, http.StatusOK, admin.PaginatedTeamRole{Results: &results, TotalCount: admin.PtrInt(1)})
+}
+
+func (s *Server) removeProjectTeam(w http.ResponseWriter, r *http.Request, p *project) {
+	if _, ok := p.teams[r.PathValue("teamId")]; !ok {
+		writeError(w, http.StatusNotFound, "TEAM_NOT_FOUND", fmt.Sprintf("Team %s not found in the group.", r.PathValue("teamId")))
+		return
+	}
+	delete(p.teams, r.PathValue("teamId"))
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (s *Server) updateProjectAPIKey(w http.ResponseWriter, r *http.Request, p *project) {
+	var update admin.UpdateAtlasProjectApiKey
+	if !decode(w, r, &update) {
+		return
+	}
+	p.apiKeys[r.PathValue("apiUserId")] = update.GetRoles()
+	roles := make([]admin.CloudAccessRoleAssignment, 0, len(update.GetRoles()))
+	for _, role := range update.GetRoles() {
+		roles = append(roles, admin.CloudAccessRoleAssignment{GroupId: p.group.Id, RoleName: admin.PtrString(role)})
+	}
+	writeJSON(w, http.StatusOK, admin.ApiKeyUserDetails{Id: admin.PtrString(r.PathValue("apiUserId")), Roles: &roles})
+}
+
+func (s *Server) removeProjectAPIKey(w http.ResponseWriter, r *http.Request, p *project) {
+	if _, ok := p.apiKeys[r.PathValue("apiUserId")]; !ok {
+		writeError(w, http.StatusNotFound, "API_KEY_NOT_FOUND", fmt.Sprintf("API key %s not found in the group.", r.PathValue("apiUserId")))
+		return
+	}
+	delete(p.apiKeys, r.PathValue("apiUserId"))
+	writeJSON(w, http.StatusNoContent, nil)
+}
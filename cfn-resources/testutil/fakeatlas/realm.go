@@ -0,0 +1,60 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"net/http"
+	"strings"
+
+	realmAuth "go.mongodb.org/realm/auth"
+)
+
+const (
+	realmAdminPath   = "/api/admin/v3.0/"
+	realmLoginPath   = realmAdminPath + "auth/providers/mongodb-cloud/login"
+	realmAccessToken = "fakeatlas-access-token"
+)
+
+// isRealm reports if the request is a request of the App Services Admin API, which the server serves next to the
+// Atlas Admin API: the logins of its API key get a token, the other endpoints answer the responses stubbed by the
+// tests, e.g. "GET /api/admin/v3.0/groups/{groupId}/apps/{appId}/triggers/{triggerId}".
+func isRealm(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, realmAdminPath)
+}
+
+func (s *Server) serveRealm(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == realmLoginPath && r.Method == http.MethodPost {
+		var login struct {
+			Username string `json:"username"`
+			APIKey   string `json:"apiKey"`
+		}
+		if !decode(w, r, &login) {
+			return
+		}
+		if login.Username != PublicKey || login.APIKey != PrivateKey {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid username/password"})
+			return
+		}
+		writeJSON(w, http.StatusOK, realmAuth.Token{AccessToken: realmAccessToken, UserID: PublicKey})
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer "+realmAccessToken {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid session"})
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mux.ServeHTTP(w, r)
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+)
+
+// Handler is a handler of a resource, e.g. resource.Create.
+type Handler[M any] func(req handler.Request, prevModel *M, currentModel *M) (handler.ProgressEvent, error)
+
+// Run calls the handler with the models like CloudFormation, with the account of the server, see testutil.Run.
+func Run[M any](t testing.TB, s *Server, h Handler[M], prevModel, currentModel *M) (handler.ProgressEvent, *M) {
+	t.Helper()
+	return testutil.Run(t, s.RequestContext(), false, testutil.HandlerFunc[M](h), prevModel, currentModel)
+}
+
+// RunWithTypeConfig is Run with the type configuration of the resource in the requests, e.g. {"AdoptIfExists": true}.
+func RunWithTypeConfig[M any](t testing.TB, s *Server, typeConfig string, h Handler[M], prevModel, currentModel *M) (handler.ProgressEvent, *M) {
+	t.Helper()
+	return testutil.RunWithTypeConfig(t, s.RequestContext(), false, json.RawMessage(typeConfig), testutil.HandlerFunc[M](h), prevModel, currentModel)
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+const deploymentUpdating = "UPDATING"
+
+type searchDeployment struct {
+	deployment admin.ApiSearchDeploymentResponse
+	// reads is the number of reads of the deployment since it was created, updated or deleted.
+	reads    int
+	deleting bool
+}
+
+func (s *Server) registerSearchDeployments() {
+	deployment := api + "/groups/{groupId}/clusters/{clusterName}/search/deployment"
+	s.mux.HandleFunc("POST "+deployment, s.withCluster(s.createSearchDeployment))
+	s.mux.HandleFunc("GET "+deployment, s.withCluster(s.withSearchDeployment(s.getSearchDeployment)))
+	s.mux.HandleFunc("PATCH "+deployment, s.withCluster(s.withSearchDeployment(s.updateSearchDeployment)))
+	s.mux.HandleFunc("DELETE "+deployment, s.withCluster(s.withSearchDeployment(s.deleteSearchDeployment)))
+}
+
+// withSearchDeployment calls the handler with the cluster of the path if it has a search deployment, or answers
+// ATLAS_FTS_DEPLOYMENT_DOES_NOT_EXIST.
+func (s *Server) withSearchDeployment(h func(http.ResponseWriter, *http.Request, *cluster)) func(http.ResponseWriter, *http.Request, *project, *cluster) {
+	return func(w http.ResponseWriter, r *http.Request, _ *project, c *cluster) {
+		if c.searchDeployment == nil {
+			writeSearchDeploymentNotFound(w, c)
+			return
+		}
+		h(w, r, c)
+	}
+}
+
+// writeSearchDeploymentNotFound answers a missing search deployment with a 400 like Atlas.
+func writeSearchDeploymentNotFound(w http.ResponseWriter, c *cluster) {
+	writeError(w, http.StatusBadRequest, "ATLAS_FTS_DEPLOYMENT_DOES_NOT_EXIST",
+		fmt.Sprintf("The search deployment of cluster %s does not exist.", c.description.GetName()))
+}
+
+func (s *Server) createSearchDeployment(w http.ResponseWriter, r *http.Request, p *project, c *cluster) {
+	if c.searchDeployment != nil {
+		writeError(w, http.StatusBadRequest, "ATLAS_FTS_DEPLOYMENT_ALREADY_EXISTS",
+			fmt.Sprintf("The search deployment of cluster %s already exists.", c.description.GetName()))
+		return
+	}
+	var req admin.ApiSearchDeploymentRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Specs) == 0 {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attribute specs is missing.")
+		return
+	}
+	c.searchDeployment = &searchDeployment{deployment: admin.ApiSearchDeploymentResponse{
+		GroupId:   p.group.Id,
+		Id:        admin.PtrString(s.newID()),
+		Specs:     &req.Specs,
+		StateName: admin.PtrString(deploymentUpdating),
+	}}
+	writeJSON(w, http.StatusOK, c.searchDeployment.deployment)
+}
+
+// getSearchDeployment moves an UPDATING deployment to IDLE, or removes a deleted deployment, when it has been read
+// TransitionReads times.
+func (s *Server) getSearchDeployment(w http.ResponseWriter, _ *http.Request, c *cluster) {
+	d := c.searchDeployment
+	if d.deployment.GetStateName() == deploymentUpdating {
+		if d.reads++; s.settled(d.reads) {
+			d.reads = 0
+			if d.deleting {
+				c.searchDeployment = nil
+				writeSearchDeploymentNotFound(w, c)
+				return
+			}
+			d.deployment.StateName = admin.PtrString(stateIdle)
+		}
+	}
+	writeJSON(w, http.StatusOK, d.deployment)
+}
+
+func (s *Server) updateSearchDeployment(w http.ResponseWriter, r *http.Request, c *cluster) {
+	var req admin.ApiSearchDeploymentRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	d := c.searchDeployment
+	d.deployment.Specs = &req.Specs
+	d.deployment.StateName, d.reads = admin.PtrString(deploymentUpdating), 0
+	writeJSON(w, http.StatusOK, d.deployment)
+}
+
+func (s *Server) deleteSearchDeployment(w http.ResponseWriter, _ *http.Request, c *cluster) {
+	d := c.searchDeployment
+	d.deployment.StateName = admin.PtrString(deploymentUpdating)
+	d.reads, d.deleting = 0, true
+	writeJSON(w, http.StatusNoContent, nil)
+}
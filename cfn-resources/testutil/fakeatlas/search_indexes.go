@@ -0,0 +1,126 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+const (
+	indexInProgress = "IN_PROGRESS"
+	indexSteady     = "STEADY"
+)
+
+type searchIndex struct {
+	index admin.ClusterSearchIndex
+	// reads is the number of reads of the index since it was created, updated or deleted.
+	reads    int
+	deleting bool
+}
+
+func (s *Server) registerSearchIndexes() {
+	indexes := api + "/groups/{groupId}/clusters/{clusterName}/fts/indexes"
+	s.mux.HandleFunc("POST "+indexes, s.withCluster(s.createSearchIndex))
+	s.mux.HandleFunc("GET "+indexes+"/{databaseName}/{collectionName}", s.withCluster(s.listSearchIndexes))
+	s.mux.HandleFunc("GET "+indexes+"/{indexId}", s.withSearchIndex(s.getSearchIndex))
+	s.mux.HandleFunc("PATCH "+indexes+"/{indexId}", s.withSearchIndex(s.updateSearchIndex))
+	s.mux.HandleFunc("DELETE "+indexes+"/{indexId}", s.withSearchIndex(s.deleteSearchIndex))
+}
+
+// withSearchIndex calls the handler with the index of the indexId of the path, or answers ATLAS_SEARCH_INDEX_NOT_FOUND.
+func (s *Server) withSearchIndex(h func(http.ResponseWriter, *http.Request, *cluster, *searchIndex)) http.HandlerFunc {
+	return s.withCluster(func(w http.ResponseWriter, r *http.Request, _ *project, c *cluster) {
+		index, ok := c.searchIndexes[r.PathValue("indexId")]
+		if !ok {
+			writeSearchIndexNotFound(w, r.PathValue("indexId"))
+			return
+		}
+		h(w, r, c, index)
+	})
+}
+
+func writeSearchIndexNotFound(w http.ResponseWriter, indexID string) {
+	writeError(w, http.StatusNotFound, "ATLAS_SEARCH_INDEX_NOT_FOUND", fmt.Sprintf("Search index %s not found.", indexID))
+}
+
+func (s *Server) createSearchIndex(w http.ResponseWriter, r *http.Request, _ *project, c *cluster) {
+	var index admin.ClusterSearchIndex
+	if !decode(w, r, &index) {
+		return
+	}
+	if index.Name == "" || index.Database == "" || index.CollectionName == "" {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attributes name, database and collectionName are missing.")
+		return
+	}
+	for _, existing := range c.searchIndexes {
+		if existing.index.Name == index.Name && existing.index.Database == index.Database &&
+			existing.index.CollectionName == index.CollectionName {
+			writeError(w, http.StatusBadRequest, "ATLAS_FTS_DUPLICATE_INDEX",
+				fmt.Sprintf("Index %s already exists on collection %s.%s.", index.Name, index.Database, index.CollectionName))
+			return
+		}
+	}
+	index.IndexID = admin.PtrString(s.newID())
+	index.Status = admin.PtrString(indexInProgress)
+	c.searchIndexes[index.GetIndexID()] = &searchIndex{index: index}
+	writeJSON(w, http.StatusOK, index)
+}
+
+func (s *Server) listSearchIndexes(w http.ResponseWriter, r *http.Request, _ *project, c *cluster) {
+	indexes := []admin.ClusterSearchIndex{}
+	for _, index := range sortedValues(c.searchIndexes) {
+		if index.index.Database == r.PathValue("databaseName") && index.index.CollectionName == r.PathValue("collectionName") {
+			indexes = append(indexes, index.index)
+		}
+	}
+	writeJSON(w, http.StatusOK, indexes)
+}
+
+// getSearchIndex moves an IN_PROGRESS index to STEADY, or removes a deleted index, when it has been read
+// TransitionReads times.
+func (s *Server) getSearchIndex(w http.ResponseWriter, _ *http.Request, c *cluster, index *searchIndex) {
+	if index.index.GetStatus() == indexInProgress {
+		if index.reads++; s.settled(index.reads) {
+			index.reads = 0
+			if index.deleting {
+				delete(c.searchIndexes, index.index.GetIndexID())
+				writeSearchIndexNotFound(w, index.index.GetIndexID())
+				return
+			}
+			index.index.Status = admin.PtrString(indexSteady)
+		}
+	}
+	writeJSON(w, http.StatusOK, index.index)
+}
+
+func (s *Server) updateSearchIndex(w http.ResponseWriter, r *http.Request, _ *cluster, index *searchIndex) {
+	updated := index.index
+	if !patch(w, r, &updated) {
+		return
+	}
+	updated.IndexID = index.index.IndexID
+	updated.Status = admin.PtrString(indexInProgress)
+	index.index, index.reads = updated, 0
+	writeJSON(w, http.StatusOK, index.index)
+}
+
+func (s *Server) deleteSearchIndex(w http.ResponseWriter, _ *http.Request, _ *cluster, index *searchIndex) {
+	index.index.Status = admin.PtrString(indexInProgress)
+	index.reads, index.deleting = 0, true
+	writeJSON(w, http.StatusAccepted, nil)
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+)
+
+const getSecretValueTarget = "secretsmanager.GetSecretValue"
+
+// Session returns an AWS session whose Secrets Manager is the server: every secret is a profile of the server, e.g.
+// the secret of the API key of an organization, read by the handlers without the profile backend.
+func (s *Server) Session() *session.Session {
+	return session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("fakeatlas", "fakeatlas", ""),
+		Endpoint:    aws.String(s.URL),
+		Region:      aws.String(s.RequestContext().Region),
+	}))
+}
+
+// isSecretsManager reports if the request is a request of the Secrets Manager of Session.
+func isSecretsManager(r *http.Request) bool {
+	return r.Header.Get("X-Amz-Target") == getSecretValueTarget
+}
+
+func (s *Server) getSecretValue(w http.ResponseWriter, r *http.Request) {
+	var input struct{ SecretId string }
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	secret, _ := json.Marshal(profile.Profile{PublicKey: PublicKey, PrivateKey: PrivateKey, BaseURL: s.URL})
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"Name":         input.SecretId,
+		"SecretString": string(secret),
+		"VersionId":    "fakeatlas",
+	})
+}
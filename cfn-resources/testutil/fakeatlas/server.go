@@ -0,0 +1,296 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fakeatlas is an in-memory Atlas Admin API served by an httptest.Server, to run the handlers in go test
+// without network nor Atlas account. It keeps the state of projects, clusters, database users, custom database
+// roles, access lists, search indexes, search deployments and backup schedules, and answers with the JSON of the
+// Atlas SDK models, e.g. 404 with an Atlas error for missing objects. The other endpoints answer the responses stubbed
+// by the tests.
+//
+// Clusters, search indexes and search deployments go through their transitional states like in Atlas, e.g. CREATING
+// then IDLE: they reach their next state at their TransitionReads-th read, so the handlers go through their callbacks.
+//
+// The handlers read the profile from the environment: New sets the MONGODB_ATLAS_* variables of the test to the
+// server, and Request returns the handler requests of an account of its own, so the profiles and clients cached by
+// the handlers for another server aren't used. Session is the AWS session of the handlers reading a profile from
+// Secrets Manager whatever the profile backend, e.g. the organization resource. The server is also the App Services
+// Admin API of the Realm clients, e.g. for the triggers.
+package fakeatlas
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/mongodb-forks/digest"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+const (
+	// OrgID is the organization of the projects of the server.
+	OrgID = "5f0000000000000000000001"
+
+	PublicKey  = "fakeatlas-public"
+	PrivateKey = "fakeatlas-private"
+
+	realm = "MMS Public API"
+	nonce = "fakeatlas"
+)
+
+// accounts gives each server the AWS account of its requests.
+var accounts atomic.Int64
+
+// Server is the fake Atlas Admin API, its URL is the base URL of the clients.
+type Server struct {
+	*httptest.Server
+	// TransitionReads is the number of reads of a cluster, a search index or a search deployment in a transitional
+	// state until it reaches its next state, 1 by default: the first read after a change returns the next state.
+	TransitionReads int
+
+	mux       *http.ServeMux
+	projects  map[string]*project
+	accountID string
+	mu        sync.Mutex
+	nextID    int
+}
+
+// New starts a server closed at the end of the test, and sets the environment of the test so that the handlers use
+// it with the Environment profile backend.
+func New(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{
+		TransitionReads: 1,
+		mux:             http.NewServeMux(),
+		projects:        map[string]*project{},
+		accountID:       fmt.Sprintf("%012d", accounts.Add(1)),
+	}
+	s.registerProjects()
+	s.registerClusters()
+	s.registerDatabaseUsers()
+	s.registerCustomDBRoles()
+	s.registerAccessList()
+	s.registerSearchIndexes()
+	s.registerSearchDeployments()
+	s.registerBackupSchedules()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	if setenv, ok := t.(interface{ Setenv(key, value string) }); ok {
+		setenv.Setenv("MONGODB_ATLAS_PROFILE_BACKEND", profile.BackendEnvironment)
+		setenv.Setenv("MONGODB_ATLAS_PUBLIC_KEY", PublicKey)
+		setenv.Setenv("MONGODB_ATLAS_PRIVATE_KEY", PrivateKey)
+		setenv.Setenv("MONGODB_ATLAS_BASE_URL", s.URL)
+		setenv.Setenv("MONGODB_REALM_BASE_URL", s.URL)
+	}
+	return s
+}
+
+// Request returns a request of the account of the server, with the callback context of a previous event if any.
+func (s *Server) Request(callbackContext map[string]any) handler.Request {
+	return handler.NewRequest("", callbackContext, s.RequestContext(), nil, nil, nil, nil)
+}
+
+// RequestContext returns the request context selecting the profile of the server, e.g. for testutil.TestCase.
+func (s *Server) RequestContext() handler.RequestContext {
+	return handler.RequestContext{AccountID: s.accountID, Region: "us-east-1"}
+}
+
+// Config returns the client configuration of the server.
+func (s *Server) Config() util.Config {
+	return util.Config{BaseURL: s.URL}
+}
+
+// AtlasClient returns a client of the server authenticated like the handlers, e.g. to check the state after a
+// handler or to create objects through the API.
+func (s *Server) AtlasClient() (*admin.APIClient, error) {
+	c := s.Config()
+	return c.NewSDKv20231115014Client(&http.Client{Transport: digest.NewTransport(PublicKey, PrivateKey)})
+}
+
+// Stub serves the JSON body for the requests matching the http.ServeMux pattern, e.g.
+// "GET /api/atlas/v2/groups/{groupId}/auditLog", to read the objects the server doesn't keep. The pattern must not
+// conflict with the endpoints of the server.
+func (s *Server) Stub(pattern string, body any) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if isSecretsManager(r) {
+		s.getSecretValue(w, r)
+		return
+	}
+	if isRealm(r) {
+		s.serveRealm(w, r)
+		return
+	}
+	if !authorized(r) {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Digest realm=%q, domain="", nonce=%q, algorithm=MD5, qop="auth", stale=false`, realm, nonce))
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "You are not authorized for this resource.")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// the mux can't tell /groups/byName/{groupName} from the paths of the objects of a project
+	if name, ok := strings.CutPrefix(r.URL.Path, "/api/atlas/v2/groups/byName/"); ok && r.Method == http.MethodGet {
+		s.getProjectByName(w, name)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+var digestParam = regexp.MustCompile(`(\w+)=(?:"([^"]*)"|([^,\s]*))`)
+
+// authorized checks the digest authentication of the request with the API key of the server.
+func authorized(r *http.Request) bool {
+	authorization, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Digest ")
+	if !ok {
+		return false
+	}
+	params := map[string]string{}
+	for _, match := range digestParam.FindAllStringSubmatch(authorization, -1) {
+		params[match[1]] = match[2] + match[3]
+	}
+	if params["username"] != PublicKey || params["nonce"] != nonce {
+		return false
+	}
+	ha1 := md5Hex(PublicKey + ":" + realm + ":" + PrivateKey)
+	ha2 := md5Hex(r.Method + ":" + params["uri"])
+	expected := md5Hex(strings.Join([]string{ha1, nonce, params["nc"], params["cnonce"], params["qop"], ha2}, ":"))
+	return params["response"] == expected
+}
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// newID returns a new 24-hexadecimal digit ID, the IDs are increasing.
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("65%022x", s.nextID)
+}
+
+// settled reports if an object in a transitional state read reads times has reached its next state.
+func (s *Server) settled(reads int) bool {
+	return reads >= max(s.TransitionReads, 1)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/vnd.atlas.2023-11-15+json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, errorCode, detail string) {
+	writeJSON(w, status, admin.ApiError{
+		Error:     admin.PtrInt(status),
+		ErrorCode: admin.PtrString(errorCode),
+		Detail:    admin.PtrString(detail),
+		Reason:    admin.PtrString(http.StatusText(status)),
+	})
+}
+
+// decode reads the JSON body of the request, it writes the error response and returns false if it's invalid.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && err != io.EOF {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return false
+	}
+	return true
+}
+
+// patch applies the fields of the JSON body of the request to dst, like the PATCH endpoints of Atlas which only
+// change the fields of the body.
+func patch(w http.ResponseWriter, r *http.Request, dst any) bool {
+	var changes map[string]any
+	if !decode(w, r, &changes) {
+		return false
+	}
+	current, err := json.Marshal(dst)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "UNEXPECTED_ERROR", err.Error())
+		return false
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(current, &fields); err != nil {
+		writeError(w, http.StatusInternalServerError, "UNEXPECTED_ERROR", err.Error())
+		return false
+	}
+	maps.Copy(fields, changes)
+	merged, _ := json.Marshal(fields)
+	if err := json.Unmarshal(merged, dst); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ATTRIBUTE", err.Error())
+		return false
+	}
+	return true
+}
+
+// page returns the page of items requested by the pageNum and itemsPerPage query parameters, and the total count
+// if requested by includeCount, which is true by default.
+func page[T any](r *http.Request, items []T) (results []T, totalCount *int) {
+	query := r.URL.Query()
+	pageNum, err := strconv.Atoi(query.Get("pageNum"))
+	if err != nil || pageNum < 1 {
+		pageNum = 1
+	}
+	itemsPerPage, err := strconv.Atoi(query.Get("itemsPerPage"))
+	if err != nil || itemsPerPage < 1 {
+		itemsPerPage = 100
+	}
+	start := min((pageNum-1)*itemsPerPage, len(items))
+	end := min(start+itemsPerPage, len(items))
+	results = slices.Clone(items[start:end])
+	if results == nil {
+		results = []T{}
+	}
+	if query.Get("includeCount") != "false" {
+		totalCount = admin.PtrInt(len(items))
+	}
+	return results, totalCount
+}
+
+// sortedValues returns the values of the map ordered by key.
+func sortedValues[V any](m map[string]V) []V {
+	values := make([]V, 0, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		values = append(values, m[k])
+	}
+	return values
+}
+
+func now() *time.Time {
+	t := time.Now().UTC().Truncate(time.Second)
+	return &t
+}
@@ -0,0 +1,187 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeatlas_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/mongodb-forks/digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115014/admin"
+	"go.mongodb.org/realm/realm"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+)
+
+func TestRejectsInvalidCredentials(t *testing.T) {
+	s := fakeatlas.New(t)
+	config := s.Config()
+	client, err := config.NewSDKv20231115014Client(&http.Client{Transport: digest.NewTransport(fakeatlas.PublicKey, "wrong")})
+	require.NoError(t, err)
+
+	_, resp, err := client.ProjectsApi.GetProject(context.Background(), s.AddProject("project")).Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestClusterTransitions(t *testing.T) {
+	ctx := context.Background()
+	s := fakeatlas.New(t)
+	s.TransitionReads = 2
+	client, err := s.AtlasClient()
+	require.NoError(t, err)
+	projectID := s.AddProject("project")
+
+	cluster, _, err := client.ClustersApi.CreateCluster(ctx, projectID, &admin.AdvancedClusterDescription{Name: admin.PtrString("cluster")}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "CREATING", cluster.GetStateName())
+	assert.Equal(t, "mongodb+srv://cluster.fake.mongodb.net", cluster.ConnectionStrings.GetStandardSrv())
+
+	for _, expected := range []string{"CREATING", "IDLE", "IDLE"} {
+		cluster, _, err = client.ClustersApi.GetCluster(ctx, projectID, "cluster").Execute()
+		require.NoError(t, err)
+		assert.Equal(t, expected, cluster.GetStateName())
+	}
+
+	_, err = client.ClustersApi.DeleteCluster(ctx, projectID, "cluster").Execute()
+	require.NoError(t, err)
+	_, _, err = client.ProjectsApi.DeleteProject(ctx, projectID).Execute()
+	assert.True(t, progressevent.IsErrorCode(err, "CANNOT_CLOSE_GROUP_ACTIVE_ATLAS_CLUSTERS"))
+
+	cluster, _, err = client.ClustersApi.GetCluster(ctx, projectID, "cluster").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "DELETING", cluster.GetStateName())
+	_, _, err = client.ClustersApi.GetCluster(ctx, projectID, "cluster").Execute()
+	assert.True(t, progressevent.IsNotFound(err))
+
+	_, _, err = client.ProjectsApi.DeleteProject(ctx, projectID).Execute()
+	require.NoError(t, err)
+	_, _, err = client.ProjectsApi.GetProject(ctx, projectID).Execute()
+	assert.True(t, progressevent.IsErrorCode(err, "GROUP_NOT_FOUND"))
+}
+
+func TestSearchDeploymentTransitions(t *testing.T) {
+	ctx := context.Background()
+	s := fakeatlas.New(t)
+	client, err := s.AtlasClient()
+	require.NoError(t, err)
+	projectID := s.AddProject("project")
+	s.AddCluster(projectID, "cluster")
+	specs := []admin.ApiSearchDeploymentSpec{{InstanceSize: "S20_HIGHCPU_NVME", NodeCount: 2}}
+
+	deployment, _, err := client.AtlasSearchApi.CreateAtlasSearchDeployment(ctx, projectID, "cluster", &admin.ApiSearchDeploymentRequest{Specs: specs}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "UPDATING", deployment.GetStateName())
+	_, _, err = client.AtlasSearchApi.CreateAtlasSearchDeployment(ctx, projectID, "cluster", &admin.ApiSearchDeploymentRequest{Specs: specs}).Execute()
+	assert.True(t, progressevent.IsErrorCode(err, "ATLAS_FTS_DEPLOYMENT_ALREADY_EXISTS"))
+
+	deployment, _, err = client.AtlasSearchApi.GetAtlasSearchDeployment(ctx, projectID, "cluster").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "IDLE", deployment.GetStateName())
+	assert.Equal(t, specs, deployment.GetSpecs())
+
+	_, err = client.AtlasSearchApi.DeleteAtlasSearchDeployment(ctx, projectID, "cluster").Execute()
+	require.NoError(t, err)
+	_, _, err = client.AtlasSearchApi.GetAtlasSearchDeployment(ctx, projectID, "cluster").Execute()
+	assert.True(t, progressevent.IsErrorCode(err, "ATLAS_FTS_DEPLOYMENT_DOES_NOT_EXIST"))
+}
+
+func TestPagination(t *testing.T) {
+	ctx := context.Background()
+	s := fakeatlas.New(t)
+	client, err := s.AtlasClient()
+	require.NoError(t, err)
+	projectID := s.AddProject("project")
+	for _, name := range []string{"a", "b", "c"} {
+		s.AddCluster(projectID, name)
+	}
+
+	page, _, err := client.ClustersApi.ListClusters(ctx, projectID).PageNum(2).ItemsPerPage(2).IncludeCount(true).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 3, page.GetTotalCount())
+	require.Len(t, page.GetResults(), 1)
+	assert.Equal(t, "c", page.GetResults()[0].GetName())
+}
+
+func TestDuplicates(t *testing.T) {
+	ctx := context.Background()
+	s := fakeatlas.New(t)
+	client, err := s.AtlasClient()
+	require.NoError(t, err)
+	projectID := s.AddProject("project")
+
+	_, _, err = client.ProjectsApi.CreateProject(ctx, &admin.Group{Name: "project", OrgId: fakeatlas.OrgID}).Execute()
+	assert.True(t, progressevent.IsAlreadyExists(err))
+
+	user := &admin.CloudDatabaseUser{GroupId: projectID, DatabaseName: "admin", Username: "user", Password: admin.PtrString("password")}
+	_, _, err = client.DatabaseUsersApi.CreateDatabaseUser(ctx, projectID, user).Execute()
+	require.NoError(t, err)
+	_, _, err = client.DatabaseUsersApi.CreateDatabaseUser(ctx, projectID, user).Execute()
+	assert.True(t, progressevent.IsAlreadyExists(err))
+
+	role := &admin.UserCustomDBRole{RoleName: "role", InheritedRoles: &[]admin.DatabaseInheritedRole{{Db: "admin", Role: "read"}}}
+	_, _, err = client.CustomDatabaseRolesApi.CreateCustomDatabaseRole(ctx, projectID, role).Execute()
+	require.NoError(t, err)
+	_, _, err = client.CustomDatabaseRolesApi.CreateCustomDatabaseRole(ctx, projectID, role).Execute()
+	assert.True(t, progressevent.IsAlreadyExists(err))
+
+	read, _, err := client.DatabaseUsersApi.GetDatabaseUser(ctx, projectID, "admin", "user").Execute()
+	require.NoError(t, err)
+	assert.Nil(t, read.Password)
+	password, ok := s.DatabaseUserPassword(projectID, "admin", "user")
+	assert.True(t, ok)
+	assert.Equal(t, "password", password)
+}
+
+func TestStub(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/atlas/v2/groups/{groupId}/auditLog", admin.AuditLog{Enabled: admin.PtrBool(true)})
+	client, err := s.AtlasClient()
+	require.NoError(t, err)
+
+	auditLog, _, err := client.AuditingApi.GetAuditingConfiguration(context.Background(), "project").Execute()
+	require.NoError(t, err)
+	assert.True(t, auditLog.GetEnabled())
+}
+
+func TestSession(t *testing.T) {
+	s := fakeatlas.New(t)
+	req := handler.NewRequest("", nil, s.RequestContext(), s.Session(), nil, nil, nil)
+
+	prof, err := profile.NewProfile(&req, aws.String("org-secret"), false)
+	require.NoError(t, err)
+	assert.Equal(t, fakeatlas.PublicKey, prof.PublicKey)
+	assert.Equal(t, fakeatlas.PrivateKey, prof.PrivateKey)
+	assert.Equal(t, s.URL, prof.BaseURL)
+}
+
+func TestRealmClient(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/admin/v3.0/groups/{groupId}/apps/{appId}/triggers/{triggerId}", realm.EventTrigger{ID: "trigger", Name: "trigger"})
+
+	client, err := util.GetRealmClient(context.Background(), s.Request(nil), aws.String("default"))
+	require.NoError(t, err)
+	trigger, _, err := client.EventTriggers.Get(context.Background(), "project", "app", "trigger")
+	require.NoError(t, err)
+	assert.Equal(t, "trigger", trigger.Name)
+}
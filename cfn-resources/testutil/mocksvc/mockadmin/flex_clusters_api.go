@@ -0,0 +1,1012 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockadmin
+
+import (
+	context "context"
+
+	admin "go.mongodb.org/atlas-sdk/v20241113002/admin"
+
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FlexClustersApi is an autogenerated mock type for the FlexClustersApi type
+type FlexClustersApi struct {
+	mock.Mock
+}
+
+type FlexClustersApi_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FlexClustersApi) EXPECT() *FlexClustersApi_Expecter {
+	return &FlexClustersApi_Expecter{mock: &_m.Mock}
+}
+
+// CreateFlexCluster provides a mock function with given fields: ctx, groupId, flexClusterDescriptionCreate20241113
+func (_m *FlexClustersApi) CreateFlexCluster(ctx context.Context, groupId string, flexClusterDescriptionCreate20241113 *admin.FlexClusterDescriptionCreate20241113) admin.CreateFlexClusterApiRequest {
+	ret := _m.Called(ctx, groupId, flexClusterDescriptionCreate20241113)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFlexCluster")
+	}
+
+	var r0 admin.CreateFlexClusterApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, *admin.FlexClusterDescriptionCreate20241113) admin.CreateFlexClusterApiRequest); ok {
+		r0 = rf(ctx, groupId, flexClusterDescriptionCreate20241113)
+	} else {
+		r0 = ret.Get(0).(admin.CreateFlexClusterApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_CreateFlexCluster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFlexCluster'
+type FlexClustersApi_CreateFlexCluster_Call struct {
+	*mock.Call
+}
+
+// CreateFlexCluster is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupId string
+//   - flexClusterDescriptionCreate20241113 *admin.FlexClusterDescriptionCreate20241113
+func (_e *FlexClustersApi_Expecter) CreateFlexCluster(ctx interface{}, groupId interface{}, flexClusterDescriptionCreate20241113 interface{}) *FlexClustersApi_CreateFlexCluster_Call {
+	return &FlexClustersApi_CreateFlexCluster_Call{Call: _e.mock.On("CreateFlexCluster", ctx, groupId, flexClusterDescriptionCreate20241113)}
+}
+
+func (_c *FlexClustersApi_CreateFlexCluster_Call) Run(run func(ctx context.Context, groupId string, flexClusterDescriptionCreate20241113 *admin.FlexClusterDescriptionCreate20241113)) *FlexClustersApi_CreateFlexCluster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*admin.FlexClusterDescriptionCreate20241113))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_CreateFlexCluster_Call) Return(_a0 admin.CreateFlexClusterApiRequest) *FlexClustersApi_CreateFlexCluster_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_CreateFlexCluster_Call) RunAndReturn(run func(context.Context, string, *admin.FlexClusterDescriptionCreate20241113) admin.CreateFlexClusterApiRequest) *FlexClustersApi_CreateFlexCluster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFlexClusterExecute provides a mock function with given fields: r
+func (_m *FlexClustersApi) CreateFlexClusterExecute(r admin.CreateFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFlexClusterExecute")
+	}
+
+	var r0 *admin.FlexClusterDescription20241113
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.CreateFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.CreateFlexClusterApiRequest) *admin.FlexClusterDescription20241113); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.FlexClusterDescription20241113)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.CreateFlexClusterApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.CreateFlexClusterApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FlexClustersApi_CreateFlexClusterExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFlexClusterExecute'
+type FlexClustersApi_CreateFlexClusterExecute_Call struct {
+	*mock.Call
+}
+
+// CreateFlexClusterExecute is a helper method to define mock.On call
+//   - r admin.CreateFlexClusterApiRequest
+func (_e *FlexClustersApi_Expecter) CreateFlexClusterExecute(r interface{}) *FlexClustersApi_CreateFlexClusterExecute_Call {
+	return &FlexClustersApi_CreateFlexClusterExecute_Call{Call: _e.mock.On("CreateFlexClusterExecute", r)}
+}
+
+func (_c *FlexClustersApi_CreateFlexClusterExecute_Call) Run(run func(r admin.CreateFlexClusterApiRequest)) *FlexClustersApi_CreateFlexClusterExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.CreateFlexClusterApiRequest))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_CreateFlexClusterExecute_Call) Return(_a0 *admin.FlexClusterDescription20241113, _a1 *http.Response, _a2 error) *FlexClustersApi_CreateFlexClusterExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FlexClustersApi_CreateFlexClusterExecute_Call) RunAndReturn(run func(admin.CreateFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error)) *FlexClustersApi_CreateFlexClusterExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFlexClusterWithParams provides a mock function with given fields: ctx, args
+func (_m *FlexClustersApi) CreateFlexClusterWithParams(ctx context.Context, args *admin.CreateFlexClusterApiParams) admin.CreateFlexClusterApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFlexClusterWithParams")
+	}
+
+	var r0 admin.CreateFlexClusterApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.CreateFlexClusterApiParams) admin.CreateFlexClusterApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.CreateFlexClusterApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_CreateFlexClusterWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFlexClusterWithParams'
+type FlexClustersApi_CreateFlexClusterWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateFlexClusterWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.CreateFlexClusterApiParams
+func (_e *FlexClustersApi_Expecter) CreateFlexClusterWithParams(ctx interface{}, args interface{}) *FlexClustersApi_CreateFlexClusterWithParams_Call {
+	return &FlexClustersApi_CreateFlexClusterWithParams_Call{Call: _e.mock.On("CreateFlexClusterWithParams", ctx, args)}
+}
+
+func (_c *FlexClustersApi_CreateFlexClusterWithParams_Call) Run(run func(ctx context.Context, args *admin.CreateFlexClusterApiParams)) *FlexClustersApi_CreateFlexClusterWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.CreateFlexClusterApiParams))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_CreateFlexClusterWithParams_Call) Return(_a0 admin.CreateFlexClusterApiRequest) *FlexClustersApi_CreateFlexClusterWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_CreateFlexClusterWithParams_Call) RunAndReturn(run func(context.Context, *admin.CreateFlexClusterApiParams) admin.CreateFlexClusterApiRequest) *FlexClustersApi_CreateFlexClusterWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFlexCluster provides a mock function with given fields: ctx, groupId, name
+func (_m *FlexClustersApi) DeleteFlexCluster(ctx context.Context, groupId string, name string) admin.DeleteFlexClusterApiRequest {
+	ret := _m.Called(ctx, groupId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFlexCluster")
+	}
+
+	var r0 admin.DeleteFlexClusterApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) admin.DeleteFlexClusterApiRequest); ok {
+		r0 = rf(ctx, groupId, name)
+	} else {
+		r0 = ret.Get(0).(admin.DeleteFlexClusterApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_DeleteFlexCluster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFlexCluster'
+type FlexClustersApi_DeleteFlexCluster_Call struct {
+	*mock.Call
+}
+
+// DeleteFlexCluster is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupId string
+//   - name string
+func (_e *FlexClustersApi_Expecter) DeleteFlexCluster(ctx interface{}, groupId interface{}, name interface{}) *FlexClustersApi_DeleteFlexCluster_Call {
+	return &FlexClustersApi_DeleteFlexCluster_Call{Call: _e.mock.On("DeleteFlexCluster", ctx, groupId, name)}
+}
+
+func (_c *FlexClustersApi_DeleteFlexCluster_Call) Run(run func(ctx context.Context, groupId string, name string)) *FlexClustersApi_DeleteFlexCluster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_DeleteFlexCluster_Call) Return(_a0 admin.DeleteFlexClusterApiRequest) *FlexClustersApi_DeleteFlexCluster_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_DeleteFlexCluster_Call) RunAndReturn(run func(context.Context, string, string) admin.DeleteFlexClusterApiRequest) *FlexClustersApi_DeleteFlexCluster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFlexClusterExecute provides a mock function with given fields: r
+func (_m *FlexClustersApi) DeleteFlexClusterExecute(r admin.DeleteFlexClusterApiRequest) (interface{}, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFlexClusterExecute")
+	}
+
+	var r0 interface{}
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.DeleteFlexClusterApiRequest) (interface{}, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.DeleteFlexClusterApiRequest) interface{}); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.DeleteFlexClusterApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.DeleteFlexClusterApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FlexClustersApi_DeleteFlexClusterExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFlexClusterExecute'
+type FlexClustersApi_DeleteFlexClusterExecute_Call struct {
+	*mock.Call
+}
+
+// DeleteFlexClusterExecute is a helper method to define mock.On call
+//   - r admin.DeleteFlexClusterApiRequest
+func (_e *FlexClustersApi_Expecter) DeleteFlexClusterExecute(r interface{}) *FlexClustersApi_DeleteFlexClusterExecute_Call {
+	return &FlexClustersApi_DeleteFlexClusterExecute_Call{Call: _e.mock.On("DeleteFlexClusterExecute", r)}
+}
+
+func (_c *FlexClustersApi_DeleteFlexClusterExecute_Call) Run(run func(r admin.DeleteFlexClusterApiRequest)) *FlexClustersApi_DeleteFlexClusterExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.DeleteFlexClusterApiRequest))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_DeleteFlexClusterExecute_Call) Return(_a0 interface{}, _a1 *http.Response, _a2 error) *FlexClustersApi_DeleteFlexClusterExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FlexClustersApi_DeleteFlexClusterExecute_Call) RunAndReturn(run func(admin.DeleteFlexClusterApiRequest) (interface{}, *http.Response, error)) *FlexClustersApi_DeleteFlexClusterExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFlexClusterWithParams provides a mock function with given fields: ctx, args
+func (_m *FlexClustersApi) DeleteFlexClusterWithParams(ctx context.Context, args *admin.DeleteFlexClusterApiParams) admin.DeleteFlexClusterApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFlexClusterWithParams")
+	}
+
+	var r0 admin.DeleteFlexClusterApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.DeleteFlexClusterApiParams) admin.DeleteFlexClusterApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.DeleteFlexClusterApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_DeleteFlexClusterWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFlexClusterWithParams'
+type FlexClustersApi_DeleteFlexClusterWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteFlexClusterWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.DeleteFlexClusterApiParams
+func (_e *FlexClustersApi_Expecter) DeleteFlexClusterWithParams(ctx interface{}, args interface{}) *FlexClustersApi_DeleteFlexClusterWithParams_Call {
+	return &FlexClustersApi_DeleteFlexClusterWithParams_Call{Call: _e.mock.On("DeleteFlexClusterWithParams", ctx, args)}
+}
+
+func (_c *FlexClustersApi_DeleteFlexClusterWithParams_Call) Run(run func(ctx context.Context, args *admin.DeleteFlexClusterApiParams)) *FlexClustersApi_DeleteFlexClusterWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.DeleteFlexClusterApiParams))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_DeleteFlexClusterWithParams_Call) Return(_a0 admin.DeleteFlexClusterApiRequest) *FlexClustersApi_DeleteFlexClusterWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_DeleteFlexClusterWithParams_Call) RunAndReturn(run func(context.Context, *admin.DeleteFlexClusterApiParams) admin.DeleteFlexClusterApiRequest) *FlexClustersApi_DeleteFlexClusterWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlexCluster provides a mock function with given fields: ctx, groupId, name
+func (_m *FlexClustersApi) GetFlexCluster(ctx context.Context, groupId string, name string) admin.GetFlexClusterApiRequest {
+	ret := _m.Called(ctx, groupId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlexCluster")
+	}
+
+	var r0 admin.GetFlexClusterApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) admin.GetFlexClusterApiRequest); ok {
+		r0 = rf(ctx, groupId, name)
+	} else {
+		r0 = ret.Get(0).(admin.GetFlexClusterApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_GetFlexCluster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlexCluster'
+type FlexClustersApi_GetFlexCluster_Call struct {
+	*mock.Call
+}
+
+// GetFlexCluster is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupId string
+//   - name string
+func (_e *FlexClustersApi_Expecter) GetFlexCluster(ctx interface{}, groupId interface{}, name interface{}) *FlexClustersApi_GetFlexCluster_Call {
+	return &FlexClustersApi_GetFlexCluster_Call{Call: _e.mock.On("GetFlexCluster", ctx, groupId, name)}
+}
+
+func (_c *FlexClustersApi_GetFlexCluster_Call) Run(run func(ctx context.Context, groupId string, name string)) *FlexClustersApi_GetFlexCluster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_GetFlexCluster_Call) Return(_a0 admin.GetFlexClusterApiRequest) *FlexClustersApi_GetFlexCluster_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_GetFlexCluster_Call) RunAndReturn(run func(context.Context, string, string) admin.GetFlexClusterApiRequest) *FlexClustersApi_GetFlexCluster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlexClusterExecute provides a mock function with given fields: r
+func (_m *FlexClustersApi) GetFlexClusterExecute(r admin.GetFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlexClusterExecute")
+	}
+
+	var r0 *admin.FlexClusterDescription20241113
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.GetFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.GetFlexClusterApiRequest) *admin.FlexClusterDescription20241113); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.FlexClusterDescription20241113)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.GetFlexClusterApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.GetFlexClusterApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FlexClustersApi_GetFlexClusterExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlexClusterExecute'
+type FlexClustersApi_GetFlexClusterExecute_Call struct {
+	*mock.Call
+}
+
+// GetFlexClusterExecute is a helper method to define mock.On call
+//   - r admin.GetFlexClusterApiRequest
+func (_e *FlexClustersApi_Expecter) GetFlexClusterExecute(r interface{}) *FlexClustersApi_GetFlexClusterExecute_Call {
+	return &FlexClustersApi_GetFlexClusterExecute_Call{Call: _e.mock.On("GetFlexClusterExecute", r)}
+}
+
+func (_c *FlexClustersApi_GetFlexClusterExecute_Call) Run(run func(r admin.GetFlexClusterApiRequest)) *FlexClustersApi_GetFlexClusterExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.GetFlexClusterApiRequest))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_GetFlexClusterExecute_Call) Return(_a0 *admin.FlexClusterDescription20241113, _a1 *http.Response, _a2 error) *FlexClustersApi_GetFlexClusterExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FlexClustersApi_GetFlexClusterExecute_Call) RunAndReturn(run func(admin.GetFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error)) *FlexClustersApi_GetFlexClusterExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlexClusterWithParams provides a mock function with given fields: ctx, args
+func (_m *FlexClustersApi) GetFlexClusterWithParams(ctx context.Context, args *admin.GetFlexClusterApiParams) admin.GetFlexClusterApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlexClusterWithParams")
+	}
+
+	var r0 admin.GetFlexClusterApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.GetFlexClusterApiParams) admin.GetFlexClusterApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.GetFlexClusterApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_GetFlexClusterWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlexClusterWithParams'
+type FlexClustersApi_GetFlexClusterWithParams_Call struct {
+	*mock.Call
+}
+
+// GetFlexClusterWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.GetFlexClusterApiParams
+func (_e *FlexClustersApi_Expecter) GetFlexClusterWithParams(ctx interface{}, args interface{}) *FlexClustersApi_GetFlexClusterWithParams_Call {
+	return &FlexClustersApi_GetFlexClusterWithParams_Call{Call: _e.mock.On("GetFlexClusterWithParams", ctx, args)}
+}
+
+func (_c *FlexClustersApi_GetFlexClusterWithParams_Call) Run(run func(ctx context.Context, args *admin.GetFlexClusterApiParams)) *FlexClustersApi_GetFlexClusterWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.GetFlexClusterApiParams))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_GetFlexClusterWithParams_Call) Return(_a0 admin.GetFlexClusterApiRequest) *FlexClustersApi_GetFlexClusterWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_GetFlexClusterWithParams_Call) RunAndReturn(run func(context.Context, *admin.GetFlexClusterApiParams) admin.GetFlexClusterApiRequest) *FlexClustersApi_GetFlexClusterWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlexClusters provides a mock function with given fields: ctx, groupId
+func (_m *FlexClustersApi) ListFlexClusters(ctx context.Context, groupId string) admin.ListFlexClustersApiRequest {
+	ret := _m.Called(ctx, groupId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFlexClusters")
+	}
+
+	var r0 admin.ListFlexClustersApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string) admin.ListFlexClustersApiRequest); ok {
+		r0 = rf(ctx, groupId)
+	} else {
+		r0 = ret.Get(0).(admin.ListFlexClustersApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_ListFlexClusters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlexClusters'
+type FlexClustersApi_ListFlexClusters_Call struct {
+	*mock.Call
+}
+
+// ListFlexClusters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupId string
+func (_e *FlexClustersApi_Expecter) ListFlexClusters(ctx interface{}, groupId interface{}) *FlexClustersApi_ListFlexClusters_Call {
+	return &FlexClustersApi_ListFlexClusters_Call{Call: _e.mock.On("ListFlexClusters", ctx, groupId)}
+}
+
+func (_c *FlexClustersApi_ListFlexClusters_Call) Run(run func(ctx context.Context, groupId string)) *FlexClustersApi_ListFlexClusters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_ListFlexClusters_Call) Return(_a0 admin.ListFlexClustersApiRequest) *FlexClustersApi_ListFlexClusters_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_ListFlexClusters_Call) RunAndReturn(run func(context.Context, string) admin.ListFlexClustersApiRequest) *FlexClustersApi_ListFlexClusters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlexClustersExecute provides a mock function with given fields: r
+func (_m *FlexClustersApi) ListFlexClustersExecute(r admin.ListFlexClustersApiRequest) (*admin.PaginatedFlexClusters20241113, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFlexClustersExecute")
+	}
+
+	var r0 *admin.PaginatedFlexClusters20241113
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.ListFlexClustersApiRequest) (*admin.PaginatedFlexClusters20241113, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.ListFlexClustersApiRequest) *admin.PaginatedFlexClusters20241113); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.PaginatedFlexClusters20241113)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.ListFlexClustersApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.ListFlexClustersApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FlexClustersApi_ListFlexClustersExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlexClustersExecute'
+type FlexClustersApi_ListFlexClustersExecute_Call struct {
+	*mock.Call
+}
+
+// ListFlexClustersExecute is a helper method to define mock.On call
+//   - r admin.ListFlexClustersApiRequest
+func (_e *FlexClustersApi_Expecter) ListFlexClustersExecute(r interface{}) *FlexClustersApi_ListFlexClustersExecute_Call {
+	return &FlexClustersApi_ListFlexClustersExecute_Call{Call: _e.mock.On("ListFlexClustersExecute", r)}
+}
+
+func (_c *FlexClustersApi_ListFlexClustersExecute_Call) Run(run func(r admin.ListFlexClustersApiRequest)) *FlexClustersApi_ListFlexClustersExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.ListFlexClustersApiRequest))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_ListFlexClustersExecute_Call) Return(_a0 *admin.PaginatedFlexClusters20241113, _a1 *http.Response, _a2 error) *FlexClustersApi_ListFlexClustersExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FlexClustersApi_ListFlexClustersExecute_Call) RunAndReturn(run func(admin.ListFlexClustersApiRequest) (*admin.PaginatedFlexClusters20241113, *http.Response, error)) *FlexClustersApi_ListFlexClustersExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlexClustersWithParams provides a mock function with given fields: ctx, args
+func (_m *FlexClustersApi) ListFlexClustersWithParams(ctx context.Context, args *admin.ListFlexClustersApiParams) admin.ListFlexClustersApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFlexClustersWithParams")
+	}
+
+	var r0 admin.ListFlexClustersApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ListFlexClustersApiParams) admin.ListFlexClustersApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.ListFlexClustersApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_ListFlexClustersWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlexClustersWithParams'
+type FlexClustersApi_ListFlexClustersWithParams_Call struct {
+	*mock.Call
+}
+
+// ListFlexClustersWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.ListFlexClustersApiParams
+func (_e *FlexClustersApi_Expecter) ListFlexClustersWithParams(ctx interface{}, args interface{}) *FlexClustersApi_ListFlexClustersWithParams_Call {
+	return &FlexClustersApi_ListFlexClustersWithParams_Call{Call: _e.mock.On("ListFlexClustersWithParams", ctx, args)}
+}
+
+func (_c *FlexClustersApi_ListFlexClustersWithParams_Call) Run(run func(ctx context.Context, args *admin.ListFlexClustersApiParams)) *FlexClustersApi_ListFlexClustersWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ListFlexClustersApiParams))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_ListFlexClustersWithParams_Call) Return(_a0 admin.ListFlexClustersApiRequest) *FlexClustersApi_ListFlexClustersWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_ListFlexClustersWithParams_Call) RunAndReturn(run func(context.Context, *admin.ListFlexClustersApiParams) admin.ListFlexClustersApiRequest) *FlexClustersApi_ListFlexClustersWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFlexCluster provides a mock function with given fields: ctx, groupId, name, flexClusterDescriptionUpdate20241113
+func (_m *FlexClustersApi) UpdateFlexCluster(ctx context.Context, groupId string, name string, flexClusterDescriptionUpdate20241113 *admin.FlexClusterDescriptionUpdate20241113) admin.UpdateFlexClusterApiRequest {
+	ret := _m.Called(ctx, groupId, name, flexClusterDescriptionUpdate20241113)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFlexCluster")
+	}
+
+	var r0 admin.UpdateFlexClusterApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *admin.FlexClusterDescriptionUpdate20241113) admin.UpdateFlexClusterApiRequest); ok {
+		r0 = rf(ctx, groupId, name, flexClusterDescriptionUpdate20241113)
+	} else {
+		r0 = ret.Get(0).(admin.UpdateFlexClusterApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_UpdateFlexCluster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlexCluster'
+type FlexClustersApi_UpdateFlexCluster_Call struct {
+	*mock.Call
+}
+
+// UpdateFlexCluster is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupId string
+//   - name string
+//   - flexClusterDescriptionUpdate20241113 *admin.FlexClusterDescriptionUpdate20241113
+func (_e *FlexClustersApi_Expecter) UpdateFlexCluster(ctx interface{}, groupId interface{}, name interface{}, flexClusterDescriptionUpdate20241113 interface{}) *FlexClustersApi_UpdateFlexCluster_Call {
+	return &FlexClustersApi_UpdateFlexCluster_Call{Call: _e.mock.On("UpdateFlexCluster", ctx, groupId, name, flexClusterDescriptionUpdate20241113)}
+}
+
+func (_c *FlexClustersApi_UpdateFlexCluster_Call) Run(run func(ctx context.Context, groupId string, name string, flexClusterDescriptionUpdate20241113 *admin.FlexClusterDescriptionUpdate20241113)) *FlexClustersApi_UpdateFlexCluster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*admin.FlexClusterDescriptionUpdate20241113))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_UpdateFlexCluster_Call) Return(_a0 admin.UpdateFlexClusterApiRequest) *FlexClustersApi_UpdateFlexCluster_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_UpdateFlexCluster_Call) RunAndReturn(run func(context.Context, string, string, *admin.FlexClusterDescriptionUpdate20241113) admin.UpdateFlexClusterApiRequest) *FlexClustersApi_UpdateFlexCluster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFlexClusterExecute provides a mock function with given fields: r
+func (_m *FlexClustersApi) UpdateFlexClusterExecute(r admin.UpdateFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFlexClusterExecute")
+	}
+
+	var r0 *admin.FlexClusterDescription20241113
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.UpdateFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.UpdateFlexClusterApiRequest) *admin.FlexClusterDescription20241113); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.FlexClusterDescription20241113)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.UpdateFlexClusterApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.UpdateFlexClusterApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FlexClustersApi_UpdateFlexClusterExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlexClusterExecute'
+type FlexClustersApi_UpdateFlexClusterExecute_Call struct {
+	*mock.Call
+}
+
+// UpdateFlexClusterExecute is a helper method to define mock.On call
+//   - r admin.UpdateFlexClusterApiRequest
+func (_e *FlexClustersApi_Expecter) UpdateFlexClusterExecute(r interface{}) *FlexClustersApi_UpdateFlexClusterExecute_Call {
+	return &FlexClustersApi_UpdateFlexClusterExecute_Call{Call: _e.mock.On("UpdateFlexClusterExecute", r)}
+}
+
+func (_c *FlexClustersApi_UpdateFlexClusterExecute_Call) Run(run func(r admin.UpdateFlexClusterApiRequest)) *FlexClustersApi_UpdateFlexClusterExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.UpdateFlexClusterApiRequest))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_UpdateFlexClusterExecute_Call) Return(_a0 *admin.FlexClusterDescription20241113, _a1 *http.Response, _a2 error) *FlexClustersApi_UpdateFlexClusterExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FlexClustersApi_UpdateFlexClusterExecute_Call) RunAndReturn(run func(admin.UpdateFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error)) *FlexClustersApi_UpdateFlexClusterExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFlexClusterWithParams provides a mock function with given fields: ctx, args
+func (_m *FlexClustersApi) UpdateFlexClusterWithParams(ctx context.Context, args *admin.UpdateFlexClusterApiParams) admin.UpdateFlexClusterApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFlexClusterWithParams")
+	}
+
+	var r0 admin.UpdateFlexClusterApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.UpdateFlexClusterApiParams) admin.UpdateFlexClusterApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.UpdateFlexClusterApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_UpdateFlexClusterWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlexClusterWithParams'
+type FlexClustersApi_UpdateFlexClusterWithParams_Call struct {
+	*mock.Call
+}
+
+// UpdateFlexClusterWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.UpdateFlexClusterApiParams
+func (_e *FlexClustersApi_Expecter) UpdateFlexClusterWithParams(ctx interface{}, args interface{}) *FlexClustersApi_UpdateFlexClusterWithParams_Call {
+	return &FlexClustersApi_UpdateFlexClusterWithParams_Call{Call: _e.mock.On("UpdateFlexClusterWithParams", ctx, args)}
+}
+
+func (_c *FlexClustersApi_UpdateFlexClusterWithParams_Call) Run(run func(ctx context.Context, args *admin.UpdateFlexClusterApiParams)) *FlexClustersApi_UpdateFlexClusterWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.UpdateFlexClusterApiParams))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_UpdateFlexClusterWithParams_Call) Return(_a0 admin.UpdateFlexClusterApiRequest) *FlexClustersApi_UpdateFlexClusterWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_UpdateFlexClusterWithParams_Call) RunAndReturn(run func(context.Context, *admin.UpdateFlexClusterApiParams) admin.UpdateFlexClusterApiRequest) *FlexClustersApi_UpdateFlexClusterWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpgradeFlexCluster provides a mock function with given fields: ctx, groupId, atlasTenantClusterUpgradeRequest20240805
+func (_m *FlexClustersApi) UpgradeFlexCluster(ctx context.Context, groupId string, atlasTenantClusterUpgradeRequest20240805 *admin.AtlasTenantClusterUpgradeRequest20240805) admin.UpgradeFlexClusterApiRequest {
+	ret := _m.Called(ctx, groupId, atlasTenantClusterUpgradeRequest20240805)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpgradeFlexCluster")
+	}
+
+	var r0 admin.UpgradeFlexClusterApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, *admin.AtlasTenantClusterUpgradeRequest20240805) admin.UpgradeFlexClusterApiRequest); ok {
+		r0 = rf(ctx, groupId, atlasTenantClusterUpgradeRequest20240805)
+	} else {
+		r0 = ret.Get(0).(admin.UpgradeFlexClusterApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_UpgradeFlexCluster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpgradeFlexCluster'
+type FlexClustersApi_UpgradeFlexCluster_Call struct {
+	*mock.Call
+}
+
+// UpgradeFlexCluster is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupId string
+//   - atlasTenantClusterUpgradeRequest20240805 *admin.AtlasTenantClusterUpgradeRequest20240805
+func (_e *FlexClustersApi_Expecter) UpgradeFlexCluster(ctx interface{}, groupId interface{}, atlasTenantClusterUpgradeRequest20240805 interface{}) *FlexClustersApi_UpgradeFlexCluster_Call {
+	return &FlexClustersApi_UpgradeFlexCluster_Call{Call: _e.mock.On("UpgradeFlexCluster", ctx, groupId, atlasTenantClusterUpgradeRequest20240805)}
+}
+
+func (_c *FlexClustersApi_UpgradeFlexCluster_Call) Run(run func(ctx context.Context, groupId string, atlasTenantClusterUpgradeRequest20240805 *admin.AtlasTenantClusterUpgradeRequest20240805)) *FlexClustersApi_UpgradeFlexCluster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*admin.AtlasTenantClusterUpgradeRequest20240805))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_UpgradeFlexCluster_Call) Return(_a0 admin.UpgradeFlexClusterApiRequest) *FlexClustersApi_UpgradeFlexCluster_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_UpgradeFlexCluster_Call) RunAndReturn(run func(context.Context, string, *admin.AtlasTenantClusterUpgradeRequest20240805) admin.UpgradeFlexClusterApiRequest) *FlexClustersApi_UpgradeFlexCluster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpgradeFlexClusterExecute provides a mock function with given fields: r
+func (_m *FlexClustersApi) UpgradeFlexClusterExecute(r admin.UpgradeFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpgradeFlexClusterExecute")
+	}
+
+	var r0 *admin.FlexClusterDescription20241113
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.UpgradeFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.UpgradeFlexClusterApiRequest) *admin.FlexClusterDescription20241113); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.FlexClusterDescription20241113)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.UpgradeFlexClusterApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.UpgradeFlexClusterApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FlexClustersApi_UpgradeFlexClusterExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpgradeFlexClusterExecute'
+type FlexClustersApi_UpgradeFlexClusterExecute_Call struct {
+	*mock.Call
+}
+
+// UpgradeFlexClusterExecute is a helper method to define mock.On call
+//   - r admin.UpgradeFlexClusterApiRequest
+func (_e *FlexClustersApi_Expecter) UpgradeFlexClusterExecute(r interface{}) *FlexClustersApi_UpgradeFlexClusterExecute_Call {
+	return &FlexClustersApi_UpgradeFlexClusterExecute_Call{Call: _e.mock.On("UpgradeFlexClusterExecute", r)}
+}
+
+func (_c *FlexClustersApi_UpgradeFlexClusterExecute_Call) Run(run func(r admin.UpgradeFlexClusterApiRequest)) *FlexClustersApi_UpgradeFlexClusterExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.UpgradeFlexClusterApiRequest))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_UpgradeFlexClusterExecute_Call) Return(_a0 *admin.FlexClusterDescription20241113, _a1 *http.Response, _a2 error) *FlexClustersApi_UpgradeFlexClusterExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FlexClustersApi_UpgradeFlexClusterExecute_Call) RunAndReturn(run func(admin.UpgradeFlexClusterApiRequest) (*admin.FlexClusterDescription20241113, *http.Response, error)) *FlexClustersApi_UpgradeFlexClusterExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpgradeFlexClusterWithParams provides a mock function with given fields: ctx, args
+func (_m *FlexClustersApi) UpgradeFlexClusterWithParams(ctx context.Context, args *admin.UpgradeFlexClusterApiParams) admin.UpgradeFlexClusterApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpgradeFlexClusterWithParams")
+	}
+
+	var r0 admin.UpgradeFlexClusterApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.UpgradeFlexClusterApiParams) admin.UpgradeFlexClusterApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.UpgradeFlexClusterApiRequest)
+	}
+
+	return r0
+}
+
+// FlexClustersApi_UpgradeFlexClusterWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpgradeFlexClusterWithParams'
+type FlexClustersApi_UpgradeFlexClusterWithParams_Call struct {
+	*mock.Call
+}
+
+// UpgradeFlexClusterWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.UpgradeFlexClusterApiParams
+func (_e *FlexClustersApi_Expecter) UpgradeFlexClusterWithParams(ctx interface{}, args interface{}) *FlexClustersApi_UpgradeFlexClusterWithParams_Call {
+	return &FlexClustersApi_UpgradeFlexClusterWithParams_Call{Call: _e.mock.On("UpgradeFlexClusterWithParams", ctx, args)}
+}
+
+func (_c *FlexClustersApi_UpgradeFlexClusterWithParams_Call) Run(run func(ctx context.Context, args *admin.UpgradeFlexClusterApiParams)) *FlexClustersApi_UpgradeFlexClusterWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.UpgradeFlexClusterApiParams))
+	})
+	return _c
+}
+
+func (_c *FlexClustersApi_UpgradeFlexClusterWithParams_Call) Return(_a0 admin.UpgradeFlexClusterApiRequest) *FlexClustersApi_UpgradeFlexClusterWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexClustersApi_UpgradeFlexClusterWithParams_Call) RunAndReturn(run func(context.Context, *admin.UpgradeFlexClusterApiParams) admin.UpgradeFlexClusterApiRequest) *FlexClustersApi_UpgradeFlexClusterWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFlexClustersApi creates a new instance of FlexClustersApi. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFlexClustersApi(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FlexClustersApi {
+	mock := &FlexClustersApi{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
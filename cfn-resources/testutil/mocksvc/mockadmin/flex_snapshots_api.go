@@ -0,0 +1,528 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockadmin
+
+import (
+	context "context"
+
+	admin "go.mongodb.org/atlas-sdk/v20241113002/admin"
+
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FlexSnapshotsApi is an autogenerated mock type for the FlexSnapshotsApi type
+type FlexSnapshotsApi struct {
+	mock.Mock
+}
+
+type FlexSnapshotsApi_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FlexSnapshotsApi) EXPECT() *FlexSnapshotsApi_Expecter {
+	return &FlexSnapshotsApi_Expecter{mock: &_m.Mock}
+}
+
+// DownloadFlexBackup provides a mock function with given fields: ctx, name, groupId, flexBackupSnapshotDownloadCreate20241113
+func (_m *FlexSnapshotsApi) DownloadFlexBackup(ctx context.Context, name string, groupId string, flexBackupSnapshotDownloadCreate20241113 *admin.FlexBackupSnapshotDownloadCreate20241113) admin.DownloadFlexBackupApiRequest {
+	ret := _m.Called(ctx, name, groupId, flexBackupSnapshotDownloadCreate20241113)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadFlexBackup")
+	}
+
+	var r0 admin.DownloadFlexBackupApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *admin.FlexBackupSnapshotDownloadCreate20241113) admin.DownloadFlexBackupApiRequest); ok {
+		r0 = rf(ctx, name, groupId, flexBackupSnapshotDownloadCreate20241113)
+	} else {
+		r0 = ret.Get(0).(admin.DownloadFlexBackupApiRequest)
+	}
+
+	return r0
+}
+
+// FlexSnapshotsApi_DownloadFlexBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadFlexBackup'
+type FlexSnapshotsApi_DownloadFlexBackup_Call struct {
+	*mock.Call
+}
+
+// DownloadFlexBackup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - groupId string
+//   - flexBackupSnapshotDownloadCreate20241113 *admin.FlexBackupSnapshotDownloadCreate20241113
+func (_e *FlexSnapshotsApi_Expecter) DownloadFlexBackup(ctx interface{}, name interface{}, groupId interface{}, flexBackupSnapshotDownloadCreate20241113 interface{}) *FlexSnapshotsApi_DownloadFlexBackup_Call {
+	return &FlexSnapshotsApi_DownloadFlexBackup_Call{Call: _e.mock.On("DownloadFlexBackup", ctx, name, groupId, flexBackupSnapshotDownloadCreate20241113)}
+}
+
+func (_c *FlexSnapshotsApi_DownloadFlexBackup_Call) Run(run func(ctx context.Context, name string, groupId string, flexBackupSnapshotDownloadCreate20241113 *admin.FlexBackupSnapshotDownloadCreate20241113)) *FlexSnapshotsApi_DownloadFlexBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*admin.FlexBackupSnapshotDownloadCreate20241113))
+	})
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_DownloadFlexBackup_Call) Return(_a0 admin.DownloadFlexBackupApiRequest) *FlexSnapshotsApi_DownloadFlexBackup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_DownloadFlexBackup_Call) RunAndReturn(run func(context.Context, string, string, *admin.FlexBackupSnapshotDownloadCreate20241113) admin.DownloadFlexBackupApiRequest) *FlexSnapshotsApi_DownloadFlexBackup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadFlexBackupExecute provides a mock function with given fields: r
+func (_m *FlexSnapshotsApi) DownloadFlexBackupExecute(r admin.DownloadFlexBackupApiRequest) (*admin.FlexBackupRestoreJob20241113, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadFlexBackupExecute")
+	}
+
+	var r0 *admin.FlexBackupRestoreJob20241113
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.DownloadFlexBackupApiRequest) (*admin.FlexBackupRestoreJob20241113, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.DownloadFlexBackupApiRequest) *admin.FlexBackupRestoreJob20241113); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.FlexBackupRestoreJob20241113)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.DownloadFlexBackupApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.DownloadFlexBackupApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FlexSnapshotsApi_DownloadFlexBackupExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadFlexBackupExecute'
+type FlexSnapshotsApi_DownloadFlexBackupExecute_Call struct {
+	*mock.Call
+}
+
+// DownloadFlexBackupExecute is a helper method to define mock.On call
+//   - r admin.DownloadFlexBackupApiRequest
+func (_e *FlexSnapshotsApi_Expecter) DownloadFlexBackupExecute(r interface{}) *FlexSnapshotsApi_DownloadFlexBackupExecute_Call {
+	return &FlexSnapshotsApi_DownloadFlexBackupExecute_Call{Call: _e.mock.On("DownloadFlexBackupExecute", r)}
+}
+
+func (_c *FlexSnapshotsApi_DownloadFlexBackupExecute_Call) Run(run func(r admin.DownloadFlexBackupApiRequest)) *FlexSnapshotsApi_DownloadFlexBackupExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.DownloadFlexBackupApiRequest))
+	})
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_DownloadFlexBackupExecute_Call) Return(_a0 *admin.FlexBackupRestoreJob20241113, _a1 *http.Response, _a2 error) *FlexSnapshotsApi_DownloadFlexBackupExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_DownloadFlexBackupExecute_Call) RunAndReturn(run func(admin.DownloadFlexBackupApiRequest) (*admin.FlexBackupRestoreJob20241113, *http.Response, error)) *FlexSnapshotsApi_DownloadFlexBackupExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadFlexBackupWithParams provides a mock function with given fields: ctx, args
+func (_m *FlexSnapshotsApi) DownloadFlexBackupWithParams(ctx context.Context, args *admin.DownloadFlexBackupApiParams) admin.DownloadFlexBackupApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadFlexBackupWithParams")
+	}
+
+	var r0 admin.DownloadFlexBackupApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.DownloadFlexBackupApiParams) admin.DownloadFlexBackupApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.DownloadFlexBackupApiRequest)
+	}
+
+	return r0
+}
+
+// FlexSnapshotsApi_DownloadFlexBackupWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadFlexBackupWithParams'
+type FlexSnapshotsApi_DownloadFlexBackupWithParams_Call struct {
+	*mock.Call
+}
+
+// DownloadFlexBackupWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.DownloadFlexBackupApiParams
+func (_e *FlexSnapshotsApi_Expecter) DownloadFlexBackupWithParams(ctx interface{}, args interface{}) *FlexSnapshotsApi_DownloadFlexBackupWithParams_Call {
+	return &FlexSnapshotsApi_DownloadFlexBackupWithParams_Call{Call: _e.mock.On("DownloadFlexBackupWithParams", ctx, args)}
+}
+
+func (_c *FlexSnapshotsApi_DownloadFlexBackupWithParams_Call) Run(run func(ctx context.Context, args *admin.DownloadFlexBackupApiParams)) *FlexSnapshotsApi_DownloadFlexBackupWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.DownloadFlexBackupApiParams))
+	})
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_DownloadFlexBackupWithParams_Call) Return(_a0 admin.DownloadFlexBackupApiRequest) *FlexSnapshotsApi_DownloadFlexBackupWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_DownloadFlexBackupWithParams_Call) RunAndReturn(run func(context.Context, *admin.DownloadFlexBackupApiParams) admin.DownloadFlexBackupApiRequest) *FlexSnapshotsApi_DownloadFlexBackupWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlexBackup provides a mock function with given fields: ctx, groupId, name, snapshotId
+func (_m *FlexSnapshotsApi) GetFlexBackup(ctx context.Context, groupId string, name string, snapshotId string) admin.GetFlexBackupApiRequest {
+	ret := _m.Called(ctx, groupId, name, snapshotId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlexBackup")
+	}
+
+	var r0 admin.GetFlexBackupApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) admin.GetFlexBackupApiRequest); ok {
+		r0 = rf(ctx, groupId, name, snapshotId)
+	} else {
+		r0 = ret.Get(0).(admin.GetFlexBackupApiRequest)
+	}
+
+	return r0
+}
+
+// FlexSnapshotsApi_GetFlexBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlexBackup'
+type FlexSnapshotsApi_GetFlexBackup_Call struct {
+	*mock.Call
+}
+
+// GetFlexBackup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupId string
+//   - name string
+//   - snapshotId string
+func (_e *FlexSnapshotsApi_Expecter) GetFlexBackup(ctx interface{}, groupId interface{}, name interface{}, snapshotId interface{}) *FlexSnapshotsApi_GetFlexBackup_Call {
+	return &FlexSnapshotsApi_GetFlexBackup_Call{Call: _e.mock.On("GetFlexBackup", ctx, groupId, name, snapshotId)}
+}
+
+func (_c *FlexSnapshotsApi_GetFlexBackup_Call) Run(run func(ctx context.Context, groupId string, name string, snapshotId string)) *FlexSnapshotsApi_GetFlexBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_GetFlexBackup_Call) Return(_a0 admin.GetFlexBackupApiRequest) *FlexSnapshotsApi_GetFlexBackup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_GetFlexBackup_Call) RunAndReturn(run func(context.Context, string, string, string) admin.GetFlexBackupApiRequest) *FlexSnapshotsApi_GetFlexBackup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlexBackupExecute provides a mock function with given fields: r
+func (_m *FlexSnapshotsApi) GetFlexBackupExecute(r admin.GetFlexBackupApiRequest) (*admin.FlexBackupSnapshot20241113, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlexBackupExecute")
+	}
+
+	var r0 *admin.FlexBackupSnapshot20241113
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.GetFlexBackupApiRequest) (*admin.FlexBackupSnapshot20241113, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.GetFlexBackupApiRequest) *admin.FlexBackupSnapshot20241113); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.FlexBackupSnapshot20241113)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.GetFlexBackupApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.GetFlexBackupApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FlexSnapshotsApi_GetFlexBackupExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlexBackupExecute'
+type FlexSnapshotsApi_GetFlexBackupExecute_Call struct {
+	*mock.Call
+}
+
+// GetFlexBackupExecute is a helper method to define mock.On call
+//   - r admin.GetFlexBackupApiRequest
+func (_e *FlexSnapshotsApi_Expecter) GetFlexBackupExecute(r interface{}) *FlexSnapshotsApi_GetFlexBackupExecute_Call {
+	return &FlexSnapshotsApi_GetFlexBackupExecute_Call{Call: _e.mock.On("GetFlexBackupExecute", r)}
+}
+
+func (_c *FlexSnapshotsApi_GetFlexBackupExecute_Call) Run(run func(r admin.GetFlexBackupApiRequest)) *FlexSnapshotsApi_GetFlexBackupExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.GetFlexBackupApiRequest))
+	})
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_GetFlexBackupExecute_Call) Return(_a0 *admin.FlexBackupSnapshot20241113, _a1 *http.Response, _a2 error) *FlexSnapshotsApi_GetFlexBackupExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_GetFlexBackupExecute_Call) RunAndReturn(run func(admin.GetFlexBackupApiRequest) (*admin.FlexBackupSnapshot20241113, *http.Response, error)) *FlexSnapshotsApi_GetFlexBackupExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlexBackupWithParams provides a mock function with given fields: ctx, args
+func (_m *FlexSnapshotsApi) GetFlexBackupWithParams(ctx context.Context, args *admin.GetFlexBackupApiParams) admin.GetFlexBackupApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlexBackupWithParams")
+	}
+
+	var r0 admin.GetFlexBackupApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.GetFlexBackupApiParams) admin.GetFlexBackupApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.GetFlexBackupApiRequest)
+	}
+
+	return r0
+}
+
+// FlexSnapshotsApi_GetFlexBackupWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlexBackupWithParams'
+type FlexSnapshotsApi_GetFlexBackupWithParams_Call struct {
+	*mock.Call
+}
+
+// GetFlexBackupWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.GetFlexBackupApiParams
+func (_e *FlexSnapshotsApi_Expecter) GetFlexBackupWithParams(ctx interface{}, args interface{}) *FlexSnapshotsApi_GetFlexBackupWithParams_Call {
+	return &FlexSnapshotsApi_GetFlexBackupWithParams_Call{Call: _e.mock.On("GetFlexBackupWithParams", ctx, args)}
+}
+
+func (_c *FlexSnapshotsApi_GetFlexBackupWithParams_Call) Run(run func(ctx context.Context, args *admin.GetFlexBackupApiParams)) *FlexSnapshotsApi_GetFlexBackupWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.GetFlexBackupApiParams))
+	})
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_GetFlexBackupWithParams_Call) Return(_a0 admin.GetFlexBackupApiRequest) *FlexSnapshotsApi_GetFlexBackupWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_GetFlexBackupWithParams_Call) RunAndReturn(run func(context.Context, *admin.GetFlexBackupApiParams) admin.GetFlexBackupApiRequest) *FlexSnapshotsApi_GetFlexBackupWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlexBackups provides a mock function with given fields: ctx, groupId, name
+func (_m *FlexSnapshotsApi) ListFlexBackups(ctx context.Context, groupId string, name string) admin.ListFlexBackupsApiRequest {
+	ret := _m.Called(ctx, groupId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFlexBackups")
+	}
+
+	var r0 admin.ListFlexBackupsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) admin.ListFlexBackupsApiRequest); ok {
+		r0 = rf(ctx, groupId, name)
+	} else {
+		r0 = ret.Get(0).(admin.ListFlexBackupsApiRequest)
+	}
+
+	return r0
+}
+
+// FlexSnapshotsApi_ListFlexBackups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlexBackups'
+type FlexSnapshotsApi_ListFlexBackups_Call struct {
+	*mock.Call
+}
+
+// ListFlexBackups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupId string
+//   - name string
+func (_e *FlexSnapshotsApi_Expecter) ListFlexBackups(ctx interface{}, groupId interface{}, name interface{}) *FlexSnapshotsApi_ListFlexBackups_Call {
+	return &FlexSnapshotsApi_ListFlexBackups_Call{Call: _e.mock.On("ListFlexBackups", ctx, groupId, name)}
+}
+
+func (_c *FlexSnapshotsApi_ListFlexBackups_Call) Run(run func(ctx context.Context, groupId string, name string)) *FlexSnapshotsApi_ListFlexBackups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_ListFlexBackups_Call) Return(_a0 admin.ListFlexBackupsApiRequest) *FlexSnapshotsApi_ListFlexBackups_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_ListFlexBackups_Call) RunAndReturn(run func(context.Context, string, string) admin.ListFlexBackupsApiRequest) *FlexSnapshotsApi_ListFlexBackups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlexBackupsExecute provides a mock function with given fields: r
+func (_m *FlexSnapshotsApi) ListFlexBackupsExecute(r admin.ListFlexBackupsApiRequest) (*admin.PaginatedApiAtlasFlexBackupSnapshot20241113, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFlexBackupsExecute")
+	}
+
+	var r0 *admin.PaginatedApiAtlasFlexBackupSnapshot20241113
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.ListFlexBackupsApiRequest) (*admin.PaginatedApiAtlasFlexBackupSnapshot20241113, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.ListFlexBackupsApiRequest) *admin.PaginatedApiAtlasFlexBackupSnapshot20241113); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.PaginatedApiAtlasFlexBackupSnapshot20241113)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.ListFlexBackupsApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.ListFlexBackupsApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FlexSnapshotsApi_ListFlexBackupsExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlexBackupsExecute'
+type FlexSnapshotsApi_ListFlexBackupsExecute_Call struct {
+	*mock.Call
+}
+
+// ListFlexBackupsExecute is a helper method to define mock.On call
+//   - r admin.ListFlexBackupsApiRequest
+func (_e *FlexSnapshotsApi_Expecter) ListFlexBackupsExecute(r interface{}) *FlexSnapshotsApi_ListFlexBackupsExecute_Call {
+	return &FlexSnapshotsApi_ListFlexBackupsExecute_Call{Call: _e.mock.On("ListFlexBackupsExecute", r)}
+}
+
+func (_c *FlexSnapshotsApi_ListFlexBackupsExecute_Call) Run(run func(r admin.ListFlexBackupsApiRequest)) *FlexSnapshotsApi_ListFlexBackupsExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.ListFlexBackupsApiRequest))
+	})
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_ListFlexBackupsExecute_Call) Return(_a0 *admin.PaginatedApiAtlasFlexBackupSnapshot20241113, _a1 *http.Response, _a2 error) *FlexSnapshotsApi_ListFlexBackupsExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_ListFlexBackupsExecute_Call) RunAndReturn(run func(admin.ListFlexBackupsApiRequest) (*admin.PaginatedApiAtlasFlexBackupSnapshot20241113, *http.Response, error)) *FlexSnapshotsApi_ListFlexBackupsExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlexBackupsWithParams provides a mock function with given fields: ctx, args
+func (_m *FlexSnapshotsApi) ListFlexBackupsWithParams(ctx context.Context, args *admin.ListFlexBackupsApiParams) admin.ListFlexBackupsApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFlexBackupsWithParams")
+	}
+
+	var r0 admin.ListFlexBackupsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ListFlexBackupsApiParams) admin.ListFlexBackupsApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.ListFlexBackupsApiRequest)
+	}
+
+	return r0
+}
+
+// FlexSnapshotsApi_ListFlexBackupsWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlexBackupsWithParams'
+type FlexSnapshotsApi_ListFlexBackupsWithParams_Call struct {
+	*mock.Call
+}
+
+// ListFlexBackupsWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.ListFlexBackupsApiParams
+func (_e *FlexSnapshotsApi_Expecter) ListFlexBackupsWithParams(ctx interface{}, args interface{}) *FlexSnapshotsApi_ListFlexBackupsWithParams_Call {
+	return &FlexSnapshotsApi_ListFlexBackupsWithParams_Call{Call: _e.mock.On("ListFlexBackupsWithParams", ctx, args)}
+}
+
+func (_c *FlexSnapshotsApi_ListFlexBackupsWithParams_Call) Run(run func(ctx context.Context, args *admin.ListFlexBackupsApiParams)) *FlexSnapshotsApi_ListFlexBackupsWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ListFlexBackupsApiParams))
+	})
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_ListFlexBackupsWithParams_Call) Return(_a0 admin.ListFlexBackupsApiRequest) *FlexSnapshotsApi_ListFlexBackupsWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlexSnapshotsApi_ListFlexBackupsWithParams_Call) RunAndReturn(run func(context.Context, *admin.ListFlexBackupsApiParams) admin.ListFlexBackupsApiRequest) *FlexSnapshotsApi_ListFlexBackupsWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFlexSnapshotsApi creates a new instance of FlexSnapshotsApi. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFlexSnapshotsApi(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FlexSnapshotsApi {
+	mock := &FlexSnapshotsApi{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,6 +1,6 @@
 // Code generated by mockery. DO NOT EDIT.
 
-package mocksvc
+package mockadmin20231115014
 
 import (
 	context "context"
@@ -0,0 +1,2787 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockadmin20231115014
+
+import (
+	context "context"
+
+	admin "go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrganizationsApi is an autogenerated mock type for the OrganizationsApi type
+type OrganizationsApi struct {
+	mock.Mock
+}
+
+type OrganizationsApi_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrganizationsApi) EXPECT() *OrganizationsApi_Expecter {
+	return &OrganizationsApi_Expecter{mock: &_m.Mock}
+}
+
+// CreateOrganization provides a mock function with given fields: ctx, createOrganizationRequest
+func (_m *OrganizationsApi) CreateOrganization(ctx context.Context, createOrganizationRequest *admin.CreateOrganizationRequest) admin.CreateOrganizationApiRequest {
+	ret := _m.Called(ctx, createOrganizationRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 admin.CreateOrganizationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.CreateOrganizationRequest) admin.CreateOrganizationApiRequest); ok {
+		r0 = rf(ctx, createOrganizationRequest)
+	} else {
+		r0 = ret.Get(0).(admin.CreateOrganizationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type OrganizationsApi_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createOrganizationRequest *admin.CreateOrganizationRequest
+func (_e *OrganizationsApi_Expecter) CreateOrganization(ctx interface{}, createOrganizationRequest interface{}) *OrganizationsApi_CreateOrganization_Call {
+	return &OrganizationsApi_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, createOrganizationRequest)}
+}
+
+func (_c *OrganizationsApi_CreateOrganization_Call) Run(run func(ctx context.Context, createOrganizationRequest *admin.CreateOrganizationRequest)) *OrganizationsApi_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.CreateOrganizationRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganization_Call) Return(_a0 admin.CreateOrganizationApiRequest) *OrganizationsApi_CreateOrganization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganization_Call) RunAndReturn(run func(context.Context, *admin.CreateOrganizationRequest) admin.CreateOrganizationApiRequest) *OrganizationsApi_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) CreateOrganizationExecute(r admin.CreateOrganizationApiRequest) (*admin.CreateOrganizationResponse, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationExecute")
+	}
+
+	var r0 *admin.CreateOrganizationResponse
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.CreateOrganizationApiRequest) (*admin.CreateOrganizationResponse, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.CreateOrganizationApiRequest) *admin.CreateOrganizationResponse); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.CreateOrganizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.CreateOrganizationApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.CreateOrganizationApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_CreateOrganizationExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationExecute'
+type OrganizationsApi_CreateOrganizationExecute_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationExecute is a helper method to define mock.On call
+//   - r admin.CreateOrganizationApiRequest
+func (_e *OrganizationsApi_Expecter) CreateOrganizationExecute(r interface{}) *OrganizationsApi_CreateOrganizationExecute_Call {
+	return &OrganizationsApi_CreateOrganizationExecute_Call{Call: _e.mock.On("CreateOrganizationExecute", r)}
+}
+
+func (_c *OrganizationsApi_CreateOrganizationExecute_Call) Run(run func(r admin.CreateOrganizationApiRequest)) *OrganizationsApi_CreateOrganizationExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.CreateOrganizationApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganizationExecute_Call) Return(_a0 *admin.CreateOrganizationResponse, _a1 *http.Response, _a2 error) *OrganizationsApi_CreateOrganizationExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganizationExecute_Call) RunAndReturn(run func(admin.CreateOrganizationApiRequest) (*admin.CreateOrganizationResponse, *http.Response, error)) *OrganizationsApi_CreateOrganizationExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationInvitation provides a mock function with given fields: ctx, orgId, organizationInvitationRequest
+func (_m *OrganizationsApi) CreateOrganizationInvitation(ctx context.Context, orgId string, organizationInvitationRequest *admin.OrganizationInvitationRequest) admin.CreateOrganizationInvitationApiRequest {
+	ret := _m.Called(ctx, orgId, organizationInvitationRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationInvitation")
+	}
+
+	var r0 admin.CreateOrganizationInvitationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, *admin.OrganizationInvitationRequest) admin.CreateOrganizationInvitationApiRequest); ok {
+		r0 = rf(ctx, orgId, organizationInvitationRequest)
+	} else {
+		r0 = ret.Get(0).(admin.CreateOrganizationInvitationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_CreateOrganizationInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitation'
+type OrganizationsApi_CreateOrganizationInvitation_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+//   - organizationInvitationRequest *admin.OrganizationInvitationRequest
+func (_e *OrganizationsApi_Expecter) CreateOrganizationInvitation(ctx interface{}, orgId interface{}, organizationInvitationRequest interface{}) *OrganizationsApi_CreateOrganizationInvitation_Call {
+	return &OrganizationsApi_CreateOrganizationInvitation_Call{Call: _e.mock.On("CreateOrganizationInvitation", ctx, orgId, organizationInvitationRequest)}
+}
+
+func (_c *OrganizationsApi_CreateOrganizationInvitation_Call) Run(run func(ctx context.Context, orgId string, organizationInvitationRequest *admin.OrganizationInvitationRequest)) *OrganizationsApi_CreateOrganizationInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*admin.OrganizationInvitationRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganizationInvitation_Call) Return(_a0 admin.CreateOrganizationInvitationApiRequest) *OrganizationsApi_CreateOrganizationInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganizationInvitation_Call) RunAndReturn(run func(context.Context, string, *admin.OrganizationInvitationRequest) admin.CreateOrganizationInvitationApiRequest) *OrganizationsApi_CreateOrganizationInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationInvitationExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) CreateOrganizationInvitationExecute(r admin.CreateOrganizationInvitationApiRequest) (*admin.OrganizationInvitation, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationInvitationExecute")
+	}
+
+	var r0 *admin.OrganizationInvitation
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.CreateOrganizationInvitationApiRequest) (*admin.OrganizationInvitation, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.CreateOrganizationInvitationApiRequest) *admin.OrganizationInvitation); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.CreateOrganizationInvitationApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.CreateOrganizationInvitationApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_CreateOrganizationInvitationExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitationExecute'
+type OrganizationsApi_CreateOrganizationInvitationExecute_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationInvitationExecute is a helper method to define mock.On call
+//   - r admin.CreateOrganizationInvitationApiRequest
+func (_e *OrganizationsApi_Expecter) CreateOrganizationInvitationExecute(r interface{}) *OrganizationsApi_CreateOrganizationInvitationExecute_Call {
+	return &OrganizationsApi_CreateOrganizationInvitationExecute_Call{Call: _e.mock.On("CreateOrganizationInvitationExecute", r)}
+}
+
+func (_c *OrganizationsApi_CreateOrganizationInvitationExecute_Call) Run(run func(r admin.CreateOrganizationInvitationApiRequest)) *OrganizationsApi_CreateOrganizationInvitationExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.CreateOrganizationInvitationApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganizationInvitationExecute_Call) Return(_a0 *admin.OrganizationInvitation, _a1 *http.Response, _a2 error) *OrganizationsApi_CreateOrganizationInvitationExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganizationInvitationExecute_Call) RunAndReturn(run func(admin.CreateOrganizationInvitationApiRequest) (*admin.OrganizationInvitation, *http.Response, error)) *OrganizationsApi_CreateOrganizationInvitationExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationInvitationWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) CreateOrganizationInvitationWithParams(ctx context.Context, args *admin.CreateOrganizationInvitationApiParams) admin.CreateOrganizationInvitationApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationInvitationWithParams")
+	}
+
+	var r0 admin.CreateOrganizationInvitationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.CreateOrganizationInvitationApiParams) admin.CreateOrganizationInvitationApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.CreateOrganizationInvitationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_CreateOrganizationInvitationWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitationWithParams'
+type OrganizationsApi_CreateOrganizationInvitationWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationInvitationWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.CreateOrganizationInvitationApiParams
+func (_e *OrganizationsApi_Expecter) CreateOrganizationInvitationWithParams(ctx interface{}, args interface{}) *OrganizationsApi_CreateOrganizationInvitationWithParams_Call {
+	return &OrganizationsApi_CreateOrganizationInvitationWithParams_Call{Call: _e.mock.On("CreateOrganizationInvitationWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_CreateOrganizationInvitationWithParams_Call) Run(run func(ctx context.Context, args *admin.CreateOrganizationInvitationApiParams)) *OrganizationsApi_CreateOrganizationInvitationWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.CreateOrganizationInvitationApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganizationInvitationWithParams_Call) Return(_a0 admin.CreateOrganizationInvitationApiRequest) *OrganizationsApi_CreateOrganizationInvitationWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganizationInvitationWithParams_Call) RunAndReturn(run func(context.Context, *admin.CreateOrganizationInvitationApiParams) admin.CreateOrganizationInvitationApiRequest) *OrganizationsApi_CreateOrganizationInvitationWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) CreateOrganizationWithParams(ctx context.Context, args *admin.CreateOrganizationApiParams) admin.CreateOrganizationApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationWithParams")
+	}
+
+	var r0 admin.CreateOrganizationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.CreateOrganizationApiParams) admin.CreateOrganizationApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.CreateOrganizationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_CreateOrganizationWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationWithParams'
+type OrganizationsApi_CreateOrganizationWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.CreateOrganizationApiParams
+func (_e *OrganizationsApi_Expecter) CreateOrganizationWithParams(ctx interface{}, args interface{}) *OrganizationsApi_CreateOrganizationWithParams_Call {
+	return &OrganizationsApi_CreateOrganizationWithParams_Call{Call: _e.mock.On("CreateOrganizationWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_CreateOrganizationWithParams_Call) Run(run func(ctx context.Context, args *admin.CreateOrganizationApiParams)) *OrganizationsApi_CreateOrganizationWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.CreateOrganizationApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganizationWithParams_Call) Return(_a0 admin.CreateOrganizationApiRequest) *OrganizationsApi_CreateOrganizationWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_CreateOrganizationWithParams_Call) RunAndReturn(run func(context.Context, *admin.CreateOrganizationApiParams) admin.CreateOrganizationApiRequest) *OrganizationsApi_CreateOrganizationWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganization provides a mock function with given fields: ctx, orgId
+func (_m *OrganizationsApi) DeleteOrganization(ctx context.Context, orgId string) admin.DeleteOrganizationApiRequest {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganization")
+	}
+
+	var r0 admin.DeleteOrganizationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string) admin.DeleteOrganizationApiRequest); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		r0 = ret.Get(0).(admin.DeleteOrganizationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_DeleteOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganization'
+type OrganizationsApi_DeleteOrganization_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+func (_e *OrganizationsApi_Expecter) DeleteOrganization(ctx interface{}, orgId interface{}) *OrganizationsApi_DeleteOrganization_Call {
+	return &OrganizationsApi_DeleteOrganization_Call{Call: _e.mock.On("DeleteOrganization", ctx, orgId)}
+}
+
+func (_c *OrganizationsApi_DeleteOrganization_Call) Run(run func(ctx context.Context, orgId string)) *OrganizationsApi_DeleteOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganization_Call) Return(_a0 admin.DeleteOrganizationApiRequest) *OrganizationsApi_DeleteOrganization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganization_Call) RunAndReturn(run func(context.Context, string) admin.DeleteOrganizationApiRequest) *OrganizationsApi_DeleteOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganizationExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) DeleteOrganizationExecute(r admin.DeleteOrganizationApiRequest) (map[string]interface{}, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationExecute")
+	}
+
+	var r0 map[string]interface{}
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.DeleteOrganizationApiRequest) (map[string]interface{}, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.DeleteOrganizationApiRequest) map[string]interface{}); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.DeleteOrganizationApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.DeleteOrganizationApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_DeleteOrganizationExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationExecute'
+type OrganizationsApi_DeleteOrganizationExecute_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationExecute is a helper method to define mock.On call
+//   - r admin.DeleteOrganizationApiRequest
+func (_e *OrganizationsApi_Expecter) DeleteOrganizationExecute(r interface{}) *OrganizationsApi_DeleteOrganizationExecute_Call {
+	return &OrganizationsApi_DeleteOrganizationExecute_Call{Call: _e.mock.On("DeleteOrganizationExecute", r)}
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationExecute_Call) Run(run func(r admin.DeleteOrganizationApiRequest)) *OrganizationsApi_DeleteOrganizationExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.DeleteOrganizationApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationExecute_Call) Return(_a0 map[string]interface{}, _a1 *http.Response, _a2 error) *OrganizationsApi_DeleteOrganizationExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationExecute_Call) RunAndReturn(run func(admin.DeleteOrganizationApiRequest) (map[string]interface{}, *http.Response, error)) *OrganizationsApi_DeleteOrganizationExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganizationInvitation provides a mock function with given fields: ctx, orgId, invitationId
+func (_m *OrganizationsApi) DeleteOrganizationInvitation(ctx context.Context, orgId string, invitationId string) admin.DeleteOrganizationInvitationApiRequest {
+	ret := _m.Called(ctx, orgId, invitationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationInvitation")
+	}
+
+	var r0 admin.DeleteOrganizationInvitationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) admin.DeleteOrganizationInvitationApiRequest); ok {
+		r0 = rf(ctx, orgId, invitationId)
+	} else {
+		r0 = ret.Get(0).(admin.DeleteOrganizationInvitationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_DeleteOrganizationInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationInvitation'
+type OrganizationsApi_DeleteOrganizationInvitation_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+//   - invitationId string
+func (_e *OrganizationsApi_Expecter) DeleteOrganizationInvitation(ctx interface{}, orgId interface{}, invitationId interface{}) *OrganizationsApi_DeleteOrganizationInvitation_Call {
+	return &OrganizationsApi_DeleteOrganizationInvitation_Call{Call: _e.mock.On("DeleteOrganizationInvitation", ctx, orgId, invitationId)}
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationInvitation_Call) Run(run func(ctx context.Context, orgId string, invitationId string)) *OrganizationsApi_DeleteOrganizationInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationInvitation_Call) Return(_a0 admin.DeleteOrganizationInvitationApiRequest) *OrganizationsApi_DeleteOrganizationInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationInvitation_Call) RunAndReturn(run func(context.Context, string, string) admin.DeleteOrganizationInvitationApiRequest) *OrganizationsApi_DeleteOrganizationInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganizationInvitationExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) DeleteOrganizationInvitationExecute(r admin.DeleteOrganizationInvitationApiRequest) (map[string]interface{}, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationInvitationExecute")
+	}
+
+	var r0 map[string]interface{}
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.DeleteOrganizationInvitationApiRequest) (map[string]interface{}, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.DeleteOrganizationInvitationApiRequest) map[string]interface{}); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.DeleteOrganizationInvitationApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.DeleteOrganizationInvitationApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_DeleteOrganizationInvitationExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationInvitationExecute'
+type OrganizationsApi_DeleteOrganizationInvitationExecute_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationInvitationExecute is a helper method to define mock.On call
+//   - r admin.DeleteOrganizationInvitationApiRequest
+func (_e *OrganizationsApi_Expecter) DeleteOrganizationInvitationExecute(r interface{}) *OrganizationsApi_DeleteOrganizationInvitationExecute_Call {
+	return &OrganizationsApi_DeleteOrganizationInvitationExecute_Call{Call: _e.mock.On("DeleteOrganizationInvitationExecute", r)}
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationInvitationExecute_Call) Run(run func(r admin.DeleteOrganizationInvitationApiRequest)) *OrganizationsApi_DeleteOrganizationInvitationExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.DeleteOrganizationInvitationApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationInvitationExecute_Call) Return(_a0 map[string]interface{}, _a1 *http.Response, _a2 error) *OrganizationsApi_DeleteOrganizationInvitationExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationInvitationExecute_Call) RunAndReturn(run func(admin.DeleteOrganizationInvitationApiRequest) (map[string]interface{}, *http.Response, error)) *OrganizationsApi_DeleteOrganizationInvitationExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganizationInvitationWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) DeleteOrganizationInvitationWithParams(ctx context.Context, args *admin.DeleteOrganizationInvitationApiParams) admin.DeleteOrganizationInvitationApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationInvitationWithParams")
+	}
+
+	var r0 admin.DeleteOrganizationInvitationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.DeleteOrganizationInvitationApiParams) admin.DeleteOrganizationInvitationApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.DeleteOrganizationInvitationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_DeleteOrganizationInvitationWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationInvitationWithParams'
+type OrganizationsApi_DeleteOrganizationInvitationWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationInvitationWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.DeleteOrganizationInvitationApiParams
+func (_e *OrganizationsApi_Expecter) DeleteOrganizationInvitationWithParams(ctx interface{}, args interface{}) *OrganizationsApi_DeleteOrganizationInvitationWithParams_Call {
+	return &OrganizationsApi_DeleteOrganizationInvitationWithParams_Call{Call: _e.mock.On("DeleteOrganizationInvitationWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationInvitationWithParams_Call) Run(run func(ctx context.Context, args *admin.DeleteOrganizationInvitationApiParams)) *OrganizationsApi_DeleteOrganizationInvitationWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.DeleteOrganizationInvitationApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationInvitationWithParams_Call) Return(_a0 admin.DeleteOrganizationInvitationApiRequest) *OrganizationsApi_DeleteOrganizationInvitationWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationInvitationWithParams_Call) RunAndReturn(run func(context.Context, *admin.DeleteOrganizationInvitationApiParams) admin.DeleteOrganizationInvitationApiRequest) *OrganizationsApi_DeleteOrganizationInvitationWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganizationWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) DeleteOrganizationWithParams(ctx context.Context, args *admin.DeleteOrganizationApiParams) admin.DeleteOrganizationApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationWithParams")
+	}
+
+	var r0 admin.DeleteOrganizationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.DeleteOrganizationApiParams) admin.DeleteOrganizationApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.DeleteOrganizationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_DeleteOrganizationWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationWithParams'
+type OrganizationsApi_DeleteOrganizationWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.DeleteOrganizationApiParams
+func (_e *OrganizationsApi_Expecter) DeleteOrganizationWithParams(ctx interface{}, args interface{}) *OrganizationsApi_DeleteOrganizationWithParams_Call {
+	return &OrganizationsApi_DeleteOrganizationWithParams_Call{Call: _e.mock.On("DeleteOrganizationWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationWithParams_Call) Run(run func(ctx context.Context, args *admin.DeleteOrganizationApiParams)) *OrganizationsApi_DeleteOrganizationWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.DeleteOrganizationApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationWithParams_Call) Return(_a0 admin.DeleteOrganizationApiRequest) *OrganizationsApi_DeleteOrganizationWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteOrganizationWithParams_Call) RunAndReturn(run func(context.Context, *admin.DeleteOrganizationApiParams) admin.DeleteOrganizationApiRequest) *OrganizationsApi_DeleteOrganizationWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganization provides a mock function with given fields: ctx, orgId
+func (_m *OrganizationsApi) GetOrganization(ctx context.Context, orgId string) admin.GetOrganizationApiRequest {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganization")
+	}
+
+	var r0 admin.GetOrganizationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string) admin.GetOrganizationApiRequest); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		r0 = ret.Get(0).(admin.GetOrganizationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_GetOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganization'
+type OrganizationsApi_GetOrganization_Call struct {
+	*mock.Call
+}
+
+// GetOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+func (_e *OrganizationsApi_Expecter) GetOrganization(ctx interface{}, orgId interface{}) *OrganizationsApi_GetOrganization_Call {
+	return &OrganizationsApi_GetOrganization_Call{Call: _e.mock.On("GetOrganization", ctx, orgId)}
+}
+
+func (_c *OrganizationsApi_GetOrganization_Call) Run(run func(ctx context.Context, orgId string)) *OrganizationsApi_GetOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganization_Call) Return(_a0 admin.GetOrganizationApiRequest) *OrganizationsApi_GetOrganization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganization_Call) RunAndReturn(run func(context.Context, string) admin.GetOrganizationApiRequest) *OrganizationsApi_GetOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) GetOrganizationExecute(r admin.GetOrganizationApiRequest) (*admin.AtlasOrganization, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationExecute")
+	}
+
+	var r0 *admin.AtlasOrganization
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.GetOrganizationApiRequest) (*admin.AtlasOrganization, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.GetOrganizationApiRequest) *admin.AtlasOrganization); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.AtlasOrganization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.GetOrganizationApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.GetOrganizationApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_GetOrganizationExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationExecute'
+type OrganizationsApi_GetOrganizationExecute_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationExecute is a helper method to define mock.On call
+//   - r admin.GetOrganizationApiRequest
+func (_e *OrganizationsApi_Expecter) GetOrganizationExecute(r interface{}) *OrganizationsApi_GetOrganizationExecute_Call {
+	return &OrganizationsApi_GetOrganizationExecute_Call{Call: _e.mock.On("GetOrganizationExecute", r)}
+}
+
+func (_c *OrganizationsApi_GetOrganizationExecute_Call) Run(run func(r admin.GetOrganizationApiRequest)) *OrganizationsApi_GetOrganizationExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.GetOrganizationApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationExecute_Call) Return(_a0 *admin.AtlasOrganization, _a1 *http.Response, _a2 error) *OrganizationsApi_GetOrganizationExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationExecute_Call) RunAndReturn(run func(admin.GetOrganizationApiRequest) (*admin.AtlasOrganization, *http.Response, error)) *OrganizationsApi_GetOrganizationExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitation provides a mock function with given fields: ctx, orgId, invitationId
+func (_m *OrganizationsApi) GetOrganizationInvitation(ctx context.Context, orgId string, invitationId string) admin.GetOrganizationInvitationApiRequest {
+	ret := _m.Called(ctx, orgId, invitationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitation")
+	}
+
+	var r0 admin.GetOrganizationInvitationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) admin.GetOrganizationInvitationApiRequest); ok {
+		r0 = rf(ctx, orgId, invitationId)
+	} else {
+		r0 = ret.Get(0).(admin.GetOrganizationInvitationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_GetOrganizationInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitation'
+type OrganizationsApi_GetOrganizationInvitation_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+//   - invitationId string
+func (_e *OrganizationsApi_Expecter) GetOrganizationInvitation(ctx interface{}, orgId interface{}, invitationId interface{}) *OrganizationsApi_GetOrganizationInvitation_Call {
+	return &OrganizationsApi_GetOrganizationInvitation_Call{Call: _e.mock.On("GetOrganizationInvitation", ctx, orgId, invitationId)}
+}
+
+func (_c *OrganizationsApi_GetOrganizationInvitation_Call) Run(run func(ctx context.Context, orgId string, invitationId string)) *OrganizationsApi_GetOrganizationInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationInvitation_Call) Return(_a0 admin.GetOrganizationInvitationApiRequest) *OrganizationsApi_GetOrganizationInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationInvitation_Call) RunAndReturn(run func(context.Context, string, string) admin.GetOrganizationInvitationApiRequest) *OrganizationsApi_GetOrganizationInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) GetOrganizationInvitationExecute(r admin.GetOrganizationInvitationApiRequest) (*admin.OrganizationInvitation, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationExecute")
+	}
+
+	var r0 *admin.OrganizationInvitation
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.GetOrganizationInvitationApiRequest) (*admin.OrganizationInvitation, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.GetOrganizationInvitationApiRequest) *admin.OrganizationInvitation); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.GetOrganizationInvitationApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.GetOrganizationInvitationApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_GetOrganizationInvitationExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationExecute'
+type OrganizationsApi_GetOrganizationInvitationExecute_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationExecute is a helper method to define mock.On call
+//   - r admin.GetOrganizationInvitationApiRequest
+func (_e *OrganizationsApi_Expecter) GetOrganizationInvitationExecute(r interface{}) *OrganizationsApi_GetOrganizationInvitationExecute_Call {
+	return &OrganizationsApi_GetOrganizationInvitationExecute_Call{Call: _e.mock.On("GetOrganizationInvitationExecute", r)}
+}
+
+func (_c *OrganizationsApi_GetOrganizationInvitationExecute_Call) Run(run func(r admin.GetOrganizationInvitationApiRequest)) *OrganizationsApi_GetOrganizationInvitationExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.GetOrganizationInvitationApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationInvitationExecute_Call) Return(_a0 *admin.OrganizationInvitation, _a1 *http.Response, _a2 error) *OrganizationsApi_GetOrganizationInvitationExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationInvitationExecute_Call) RunAndReturn(run func(admin.GetOrganizationInvitationApiRequest) (*admin.OrganizationInvitation, *http.Response, error)) *OrganizationsApi_GetOrganizationInvitationExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) GetOrganizationInvitationWithParams(ctx context.Context, args *admin.GetOrganizationInvitationApiParams) admin.GetOrganizationInvitationApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationWithParams")
+	}
+
+	var r0 admin.GetOrganizationInvitationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.GetOrganizationInvitationApiParams) admin.GetOrganizationInvitationApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.GetOrganizationInvitationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_GetOrganizationInvitationWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationWithParams'
+type OrganizationsApi_GetOrganizationInvitationWithParams_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.GetOrganizationInvitationApiParams
+func (_e *OrganizationsApi_Expecter) GetOrganizationInvitationWithParams(ctx interface{}, args interface{}) *OrganizationsApi_GetOrganizationInvitationWithParams_Call {
+	return &OrganizationsApi_GetOrganizationInvitationWithParams_Call{Call: _e.mock.On("GetOrganizationInvitationWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_GetOrganizationInvitationWithParams_Call) Run(run func(ctx context.Context, args *admin.GetOrganizationInvitationApiParams)) *OrganizationsApi_GetOrganizationInvitationWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.GetOrganizationInvitationApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationInvitationWithParams_Call) Return(_a0 admin.GetOrganizationInvitationApiRequest) *OrganizationsApi_GetOrganizationInvitationWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationInvitationWithParams_Call) RunAndReturn(run func(context.Context, *admin.GetOrganizationInvitationApiParams) admin.GetOrganizationInvitationApiRequest) *OrganizationsApi_GetOrganizationInvitationWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationSettings provides a mock function with given fields: ctx, orgId
+func (_m *OrganizationsApi) GetOrganizationSettings(ctx context.Context, orgId string) admin.GetOrganizationSettingsApiRequest {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationSettings")
+	}
+
+	var r0 admin.GetOrganizationSettingsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string) admin.GetOrganizationSettingsApiRequest); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		r0 = ret.Get(0).(admin.GetOrganizationSettingsApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_GetOrganizationSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationSettings'
+type OrganizationsApi_GetOrganizationSettings_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+func (_e *OrganizationsApi_Expecter) GetOrganizationSettings(ctx interface{}, orgId interface{}) *OrganizationsApi_GetOrganizationSettings_Call {
+	return &OrganizationsApi_GetOrganizationSettings_Call{Call: _e.mock.On("GetOrganizationSettings", ctx, orgId)}
+}
+
+func (_c *OrganizationsApi_GetOrganizationSettings_Call) Run(run func(ctx context.Context, orgId string)) *OrganizationsApi_GetOrganizationSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationSettings_Call) Return(_a0 admin.GetOrganizationSettingsApiRequest) *OrganizationsApi_GetOrganizationSettings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationSettings_Call) RunAndReturn(run func(context.Context, string) admin.GetOrganizationSettingsApiRequest) *OrganizationsApi_GetOrganizationSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationSettingsExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) GetOrganizationSettingsExecute(r admin.GetOrganizationSettingsApiRequest) (*admin.OrganizationSettings, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationSettingsExecute")
+	}
+
+	var r0 *admin.OrganizationSettings
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.GetOrganizationSettingsApiRequest) (*admin.OrganizationSettings, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.GetOrganizationSettingsApiRequest) *admin.OrganizationSettings); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.OrganizationSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.GetOrganizationSettingsApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.GetOrganizationSettingsApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_GetOrganizationSettingsExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationSettingsExecute'
+type OrganizationsApi_GetOrganizationSettingsExecute_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationSettingsExecute is a helper method to define mock.On call
+//   - r admin.GetOrganizationSettingsApiRequest
+func (_e *OrganizationsApi_Expecter) GetOrganizationSettingsExecute(r interface{}) *OrganizationsApi_GetOrganizationSettingsExecute_Call {
+	return &OrganizationsApi_GetOrganizationSettingsExecute_Call{Call: _e.mock.On("GetOrganizationSettingsExecute", r)}
+}
+
+func (_c *OrganizationsApi_GetOrganizationSettingsExecute_Call) Run(run func(r admin.GetOrganizationSettingsApiRequest)) *OrganizationsApi_GetOrganizationSettingsExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.GetOrganizationSettingsApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationSettingsExecute_Call) Return(_a0 *admin.OrganizationSettings, _a1 *http.Response, _a2 error) *OrganizationsApi_GetOrganizationSettingsExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationSettingsExecute_Call) RunAndReturn(run func(admin.GetOrganizationSettingsApiRequest) (*admin.OrganizationSettings, *http.Response, error)) *OrganizationsApi_GetOrganizationSettingsExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationSettingsWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) GetOrganizationSettingsWithParams(ctx context.Context, args *admin.GetOrganizationSettingsApiParams) admin.GetOrganizationSettingsApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationSettingsWithParams")
+	}
+
+	var r0 admin.GetOrganizationSettingsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.GetOrganizationSettingsApiParams) admin.GetOrganizationSettingsApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.GetOrganizationSettingsApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_GetOrganizationSettingsWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationSettingsWithParams'
+type OrganizationsApi_GetOrganizationSettingsWithParams_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationSettingsWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.GetOrganizationSettingsApiParams
+func (_e *OrganizationsApi_Expecter) GetOrganizationSettingsWithParams(ctx interface{}, args interface{}) *OrganizationsApi_GetOrganizationSettingsWithParams_Call {
+	return &OrganizationsApi_GetOrganizationSettingsWithParams_Call{Call: _e.mock.On("GetOrganizationSettingsWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_GetOrganizationSettingsWithParams_Call) Run(run func(ctx context.Context, args *admin.GetOrganizationSettingsApiParams)) *OrganizationsApi_GetOrganizationSettingsWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.GetOrganizationSettingsApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationSettingsWithParams_Call) Return(_a0 admin.GetOrganizationSettingsApiRequest) *OrganizationsApi_GetOrganizationSettingsWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationSettingsWithParams_Call) RunAndReturn(run func(context.Context, *admin.GetOrganizationSettingsApiParams) admin.GetOrganizationSettingsApiRequest) *OrganizationsApi_GetOrganizationSettingsWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) GetOrganizationWithParams(ctx context.Context, args *admin.GetOrganizationApiParams) admin.GetOrganizationApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationWithParams")
+	}
+
+	var r0 admin.GetOrganizationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.GetOrganizationApiParams) admin.GetOrganizationApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.GetOrganizationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_GetOrganizationWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationWithParams'
+type OrganizationsApi_GetOrganizationWithParams_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.GetOrganizationApiParams
+func (_e *OrganizationsApi_Expecter) GetOrganizationWithParams(ctx interface{}, args interface{}) *OrganizationsApi_GetOrganizationWithParams_Call {
+	return &OrganizationsApi_GetOrganizationWithParams_Call{Call: _e.mock.On("GetOrganizationWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_GetOrganizationWithParams_Call) Run(run func(ctx context.Context, args *admin.GetOrganizationApiParams)) *OrganizationsApi_GetOrganizationWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.GetOrganizationApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationWithParams_Call) Return(_a0 admin.GetOrganizationApiRequest) *OrganizationsApi_GetOrganizationWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_GetOrganizationWithParams_Call) RunAndReturn(run func(context.Context, *admin.GetOrganizationApiParams) admin.GetOrganizationApiRequest) *OrganizationsApi_GetOrganizationWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationInvitations provides a mock function with given fields: ctx, orgId
+func (_m *OrganizationsApi) ListOrganizationInvitations(ctx context.Context, orgId string) admin.ListOrganizationInvitationsApiRequest {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationInvitations")
+	}
+
+	var r0 admin.ListOrganizationInvitationsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string) admin.ListOrganizationInvitationsApiRequest); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		r0 = ret.Get(0).(admin.ListOrganizationInvitationsApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_ListOrganizationInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationInvitations'
+type OrganizationsApi_ListOrganizationInvitations_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+func (_e *OrganizationsApi_Expecter) ListOrganizationInvitations(ctx interface{}, orgId interface{}) *OrganizationsApi_ListOrganizationInvitations_Call {
+	return &OrganizationsApi_ListOrganizationInvitations_Call{Call: _e.mock.On("ListOrganizationInvitations", ctx, orgId)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationInvitations_Call) Run(run func(ctx context.Context, orgId string)) *OrganizationsApi_ListOrganizationInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationInvitations_Call) Return(_a0 admin.ListOrganizationInvitationsApiRequest) *OrganizationsApi_ListOrganizationInvitations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationInvitations_Call) RunAndReturn(run func(context.Context, string) admin.ListOrganizationInvitationsApiRequest) *OrganizationsApi_ListOrganizationInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationInvitationsExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) ListOrganizationInvitationsExecute(r admin.ListOrganizationInvitationsApiRequest) ([]admin.OrganizationInvitation, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationInvitationsExecute")
+	}
+
+	var r0 []admin.OrganizationInvitation
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.ListOrganizationInvitationsApiRequest) ([]admin.OrganizationInvitation, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.ListOrganizationInvitationsApiRequest) []admin.OrganizationInvitation); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]admin.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.ListOrganizationInvitationsApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.ListOrganizationInvitationsApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_ListOrganizationInvitationsExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationInvitationsExecute'
+type OrganizationsApi_ListOrganizationInvitationsExecute_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationInvitationsExecute is a helper method to define mock.On call
+//   - r admin.ListOrganizationInvitationsApiRequest
+func (_e *OrganizationsApi_Expecter) ListOrganizationInvitationsExecute(r interface{}) *OrganizationsApi_ListOrganizationInvitationsExecute_Call {
+	return &OrganizationsApi_ListOrganizationInvitationsExecute_Call{Call: _e.mock.On("ListOrganizationInvitationsExecute", r)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationInvitationsExecute_Call) Run(run func(r admin.ListOrganizationInvitationsApiRequest)) *OrganizationsApi_ListOrganizationInvitationsExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.ListOrganizationInvitationsApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationInvitationsExecute_Call) Return(_a0 []admin.OrganizationInvitation, _a1 *http.Response, _a2 error) *OrganizationsApi_ListOrganizationInvitationsExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationInvitationsExecute_Call) RunAndReturn(run func(admin.ListOrganizationInvitationsApiRequest) ([]admin.OrganizationInvitation, *http.Response, error)) *OrganizationsApi_ListOrganizationInvitationsExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationInvitationsWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) ListOrganizationInvitationsWithParams(ctx context.Context, args *admin.ListOrganizationInvitationsApiParams) admin.ListOrganizationInvitationsApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationInvitationsWithParams")
+	}
+
+	var r0 admin.ListOrganizationInvitationsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ListOrganizationInvitationsApiParams) admin.ListOrganizationInvitationsApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.ListOrganizationInvitationsApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_ListOrganizationInvitationsWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationInvitationsWithParams'
+type OrganizationsApi_ListOrganizationInvitationsWithParams_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationInvitationsWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.ListOrganizationInvitationsApiParams
+func (_e *OrganizationsApi_Expecter) ListOrganizationInvitationsWithParams(ctx interface{}, args interface{}) *OrganizationsApi_ListOrganizationInvitationsWithParams_Call {
+	return &OrganizationsApi_ListOrganizationInvitationsWithParams_Call{Call: _e.mock.On("ListOrganizationInvitationsWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationInvitationsWithParams_Call) Run(run func(ctx context.Context, args *admin.ListOrganizationInvitationsApiParams)) *OrganizationsApi_ListOrganizationInvitationsWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ListOrganizationInvitationsApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationInvitationsWithParams_Call) Return(_a0 admin.ListOrganizationInvitationsApiRequest) *OrganizationsApi_ListOrganizationInvitationsWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationInvitationsWithParams_Call) RunAndReturn(run func(context.Context, *admin.ListOrganizationInvitationsApiParams) admin.ListOrganizationInvitationsApiRequest) *OrganizationsApi_ListOrganizationInvitationsWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationProjects provides a mock function with given fields: ctx, orgId
+func (_m *OrganizationsApi) ListOrganizationProjects(ctx context.Context, orgId string) admin.ListOrganizationProjectsApiRequest {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationProjects")
+	}
+
+	var r0 admin.ListOrganizationProjectsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string) admin.ListOrganizationProjectsApiRequest); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		r0 = ret.Get(0).(admin.ListOrganizationProjectsApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_ListOrganizationProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationProjects'
+type OrganizationsApi_ListOrganizationProjects_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationProjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+func (_e *OrganizationsApi_Expecter) ListOrganizationProjects(ctx interface{}, orgId interface{}) *OrganizationsApi_ListOrganizationProjects_Call {
+	return &OrganizationsApi_ListOrganizationProjects_Call{Call: _e.mock.On("ListOrganizationProjects", ctx, orgId)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationProjects_Call) Run(run func(ctx context.Context, orgId string)) *OrganizationsApi_ListOrganizationProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationProjects_Call) Return(_a0 admin.ListOrganizationProjectsApiRequest) *OrganizationsApi_ListOrganizationProjects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationProjects_Call) RunAndReturn(run func(context.Context, string) admin.ListOrganizationProjectsApiRequest) *OrganizationsApi_ListOrganizationProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationProjectsExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) ListOrganizationProjectsExecute(r admin.ListOrganizationProjectsApiRequest) (*admin.PaginatedAtlasGroup, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationProjectsExecute")
+	}
+
+	var r0 *admin.PaginatedAtlasGroup
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.ListOrganizationProjectsApiRequest) (*admin.PaginatedAtlasGroup, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.ListOrganizationProjectsApiRequest) *admin.PaginatedAtlasGroup); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.PaginatedAtlasGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.ListOrganizationProjectsApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.ListOrganizationProjectsApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_ListOrganizationProjectsExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationProjectsExecute'
+type OrganizationsApi_ListOrganizationProjectsExecute_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationProjectsExecute is a helper method to define mock.On call
+//   - r admin.ListOrganizationProjectsApiRequest
+func (_e *OrganizationsApi_Expecter) ListOrganizationProjectsExecute(r interface{}) *OrganizationsApi_ListOrganizationProjectsExecute_Call {
+	return &OrganizationsApi_ListOrganizationProjectsExecute_Call{Call: _e.mock.On("ListOrganizationProjectsExecute", r)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationProjectsExecute_Call) Run(run func(r admin.ListOrganizationProjectsApiRequest)) *OrganizationsApi_ListOrganizationProjectsExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.ListOrganizationProjectsApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationProjectsExecute_Call) Return(_a0 *admin.PaginatedAtlasGroup, _a1 *http.Response, _a2 error) *OrganizationsApi_ListOrganizationProjectsExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationProjectsExecute_Call) RunAndReturn(run func(admin.ListOrganizationProjectsApiRequest) (*admin.PaginatedAtlasGroup, *http.Response, error)) *OrganizationsApi_ListOrganizationProjectsExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationProjectsWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) ListOrganizationProjectsWithParams(ctx context.Context, args *admin.ListOrganizationProjectsApiParams) admin.ListOrganizationProjectsApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationProjectsWithParams")
+	}
+
+	var r0 admin.ListOrganizationProjectsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ListOrganizationProjectsApiParams) admin.ListOrganizationProjectsApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.ListOrganizationProjectsApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_ListOrganizationProjectsWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationProjectsWithParams'
+type OrganizationsApi_ListOrganizationProjectsWithParams_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationProjectsWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.ListOrganizationProjectsApiParams
+func (_e *OrganizationsApi_Expecter) ListOrganizationProjectsWithParams(ctx interface{}, args interface{}) *OrganizationsApi_ListOrganizationProjectsWithParams_Call {
+	return &OrganizationsApi_ListOrganizationProjectsWithParams_Call{Call: _e.mock.On("ListOrganizationProjectsWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationProjectsWithParams_Call) Run(run func(ctx context.Context, args *admin.ListOrganizationProjectsApiParams)) *OrganizationsApi_ListOrganizationProjectsWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ListOrganizationProjectsApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationProjectsWithParams_Call) Return(_a0 admin.ListOrganizationProjectsApiRequest) *OrganizationsApi_ListOrganizationProjectsWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationProjectsWithParams_Call) RunAndReturn(run func(context.Context, *admin.ListOrganizationProjectsApiParams) admin.ListOrganizationProjectsApiRequest) *OrganizationsApi_ListOrganizationProjectsWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationUsers provides a mock function with given fields: ctx, orgId
+func (_m *OrganizationsApi) ListOrganizationUsers(ctx context.Context, orgId string) admin.ListOrganizationUsersApiRequest {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationUsers")
+	}
+
+	var r0 admin.ListOrganizationUsersApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string) admin.ListOrganizationUsersApiRequest); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		r0 = ret.Get(0).(admin.ListOrganizationUsersApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_ListOrganizationUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationUsers'
+type OrganizationsApi_ListOrganizationUsers_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+func (_e *OrganizationsApi_Expecter) ListOrganizationUsers(ctx interface{}, orgId interface{}) *OrganizationsApi_ListOrganizationUsers_Call {
+	return &OrganizationsApi_ListOrganizationUsers_Call{Call: _e.mock.On("ListOrganizationUsers", ctx, orgId)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationUsers_Call) Run(run func(ctx context.Context, orgId string)) *OrganizationsApi_ListOrganizationUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationUsers_Call) Return(_a0 admin.ListOrganizationUsersApiRequest) *OrganizationsApi_ListOrganizationUsers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationUsers_Call) RunAndReturn(run func(context.Context, string) admin.ListOrganizationUsersApiRequest) *OrganizationsApi_ListOrganizationUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationUsersExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) ListOrganizationUsersExecute(r admin.ListOrganizationUsersApiRequest) (*admin.PaginatedAppUser, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationUsersExecute")
+	}
+
+	var r0 *admin.PaginatedAppUser
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.ListOrganizationUsersApiRequest) (*admin.PaginatedAppUser, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.ListOrganizationUsersApiRequest) *admin.PaginatedAppUser); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.PaginatedAppUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.ListOrganizationUsersApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.ListOrganizationUsersApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_ListOrganizationUsersExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationUsersExecute'
+type OrganizationsApi_ListOrganizationUsersExecute_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationUsersExecute is a helper method to define mock.On call
+//   - r admin.ListOrganizationUsersApiRequest
+func (_e *OrganizationsApi_Expecter) ListOrganizationUsersExecute(r interface{}) *OrganizationsApi_ListOrganizationUsersExecute_Call {
+	return &OrganizationsApi_ListOrganizationUsersExecute_Call{Call: _e.mock.On("ListOrganizationUsersExecute", r)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationUsersExecute_Call) Run(run func(r admin.ListOrganizationUsersApiRequest)) *OrganizationsApi_ListOrganizationUsersExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.ListOrganizationUsersApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationUsersExecute_Call) Return(_a0 *admin.PaginatedAppUser, _a1 *http.Response, _a2 error) *OrganizationsApi_ListOrganizationUsersExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationUsersExecute_Call) RunAndReturn(run func(admin.ListOrganizationUsersApiRequest) (*admin.PaginatedAppUser, *http.Response, error)) *OrganizationsApi_ListOrganizationUsersExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationUsersWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) ListOrganizationUsersWithParams(ctx context.Context, args *admin.ListOrganizationUsersApiParams) admin.ListOrganizationUsersApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationUsersWithParams")
+	}
+
+	var r0 admin.ListOrganizationUsersApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ListOrganizationUsersApiParams) admin.ListOrganizationUsersApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.ListOrganizationUsersApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_ListOrganizationUsersWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationUsersWithParams'
+type OrganizationsApi_ListOrganizationUsersWithParams_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationUsersWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.ListOrganizationUsersApiParams
+func (_e *OrganizationsApi_Expecter) ListOrganizationUsersWithParams(ctx interface{}, args interface{}) *OrganizationsApi_ListOrganizationUsersWithParams_Call {
+	return &OrganizationsApi_ListOrganizationUsersWithParams_Call{Call: _e.mock.On("ListOrganizationUsersWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationUsersWithParams_Call) Run(run func(ctx context.Context, args *admin.ListOrganizationUsersApiParams)) *OrganizationsApi_ListOrganizationUsersWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ListOrganizationUsersApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationUsersWithParams_Call) Return(_a0 admin.ListOrganizationUsersApiRequest) *OrganizationsApi_ListOrganizationUsersWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationUsersWithParams_Call) RunAndReturn(run func(context.Context, *admin.ListOrganizationUsersApiParams) admin.ListOrganizationUsersApiRequest) *OrganizationsApi_ListOrganizationUsersWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizations provides a mock function with given fields: ctx
+func (_m *OrganizationsApi) ListOrganizations(ctx context.Context) admin.ListOrganizationsApiRequest {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizations")
+	}
+
+	var r0 admin.ListOrganizationsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context) admin.ListOrganizationsApiRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(admin.ListOrganizationsApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_ListOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizations'
+type OrganizationsApi_ListOrganizations_Call struct {
+	*mock.Call
+}
+
+// ListOrganizations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrganizationsApi_Expecter) ListOrganizations(ctx interface{}) *OrganizationsApi_ListOrganizations_Call {
+	return &OrganizationsApi_ListOrganizations_Call{Call: _e.mock.On("ListOrganizations", ctx)}
+}
+
+func (_c *OrganizationsApi_ListOrganizations_Call) Run(run func(ctx context.Context)) *OrganizationsApi_ListOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizations_Call) Return(_a0 admin.ListOrganizationsApiRequest) *OrganizationsApi_ListOrganizations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizations_Call) RunAndReturn(run func(context.Context) admin.ListOrganizationsApiRequest) *OrganizationsApi_ListOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationsExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) ListOrganizationsExecute(r admin.ListOrganizationsApiRequest) (*admin.PaginatedOrganization, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationsExecute")
+	}
+
+	var r0 *admin.PaginatedOrganization
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.ListOrganizationsApiRequest) (*admin.PaginatedOrganization, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.ListOrganizationsApiRequest) *admin.PaginatedOrganization); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.PaginatedOrganization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.ListOrganizationsApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.ListOrganizationsApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_ListOrganizationsExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationsExecute'
+type OrganizationsApi_ListOrganizationsExecute_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationsExecute is a helper method to define mock.On call
+//   - r admin.ListOrganizationsApiRequest
+func (_e *OrganizationsApi_Expecter) ListOrganizationsExecute(r interface{}) *OrganizationsApi_ListOrganizationsExecute_Call {
+	return &OrganizationsApi_ListOrganizationsExecute_Call{Call: _e.mock.On("ListOrganizationsExecute", r)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationsExecute_Call) Run(run func(r admin.ListOrganizationsApiRequest)) *OrganizationsApi_ListOrganizationsExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.ListOrganizationsApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationsExecute_Call) Return(_a0 *admin.PaginatedOrganization, _a1 *http.Response, _a2 error) *OrganizationsApi_ListOrganizationsExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationsExecute_Call) RunAndReturn(run func(admin.ListOrganizationsApiRequest) (*admin.PaginatedOrganization, *http.Response, error)) *OrganizationsApi_ListOrganizationsExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationsWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) ListOrganizationsWithParams(ctx context.Context, args *admin.ListOrganizationsApiParams) admin.ListOrganizationsApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationsWithParams")
+	}
+
+	var r0 admin.ListOrganizationsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ListOrganizationsApiParams) admin.ListOrganizationsApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.ListOrganizationsApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_ListOrganizationsWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationsWithParams'
+type OrganizationsApi_ListOrganizationsWithParams_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationsWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.ListOrganizationsApiParams
+func (_e *OrganizationsApi_Expecter) ListOrganizationsWithParams(ctx interface{}, args interface{}) *OrganizationsApi_ListOrganizationsWithParams_Call {
+	return &OrganizationsApi_ListOrganizationsWithParams_Call{Call: _e.mock.On("ListOrganizationsWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_ListOrganizationsWithParams_Call) Run(run func(ctx context.Context, args *admin.ListOrganizationsApiParams)) *OrganizationsApi_ListOrganizationsWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ListOrganizationsApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationsWithParams_Call) Return(_a0 admin.ListOrganizationsApiRequest) *OrganizationsApi_ListOrganizationsWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_ListOrganizationsWithParams_Call) RunAndReturn(run func(context.Context, *admin.ListOrganizationsApiParams) admin.ListOrganizationsApiRequest) *OrganizationsApi_ListOrganizationsWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveOrganizationUser provides a mock function with given fields: ctx, orgId, userId
+func (_m *OrganizationsApi) RemoveOrganizationUser(ctx context.Context, orgId string, userId string) admin.RemoveOrganizationUserApiRequest {
+	ret := _m.Called(ctx, orgId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveOrganizationUser")
+	}
+
+	var r0 admin.RemoveOrganizationUserApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) admin.RemoveOrganizationUserApiRequest); ok {
+		r0 = rf(ctx, orgId, userId)
+	} else {
+		r0 = ret.Get(0).(admin.RemoveOrganizationUserApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_RemoveOrganizationUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveOrganizationUser'
+type OrganizationsApi_RemoveOrganizationUser_Call struct {
+	*mock.Call
+}
+
+// RemoveOrganizationUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+//   - userId string
+func (_e *OrganizationsApi_Expecter) RemoveOrganizationUser(ctx interface{}, orgId interface{}, userId interface{}) *OrganizationsApi_RemoveOrganizationUser_Call {
+	return &OrganizationsApi_RemoveOrganizationUser_Call{Call: _e.mock.On("RemoveOrganizationUser", ctx, orgId, userId)}
+}
+
+func (_c *OrganizationsApi_RemoveOrganizationUser_Call) Run(run func(ctx context.Context, orgId string, userId string)) *OrganizationsApi_RemoveOrganizationUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_RemoveOrganizationUser_Call) Return(_a0 admin.RemoveOrganizationUserApiRequest) *OrganizationsApi_RemoveOrganizationUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_RemoveOrganizationUser_Call) RunAndReturn(run func(context.Context, string, string) admin.RemoveOrganizationUserApiRequest) *OrganizationsApi_RemoveOrganizationUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveOrganizationUserExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) RemoveOrganizationUserExecute(r admin.RemoveOrganizationUserApiRequest) (map[string]interface{}, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveOrganizationUserExecute")
+	}
+
+	var r0 map[string]interface{}
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.RemoveOrganizationUserApiRequest) (map[string]interface{}, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.RemoveOrganizationUserApiRequest) map[string]interface{}); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.RemoveOrganizationUserApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.RemoveOrganizationUserApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_RemoveOrganizationUserExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveOrganizationUserExecute'
+type OrganizationsApi_RemoveOrganizationUserExecute_Call struct {
+	*mock.Call
+}
+
+// RemoveOrganizationUserExecute is a helper method to define mock.On call
+//   - r admin.RemoveOrganizationUserApiRequest
+func (_e *OrganizationsApi_Expecter) RemoveOrganizationUserExecute(r interface{}) *OrganizationsApi_RemoveOrganizationUserExecute_Call {
+	return &OrganizationsApi_RemoveOrganizationUserExecute_Call{Call: _e.mock.On("RemoveOrganizationUserExecute", r)}
+}
+
+func (_c *OrganizationsApi_RemoveOrganizationUserExecute_Call) Run(run func(r admin.RemoveOrganizationUserApiRequest)) *OrganizationsApi_RemoveOrganizationUserExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.RemoveOrganizationUserApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_RemoveOrganizationUserExecute_Call) Return(_a0 map[string]interface{}, _a1 *http.Response, _a2 error) *OrganizationsApi_RemoveOrganizationUserExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_RemoveOrganizationUserExecute_Call) RunAndReturn(run func(admin.RemoveOrganizationUserApiRequest) (map[string]interface{}, *http.Response, error)) *OrganizationsApi_RemoveOrganizationUserExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveOrganizationUserWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) RemoveOrganizationUserWithParams(ctx context.Context, args *admin.RemoveOrganizationUserApiParams) admin.RemoveOrganizationUserApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveOrganizationUserWithParams")
+	}
+
+	var r0 admin.RemoveOrganizationUserApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.RemoveOrganizationUserApiParams) admin.RemoveOrganizationUserApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.RemoveOrganizationUserApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_RemoveOrganizationUserWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveOrganizationUserWithParams'
+type OrganizationsApi_RemoveOrganizationUserWithParams_Call struct {
+	*mock.Call
+}
+
+// RemoveOrganizationUserWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.RemoveOrganizationUserApiParams
+func (_e *OrganizationsApi_Expecter) RemoveOrganizationUserWithParams(ctx interface{}, args interface{}) *OrganizationsApi_RemoveOrganizationUserWithParams_Call {
+	return &OrganizationsApi_RemoveOrganizationUserWithParams_Call{Call: _e.mock.On("RemoveOrganizationUserWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_RemoveOrganizationUserWithParams_Call) Run(run func(ctx context.Context, args *admin.RemoveOrganizationUserApiParams)) *OrganizationsApi_RemoveOrganizationUserWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.RemoveOrganizationUserApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_RemoveOrganizationUserWithParams_Call) Return(_a0 admin.RemoveOrganizationUserApiRequest) *OrganizationsApi_RemoveOrganizationUserWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_RemoveOrganizationUserWithParams_Call) RunAndReturn(run func(context.Context, *admin.RemoveOrganizationUserApiParams) admin.RemoveOrganizationUserApiRequest) *OrganizationsApi_RemoveOrganizationUserWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameOrganization provides a mock function with given fields: ctx, orgId, atlasOrganization
+func (_m *OrganizationsApi) RenameOrganization(ctx context.Context, orgId string, atlasOrganization *admin.AtlasOrganization) admin.RenameOrganizationApiRequest {
+	ret := _m.Called(ctx, orgId, atlasOrganization)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameOrganization")
+	}
+
+	var r0 admin.RenameOrganizationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, *admin.AtlasOrganization) admin.RenameOrganizationApiRequest); ok {
+		r0 = rf(ctx, orgId, atlasOrganization)
+	} else {
+		r0 = ret.Get(0).(admin.RenameOrganizationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_RenameOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameOrganization'
+type OrganizationsApi_RenameOrganization_Call struct {
+	*mock.Call
+}
+
+// RenameOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+//   - atlasOrganization *admin.AtlasOrganization
+func (_e *OrganizationsApi_Expecter) RenameOrganization(ctx interface{}, orgId interface{}, atlasOrganization interface{}) *OrganizationsApi_RenameOrganization_Call {
+	return &OrganizationsApi_RenameOrganization_Call{Call: _e.mock.On("RenameOrganization", ctx, orgId, atlasOrganization)}
+}
+
+func (_c *OrganizationsApi_RenameOrganization_Call) Run(run func(ctx context.Context, orgId string, atlasOrganization *admin.AtlasOrganization)) *OrganizationsApi_RenameOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*admin.AtlasOrganization))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_RenameOrganization_Call) Return(_a0 admin.RenameOrganizationApiRequest) *OrganizationsApi_RenameOrganization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_RenameOrganization_Call) RunAndReturn(run func(context.Context, string, *admin.AtlasOrganization) admin.RenameOrganizationApiRequest) *OrganizationsApi_RenameOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameOrganizationExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) RenameOrganizationExecute(r admin.RenameOrganizationApiRequest) (*admin.AtlasOrganization, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameOrganizationExecute")
+	}
+
+	var r0 *admin.AtlasOrganization
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.RenameOrganizationApiRequest) (*admin.AtlasOrganization, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.RenameOrganizationApiRequest) *admin.AtlasOrganization); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.AtlasOrganization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.RenameOrganizationApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.RenameOrganizationApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_RenameOrganizationExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameOrganizationExecute'
+type OrganizationsApi_RenameOrganizationExecute_Call struct {
+	*mock.Call
+}
+
+// RenameOrganizationExecute is a helper method to define mock.On call
+//   - r admin.RenameOrganizationApiRequest
+func (_e *OrganizationsApi_Expecter) RenameOrganizationExecute(r interface{}) *OrganizationsApi_RenameOrganizationExecute_Call {
+	return &OrganizationsApi_RenameOrganizationExecute_Call{Call: _e.mock.On("RenameOrganizationExecute", r)}
+}
+
+func (_c *OrganizationsApi_RenameOrganizationExecute_Call) Run(run func(r admin.RenameOrganizationApiRequest)) *OrganizationsApi_RenameOrganizationExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.RenameOrganizationApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_RenameOrganizationExecute_Call) Return(_a0 *admin.AtlasOrganization, _a1 *http.Response, _a2 error) *OrganizationsApi_RenameOrganizationExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_RenameOrganizationExecute_Call) RunAndReturn(run func(admin.RenameOrganizationApiRequest) (*admin.AtlasOrganization, *http.Response, error)) *OrganizationsApi_RenameOrganizationExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameOrganizationWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) RenameOrganizationWithParams(ctx context.Context, args *admin.RenameOrganizationApiParams) admin.RenameOrganizationApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameOrganizationWithParams")
+	}
+
+	var r0 admin.RenameOrganizationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.RenameOrganizationApiParams) admin.RenameOrganizationApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.RenameOrganizationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_RenameOrganizationWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameOrganizationWithParams'
+type OrganizationsApi_RenameOrganizationWithParams_Call struct {
+	*mock.Call
+}
+
+// RenameOrganizationWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.RenameOrganizationApiParams
+func (_e *OrganizationsApi_Expecter) RenameOrganizationWithParams(ctx interface{}, args interface{}) *OrganizationsApi_RenameOrganizationWithParams_Call {
+	return &OrganizationsApi_RenameOrganizationWithParams_Call{Call: _e.mock.On("RenameOrganizationWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_RenameOrganizationWithParams_Call) Run(run func(ctx context.Context, args *admin.RenameOrganizationApiParams)) *OrganizationsApi_RenameOrganizationWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.RenameOrganizationApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_RenameOrganizationWithParams_Call) Return(_a0 admin.RenameOrganizationApiRequest) *OrganizationsApi_RenameOrganizationWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_RenameOrganizationWithParams_Call) RunAndReturn(run func(context.Context, *admin.RenameOrganizationApiParams) admin.RenameOrganizationApiRequest) *OrganizationsApi_RenameOrganizationWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationInvitation provides a mock function with given fields: ctx, orgId, organizationInvitationRequest
+func (_m *OrganizationsApi) UpdateOrganizationInvitation(ctx context.Context, orgId string, organizationInvitationRequest *admin.OrganizationInvitationRequest) admin.UpdateOrganizationInvitationApiRequest {
+	ret := _m.Called(ctx, orgId, organizationInvitationRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationInvitation")
+	}
+
+	var r0 admin.UpdateOrganizationInvitationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, *admin.OrganizationInvitationRequest) admin.UpdateOrganizationInvitationApiRequest); ok {
+		r0 = rf(ctx, orgId, organizationInvitationRequest)
+	} else {
+		r0 = ret.Get(0).(admin.UpdateOrganizationInvitationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_UpdateOrganizationInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitation'
+type OrganizationsApi_UpdateOrganizationInvitation_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+//   - organizationInvitationRequest *admin.OrganizationInvitationRequest
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationInvitation(ctx interface{}, orgId interface{}, organizationInvitationRequest interface{}) *OrganizationsApi_UpdateOrganizationInvitation_Call {
+	return &OrganizationsApi_UpdateOrganizationInvitation_Call{Call: _e.mock.On("UpdateOrganizationInvitation", ctx, orgId, organizationInvitationRequest)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitation_Call) Run(run func(ctx context.Context, orgId string, organizationInvitationRequest *admin.OrganizationInvitationRequest)) *OrganizationsApi_UpdateOrganizationInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*admin.OrganizationInvitationRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitation_Call) Return(_a0 admin.UpdateOrganizationInvitationApiRequest) *OrganizationsApi_UpdateOrganizationInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitation_Call) RunAndReturn(run func(context.Context, string, *admin.OrganizationInvitationRequest) admin.UpdateOrganizationInvitationApiRequest) *OrganizationsApi_UpdateOrganizationInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationInvitationById provides a mock function with given fields: ctx, orgId, invitationId, organizationInvitationUpdateRequest
+func (_m *OrganizationsApi) UpdateOrganizationInvitationById(ctx context.Context, orgId string, invitationId string, organizationInvitationUpdateRequest *admin.OrganizationInvitationUpdateRequest) admin.UpdateOrganizationInvitationByIdApiRequest {
+	ret := _m.Called(ctx, orgId, invitationId, organizationInvitationUpdateRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationInvitationById")
+	}
+
+	var r0 admin.UpdateOrganizationInvitationByIdApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *admin.OrganizationInvitationUpdateRequest) admin.UpdateOrganizationInvitationByIdApiRequest); ok {
+		r0 = rf(ctx, orgId, invitationId, organizationInvitationUpdateRequest)
+	} else {
+		r0 = ret.Get(0).(admin.UpdateOrganizationInvitationByIdApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_UpdateOrganizationInvitationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitationById'
+type OrganizationsApi_UpdateOrganizationInvitationById_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationInvitationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+//   - invitationId string
+//   - organizationInvitationUpdateRequest *admin.OrganizationInvitationUpdateRequest
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationInvitationById(ctx interface{}, orgId interface{}, invitationId interface{}, organizationInvitationUpdateRequest interface{}) *OrganizationsApi_UpdateOrganizationInvitationById_Call {
+	return &OrganizationsApi_UpdateOrganizationInvitationById_Call{Call: _e.mock.On("UpdateOrganizationInvitationById", ctx, orgId, invitationId, organizationInvitationUpdateRequest)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationById_Call) Run(run func(ctx context.Context, orgId string, invitationId string, organizationInvitationUpdateRequest *admin.OrganizationInvitationUpdateRequest)) *OrganizationsApi_UpdateOrganizationInvitationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*admin.OrganizationInvitationUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationById_Call) Return(_a0 admin.UpdateOrganizationInvitationByIdApiRequest) *OrganizationsApi_UpdateOrganizationInvitationById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationById_Call) RunAndReturn(run func(context.Context, string, string, *admin.OrganizationInvitationUpdateRequest) admin.UpdateOrganizationInvitationByIdApiRequest) *OrganizationsApi_UpdateOrganizationInvitationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationInvitationByIdExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) UpdateOrganizationInvitationByIdExecute(r admin.UpdateOrganizationInvitationByIdApiRequest) (*admin.OrganizationInvitation, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationInvitationByIdExecute")
+	}
+
+	var r0 *admin.OrganizationInvitation
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.UpdateOrganizationInvitationByIdApiRequest) (*admin.OrganizationInvitation, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.UpdateOrganizationInvitationByIdApiRequest) *admin.OrganizationInvitation); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.UpdateOrganizationInvitationByIdApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.UpdateOrganizationInvitationByIdApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_UpdateOrganizationInvitationByIdExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitationByIdExecute'
+type OrganizationsApi_UpdateOrganizationInvitationByIdExecute_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationInvitationByIdExecute is a helper method to define mock.On call
+//   - r admin.UpdateOrganizationInvitationByIdApiRequest
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationInvitationByIdExecute(r interface{}) *OrganizationsApi_UpdateOrganizationInvitationByIdExecute_Call {
+	return &OrganizationsApi_UpdateOrganizationInvitationByIdExecute_Call{Call: _e.mock.On("UpdateOrganizationInvitationByIdExecute", r)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationByIdExecute_Call) Run(run func(r admin.UpdateOrganizationInvitationByIdApiRequest)) *OrganizationsApi_UpdateOrganizationInvitationByIdExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.UpdateOrganizationInvitationByIdApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationByIdExecute_Call) Return(_a0 *admin.OrganizationInvitation, _a1 *http.Response, _a2 error) *OrganizationsApi_UpdateOrganizationInvitationByIdExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationByIdExecute_Call) RunAndReturn(run func(admin.UpdateOrganizationInvitationByIdApiRequest) (*admin.OrganizationInvitation, *http.Response, error)) *OrganizationsApi_UpdateOrganizationInvitationByIdExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationInvitationByIdWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) UpdateOrganizationInvitationByIdWithParams(ctx context.Context, args *admin.UpdateOrganizationInvitationByIdApiParams) admin.UpdateOrganizationInvitationByIdApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationInvitationByIdWithParams")
+	}
+
+	var r0 admin.UpdateOrganizationInvitationByIdApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.UpdateOrganizationInvitationByIdApiParams) admin.UpdateOrganizationInvitationByIdApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.UpdateOrganizationInvitationByIdApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_UpdateOrganizationInvitationByIdWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitationByIdWithParams'
+type OrganizationsApi_UpdateOrganizationInvitationByIdWithParams_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationInvitationByIdWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.UpdateOrganizationInvitationByIdApiParams
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationInvitationByIdWithParams(ctx interface{}, args interface{}) *OrganizationsApi_UpdateOrganizationInvitationByIdWithParams_Call {
+	return &OrganizationsApi_UpdateOrganizationInvitationByIdWithParams_Call{Call: _e.mock.On("UpdateOrganizationInvitationByIdWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationByIdWithParams_Call) Run(run func(ctx context.Context, args *admin.UpdateOrganizationInvitationByIdApiParams)) *OrganizationsApi_UpdateOrganizationInvitationByIdWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.UpdateOrganizationInvitationByIdApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationByIdWithParams_Call) Return(_a0 admin.UpdateOrganizationInvitationByIdApiRequest) *OrganizationsApi_UpdateOrganizationInvitationByIdWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationByIdWithParams_Call) RunAndReturn(run func(context.Context, *admin.UpdateOrganizationInvitationByIdApiParams) admin.UpdateOrganizationInvitationByIdApiRequest) *OrganizationsApi_UpdateOrganizationInvitationByIdWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationInvitationExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) UpdateOrganizationInvitationExecute(r admin.UpdateOrganizationInvitationApiRequest) (*admin.OrganizationInvitation, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationInvitationExecute")
+	}
+
+	var r0 *admin.OrganizationInvitation
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.UpdateOrganizationInvitationApiRequest) (*admin.OrganizationInvitation, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.UpdateOrganizationInvitationApiRequest) *admin.OrganizationInvitation); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.UpdateOrganizationInvitationApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.UpdateOrganizationInvitationApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_UpdateOrganizationInvitationExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitationExecute'
+type OrganizationsApi_UpdateOrganizationInvitationExecute_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationInvitationExecute is a helper method to define mock.On call
+//   - r admin.UpdateOrganizationInvitationApiRequest
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationInvitationExecute(r interface{}) *OrganizationsApi_UpdateOrganizationInvitationExecute_Call {
+	return &OrganizationsApi_UpdateOrganizationInvitationExecute_Call{Call: _e.mock.On("UpdateOrganizationInvitationExecute", r)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationExecute_Call) Run(run func(r admin.UpdateOrganizationInvitationApiRequest)) *OrganizationsApi_UpdateOrganizationInvitationExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.UpdateOrganizationInvitationApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationExecute_Call) Return(_a0 *admin.OrganizationInvitation, _a1 *http.Response, _a2 error) *OrganizationsApi_UpdateOrganizationInvitationExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationExecute_Call) RunAndReturn(run func(admin.UpdateOrganizationInvitationApiRequest) (*admin.OrganizationInvitation, *http.Response, error)) *OrganizationsApi_UpdateOrganizationInvitationExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationInvitationWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) UpdateOrganizationInvitationWithParams(ctx context.Context, args *admin.UpdateOrganizationInvitationApiParams) admin.UpdateOrganizationInvitationApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationInvitationWithParams")
+	}
+
+	var r0 admin.UpdateOrganizationInvitationApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.UpdateOrganizationInvitationApiParams) admin.UpdateOrganizationInvitationApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.UpdateOrganizationInvitationApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_UpdateOrganizationInvitationWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitationWithParams'
+type OrganizationsApi_UpdateOrganizationInvitationWithParams_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationInvitationWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.UpdateOrganizationInvitationApiParams
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationInvitationWithParams(ctx interface{}, args interface{}) *OrganizationsApi_UpdateOrganizationInvitationWithParams_Call {
+	return &OrganizationsApi_UpdateOrganizationInvitationWithParams_Call{Call: _e.mock.On("UpdateOrganizationInvitationWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationWithParams_Call) Run(run func(ctx context.Context, args *admin.UpdateOrganizationInvitationApiParams)) *OrganizationsApi_UpdateOrganizationInvitationWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.UpdateOrganizationInvitationApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationWithParams_Call) Return(_a0 admin.UpdateOrganizationInvitationApiRequest) *OrganizationsApi_UpdateOrganizationInvitationWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationInvitationWithParams_Call) RunAndReturn(run func(context.Context, *admin.UpdateOrganizationInvitationApiParams) admin.UpdateOrganizationInvitationApiRequest) *OrganizationsApi_UpdateOrganizationInvitationWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationRoles provides a mock function with given fields: ctx, orgId, userId, updateOrgRolesForUser
+func (_m *OrganizationsApi) UpdateOrganizationRoles(ctx context.Context, orgId string, userId string, updateOrgRolesForUser *admin.UpdateOrgRolesForUser) admin.UpdateOrganizationRolesApiRequest {
+	ret := _m.Called(ctx, orgId, userId, updateOrgRolesForUser)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationRoles")
+	}
+
+	var r0 admin.UpdateOrganizationRolesApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *admin.UpdateOrgRolesForUser) admin.UpdateOrganizationRolesApiRequest); ok {
+		r0 = rf(ctx, orgId, userId, updateOrgRolesForUser)
+	} else {
+		r0 = ret.Get(0).(admin.UpdateOrganizationRolesApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_UpdateOrganizationRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationRoles'
+type OrganizationsApi_UpdateOrganizationRoles_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+//   - userId string
+//   - updateOrgRolesForUser *admin.UpdateOrgRolesForUser
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationRoles(ctx interface{}, orgId interface{}, userId interface{}, updateOrgRolesForUser interface{}) *OrganizationsApi_UpdateOrganizationRoles_Call {
+	return &OrganizationsApi_UpdateOrganizationRoles_Call{Call: _e.mock.On("UpdateOrganizationRoles", ctx, orgId, userId, updateOrgRolesForUser)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationRoles_Call) Run(run func(ctx context.Context, orgId string, userId string, updateOrgRolesForUser *admin.UpdateOrgRolesForUser)) *OrganizationsApi_UpdateOrganizationRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*admin.UpdateOrgRolesForUser))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationRoles_Call) Return(_a0 admin.UpdateOrganizationRolesApiRequest) *OrganizationsApi_UpdateOrganizationRoles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationRoles_Call) RunAndReturn(run func(context.Context, string, string, *admin.UpdateOrgRolesForUser) admin.UpdateOrganizationRolesApiRequest) *OrganizationsApi_UpdateOrganizationRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationRolesExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) UpdateOrganizationRolesExecute(r admin.UpdateOrganizationRolesApiRequest) (*admin.UpdateOrgRolesForUser, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationRolesExecute")
+	}
+
+	var r0 *admin.UpdateOrgRolesForUser
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.UpdateOrganizationRolesApiRequest) (*admin.UpdateOrgRolesForUser, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.UpdateOrganizationRolesApiRequest) *admin.UpdateOrgRolesForUser); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.UpdateOrgRolesForUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.UpdateOrganizationRolesApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.UpdateOrganizationRolesApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_UpdateOrganizationRolesExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationRolesExecute'
+type OrganizationsApi_UpdateOrganizationRolesExecute_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationRolesExecute is a helper method to define mock.On call
+//   - r admin.UpdateOrganizationRolesApiRequest
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationRolesExecute(r interface{}) *OrganizationsApi_UpdateOrganizationRolesExecute_Call {
+	return &OrganizationsApi_UpdateOrganizationRolesExecute_Call{Call: _e.mock.On("UpdateOrganizationRolesExecute", r)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationRolesExecute_Call) Run(run func(r admin.UpdateOrganizationRolesApiRequest)) *OrganizationsApi_UpdateOrganizationRolesExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.UpdateOrganizationRolesApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationRolesExecute_Call) Return(_a0 *admin.UpdateOrgRolesForUser, _a1 *http.Response, _a2 error) *OrganizationsApi_UpdateOrganizationRolesExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationRolesExecute_Call) RunAndReturn(run func(admin.UpdateOrganizationRolesApiRequest) (*admin.UpdateOrgRolesForUser, *http.Response, error)) *OrganizationsApi_UpdateOrganizationRolesExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationRolesWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) UpdateOrganizationRolesWithParams(ctx context.Context, args *admin.UpdateOrganizationRolesApiParams) admin.UpdateOrganizationRolesApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationRolesWithParams")
+	}
+
+	var r0 admin.UpdateOrganizationRolesApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.UpdateOrganizationRolesApiParams) admin.UpdateOrganizationRolesApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.UpdateOrganizationRolesApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_UpdateOrganizationRolesWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationRolesWithParams'
+type OrganizationsApi_UpdateOrganizationRolesWithParams_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationRolesWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.UpdateOrganizationRolesApiParams
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationRolesWithParams(ctx interface{}, args interface{}) *OrganizationsApi_UpdateOrganizationRolesWithParams_Call {
+	return &OrganizationsApi_UpdateOrganizationRolesWithParams_Call{Call: _e.mock.On("UpdateOrganizationRolesWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationRolesWithParams_Call) Run(run func(ctx context.Context, args *admin.UpdateOrganizationRolesApiParams)) *OrganizationsApi_UpdateOrganizationRolesWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.UpdateOrganizationRolesApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationRolesWithParams_Call) Return(_a0 admin.UpdateOrganizationRolesApiRequest) *OrganizationsApi_UpdateOrganizationRolesWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationRolesWithParams_Call) RunAndReturn(run func(context.Context, *admin.UpdateOrganizationRolesApiParams) admin.UpdateOrganizationRolesApiRequest) *OrganizationsApi_UpdateOrganizationRolesWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationSettings provides a mock function with given fields: ctx, orgId, organizationSettings
+func (_m *OrganizationsApi) UpdateOrganizationSettings(ctx context.Context, orgId string, organizationSettings *admin.OrganizationSettings) admin.UpdateOrganizationSettingsApiRequest {
+	ret := _m.Called(ctx, orgId, organizationSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationSettings")
+	}
+
+	var r0 admin.UpdateOrganizationSettingsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, *admin.OrganizationSettings) admin.UpdateOrganizationSettingsApiRequest); ok {
+		r0 = rf(ctx, orgId, organizationSettings)
+	} else {
+		r0 = ret.Get(0).(admin.UpdateOrganizationSettingsApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_UpdateOrganizationSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationSettings'
+type OrganizationsApi_UpdateOrganizationSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId string
+//   - organizationSettings *admin.OrganizationSettings
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationSettings(ctx interface{}, orgId interface{}, organizationSettings interface{}) *OrganizationsApi_UpdateOrganizationSettings_Call {
+	return &OrganizationsApi_UpdateOrganizationSettings_Call{Call: _e.mock.On("UpdateOrganizationSettings", ctx, orgId, organizationSettings)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationSettings_Call) Run(run func(ctx context.Context, orgId string, organizationSettings *admin.OrganizationSettings)) *OrganizationsApi_UpdateOrganizationSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*admin.OrganizationSettings))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationSettings_Call) Return(_a0 admin.UpdateOrganizationSettingsApiRequest) *OrganizationsApi_UpdateOrganizationSettings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationSettings_Call) RunAndReturn(run func(context.Context, string, *admin.OrganizationSettings) admin.UpdateOrganizationSettingsApiRequest) *OrganizationsApi_UpdateOrganizationSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationSettingsExecute provides a mock function with given fields: r
+func (_m *OrganizationsApi) UpdateOrganizationSettingsExecute(r admin.UpdateOrganizationSettingsApiRequest) (*admin.OrganizationSettings, *http.Response, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationSettingsExecute")
+	}
+
+	var r0 *admin.OrganizationSettings
+	var r1 *http.Response
+	var r2 error
+	if rf, ok := ret.Get(0).(func(admin.UpdateOrganizationSettingsApiRequest) (*admin.OrganizationSettings, *http.Response, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(admin.UpdateOrganizationSettingsApiRequest) *admin.OrganizationSettings); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.OrganizationSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(admin.UpdateOrganizationSettingsApiRequest) *http.Response); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(admin.UpdateOrganizationSettingsApiRequest) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrganizationsApi_UpdateOrganizationSettingsExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationSettingsExecute'
+type OrganizationsApi_UpdateOrganizationSettingsExecute_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationSettingsExecute is a helper method to define mock.On call
+//   - r admin.UpdateOrganizationSettingsApiRequest
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationSettingsExecute(r interface{}) *OrganizationsApi_UpdateOrganizationSettingsExecute_Call {
+	return &OrganizationsApi_UpdateOrganizationSettingsExecute_Call{Call: _e.mock.On("UpdateOrganizationSettingsExecute", r)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationSettingsExecute_Call) Run(run func(r admin.UpdateOrganizationSettingsApiRequest)) *OrganizationsApi_UpdateOrganizationSettingsExecute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(admin.UpdateOrganizationSettingsApiRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationSettingsExecute_Call) Return(_a0 *admin.OrganizationSettings, _a1 *http.Response, _a2 error) *OrganizationsApi_UpdateOrganizationSettingsExecute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationSettingsExecute_Call) RunAndReturn(run func(admin.UpdateOrganizationSettingsApiRequest) (*admin.OrganizationSettings, *http.Response, error)) *OrganizationsApi_UpdateOrganizationSettingsExecute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationSettingsWithParams provides a mock function with given fields: ctx, args
+func (_m *OrganizationsApi) UpdateOrganizationSettingsWithParams(ctx context.Context, args *admin.UpdateOrganizationSettingsApiParams) admin.UpdateOrganizationSettingsApiRequest {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationSettingsWithParams")
+	}
+
+	var r0 admin.UpdateOrganizationSettingsApiRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.UpdateOrganizationSettingsApiParams) admin.UpdateOrganizationSettingsApiRequest); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(admin.UpdateOrganizationSettingsApiRequest)
+	}
+
+	return r0
+}
+
+// OrganizationsApi_UpdateOrganizationSettingsWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationSettingsWithParams'
+type OrganizationsApi_UpdateOrganizationSettingsWithParams_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationSettingsWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *admin.UpdateOrganizationSettingsApiParams
+func (_e *OrganizationsApi_Expecter) UpdateOrganizationSettingsWithParams(ctx interface{}, args interface{}) *OrganizationsApi_UpdateOrganizationSettingsWithParams_Call {
+	return &OrganizationsApi_UpdateOrganizationSettingsWithParams_Call{Call: _e.mock.On("UpdateOrganizationSettingsWithParams", ctx, args)}
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationSettingsWithParams_Call) Run(run func(ctx context.Context, args *admin.UpdateOrganizationSettingsApiParams)) *OrganizationsApi_UpdateOrganizationSettingsWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.UpdateOrganizationSettingsApiParams))
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationSettingsWithParams_Call) Return(_a0 admin.UpdateOrganizationSettingsApiRequest) *OrganizationsApi_UpdateOrganizationSettingsWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationsApi_UpdateOrganizationSettingsWithParams_Call) RunAndReturn(run func(context.Context, *admin.UpdateOrganizationSettingsApiParams) admin.UpdateOrganizationSettingsApiRequest) *OrganizationsApi_UpdateOrganizationSettingsWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrganizationsApi creates a new instance of OrganizationsApi. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrganizationsApi(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrganizationsApi {
+	mock := &OrganizationsApi{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
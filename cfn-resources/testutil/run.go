@@ -0,0 +1,78 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+)
+
+// HandlerFunc is a CRUDL function of a resource package, e.g. resource.Create.
+type HandlerFunc[M any] func(req handler.Request, prevModel *M, currentModel *M) (handler.ProgressEvent, error)
+
+// Run calls the handler with the models like CloudFormation: while the handler returns IN_PROGRESS, it's called
+// again with the callback context and the resource model of the event, going through the JSON serialization of
+// CloudFormation. The callback delays are waited if wait is set. It returns the last event and its resource model,
+// if any, and fails the test if the handler returns an error.
+func Run[M any](t testing.TB, reqCtx handler.RequestContext, wait bool, h HandlerFunc[M], prevModel, currentModel *M) (handler.ProgressEvent, *M) {
+	t.Helper()
+	return RunWithTypeConfig(t, reqCtx, wait, nil, h, prevModel, currentModel)
+}
+
+// RunWithTypeConfig is Run with the type configuration of the resource in the requests, e.g. {"AdoptIfExists": true}.
+func RunWithTypeConfig[M any](t testing.TB, reqCtx handler.RequestContext, wait bool, typeConfig json.RawMessage, h HandlerFunc[M],
+	prevModel, currentModel *M) (handler.ProgressEvent, *M) {
+	t.Helper()
+	var callbackContext map[string]any
+	for range maxCallbacks {
+		event, err := h(handler.NewRequest("", callbackContext, reqCtx, nil, nil, nil, typeConfig), prevModel, currentModel)
+		if err != nil {
+			t.Fatalf("handler error: %v, event: %+v", err, event)
+		}
+		model := roundTrip[*M](t, event.ResourceModel)
+		if event.OperationStatus != handler.InProgress {
+			return event, model
+		}
+		if wait {
+			time.Sleep(time.Duration(event.CallbackDelaySeconds) * time.Second)
+		}
+		callbackContext = roundTrip[map[string]any](t, event.CallbackContext)
+		if model != nil {
+			currentModel = model
+		}
+	}
+	t.Fatalf("handler still IN_PROGRESS after %d callbacks", maxCallbacks)
+	return handler.ProgressEvent{}, nil
+}
+
+// roundTrip returns the value decoded from its JSON, like CloudFormation passes the models and callback contexts.
+func roundTrip[T any](t testing.TB, v any) T {
+	t.Helper()
+	var decoded T
+	if v == nil {
+		return decoded
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshaling %T: %v", v, err)
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshaling %T: %v", v, err)
+	}
+	return decoded
+}
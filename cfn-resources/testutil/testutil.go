@@ -14,20 +14,70 @@
 
 package testutil
 
+import (
+	"fmt"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// TestOperation is the handler called by a TestStep.
 type TestOperation int
 
+// The operations of the handler a TestStep can call.
+const (
+	OperationCreate TestOperation = iota + 1
+	OperationRead
+	OperationUpdate
+	OperationDelete
+	OperationList
+)
+
+// String returns the name of the operation, as used by CloudFormation.
+func (o TestOperation) String() string {
+	switch o {
+	case OperationCreate:
+		return "CREATE"
+	case OperationRead:
+		return "READ"
+	case OperationUpdate:
+		return "UPDATE"
+	case OperationDelete:
+		return "DELETE"
+	case OperationList:
+		return "LIST"
+	default:
+		return fmt.Sprintf("TestOperation(%d)", int(o))
+	}
+}
+
+// TestCase is a sequence of steps run by Test against the handler of a resource.
 type TestCase struct {
 	TestHandler TestHandler
 	Name        string
-	Steps       []TestStep
+	// Schema is the path of the resource schema, its primary identifier, read-only and write-only properties
+	// are used like 'cfn test' does.
+	Schema string
+	// RequestContext is passed to the handler in every request, its AccountID and Region select the profile.
+	RequestContext handler.RequestContext
+	// Session is passed to the handler in every request, e.g. the session of fakeatlas for the secrets of an
+	// organization, the handlers have no AWS session when it is nil.
+	Session *session.Session
+	Steps   []TestStep
 }
 
+// TestStep calls one operation of the handler. Config is the JSON resource model of Create and Update and
+// optionally of List and Read, the other operations use the current state of the resource. A Read with a Config
+// reads a resource the test case didn't create, e.g. one served by stubbed Atlas responses, and must read the
+// properties of the Config but the read-only and write-only ones.
 type TestStep struct {
 	Check     TestCheckFunc
 	Config    string
 	Operation TestOperation
 }
 
+// TestCheckFunc checks the resource model read after a Create, Update or Read step, the model returned by
+// a Delete step or the models returned by a List step.
 type TestCheckFunc func(model interface{}) error
 
 // TestT is the interface used to handle the test lifecycle of a test.
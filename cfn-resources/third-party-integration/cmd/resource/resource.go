@@ -18,10 +18,12 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	log "github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
@@ -48,23 +50,31 @@ func validateModel(fields []string, model *Model) *handler.ProgressEvent {
 	return validator.ValidateModel(fields, model)
 }
 
-func setup() {
-	util.SetupLogger("mongodb-atlas-thirdpartyintegration")
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-third-party-integration",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	Create:   handlerkit.Operation[Model]{Run: create, RequiredFields: RequiredFields},
+	Read:     handlerkit.Operation[Model]{Run: read, RequiredFields: RequiredFields},
+	Update:   handlerkit.Operation[Model]{Run: update, RequiredFields: RequiredFields},
+	Delete:   handlerkit.Operation[Model]{Run: deleteResource, RequiredFields: RequiredFields},
+	List:     handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields},
 }
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-	_, _ = log.Warnf("Create() currentModel:%+v", currentModel)
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
+func create(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	_, _ = log.Warnf("Create() currentModel:%+v", currentModel)
 
 	ProjectID := currentModel.ProjectId
 	IntegrationType := currentModel.Type
@@ -82,7 +92,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			return progressevent.GetFailedEventByCode("INTEGRATION_ALREADY_CONFIGURED.", cloudformation.HandlerErrorCodeAlreadyExists), nil
 		}
 
-		return progressevent.GetFailedEventByResponse(err.Error(), resModel), nil
+		return progressevent.GetFailedEventByError(err, resModel), nil
 	}
 
 	return handler.ProgressEvent{
@@ -91,27 +101,14 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("Read() currentModel:%+v", currentModel)
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func read(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	ProjectID := currentModel.ProjectId
 	IntegrationType := currentModel.Type
 
 	integration, res, err := client.Atlas20231115002.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(context.Background(), *ProjectID, *IntegrationType).Execute()
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 	_, _ = log.Debugf("Atlas Client %v", client)
 
@@ -121,33 +118,19 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("Update() currentModel:%+v", currentModel)
-
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func update(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	ProjectID := currentModel.ProjectId
 	IntegrationType := currentModel.Type
 
 	integration, res, err := client.Atlas20231115002.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(context.Background(), *ProjectID, *IntegrationType).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	updateIntegrationFromSchema(currentModel, integration)
 	integrations, res, err := client.Atlas20231115002.ThirdPartyIntegrationsApi.UpdateThirdPartyIntegration(context.Background(), *IntegrationType, *ProjectID, integration).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	return handler.ProgressEvent{
@@ -204,19 +187,7 @@ func updateIntegrationFromSchema(currentModel *Model, integration *admin.ThridPa
 	}
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("Delete() currentModel:%+v", currentModel)
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
-	if peErr != nil {
-		return *peErr, nil
-	}
-
+func deleteResource(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	var res *http.Response
 	var err error
 
@@ -226,7 +197,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	_, res, err = client.Atlas20231115002.ThirdPartyIntegrationsApi.DeleteThirdPartyIntegration(context.Background(), *IntegrationType, *ProjectID).Execute()
 
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return progressevent.GetFailedEventByError(err, res), nil
 	}
 
 	return handler.ProgressEvent{
@@ -235,30 +206,27 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("List() currentModel:%+v", currentModel)
-	if modelValidation := validateModel(ListRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+func list(req handler.Request, client *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	ProjectID := currentModel.ProjectId
+	integrations, nextToken, peErr := util.ListPage(&req, constants.DefaultListItemsPerPage, func(pageNum, itemsPerPage int) ([]admin.ThridPartyIntegration, int, *http.Response, error) {
+		page, res, err := client.Atlas20231115002.ThirdPartyIntegrationsApi.ListThirdPartyIntegrationsWithParams(context.Background(), &admin.ListThirdPartyIntegrationsApiParams{
+			GroupId:      *ProjectID,
+			IncludeCount: admin.PtrBool(true),
+			ItemsPerPage: admin.PtrInt(itemsPerPage),
+			PageNum:      admin.PtrInt(pageNum),
+		}).Execute()
+		if err != nil {
+			return nil, 0, res, err
+		}
+		return page.GetResults(), page.GetTotalCount(), res, nil
+	})
 	if peErr != nil {
 		return *peErr, nil
 	}
 
-	var res *http.Response
-	ProjectID := currentModel.ProjectId
-	integrations, res, err := client.Atlas20231115002.ThirdPartyIntegrationsApi.ListThirdPartyIntegrations(context.Background(), *ProjectID).Execute()
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
-	}
-
 	mm := make([]interface{}, 0)
-	for i := range integrations.Results {
-		m := integrationToModel(*currentModel, &integrations.Results[i])
+	for i := range integrations {
+		m := integrationToModel(*currentModel, &integrations[i])
 		mm = append(mm, m)
 	}
 
@@ -267,6 +235,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		OperationStatus: handler.Success,
 		Message:         "List successful",
 		ResourceModels:  mm,
+		NextToken:       nextToken,
 	}, nil
 }
 
@@ -336,9 +305,11 @@ func integrationToModel(currentModel Model, integration *admin.ThridPartyIntegra
 	   The variables from the thirdparty integration are not returned back in reposnse because most of the variables are sensitive variables.
 	*/
 	out := Model{
-		Type:      integration.Type,
-		ProjectId: currentModel.ProjectId,
-		Profile:   currentModel.Profile,
+		Type:          integration.Type,
+		ProjectId:     currentModel.ProjectId,
+		Profile:       currentModel.Profile,
+		ListenAddress: integration.ListenAddress,
+		TlsPemPath:    integration.TlsPemPath,
 	}
 
 	if !enabled {
@@ -0,0 +1,191 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// redaction-generator reads the writeOnlyProperties of every resource schema and generates, in util/logger:
+//   - schema_sensitive_fields.go, the fields masked by the logger for the models of each resource.
+//   - redact_models_test.go, a test feeding the model of every resource through the logger.
+//
+// It's run with `go generate ./util/logger` from cfn-resources.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	header = `// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by tool/redaction-generator. DO NOT EDIT.
+
+`
+	modulePath = "github.com/mongodb/mongodbatlas-cloudformation-resources"
+)
+
+type resource struct {
+	Dir       string
+	WriteOnly []string
+}
+
+type schema struct {
+	WriteOnlyProperties []string `json:"writeOnlyProperties"`
+}
+
+func main() {
+	root, outDir := ".", filepath.Join("util", "logger")
+	if _, err := os.Stat(filepath.Join(root, "go.mod")); err != nil {
+		// invoked by go generate from util/logger
+		root, outDir = filepath.Join("..", ".."), "."
+	}
+
+	resources, err := readResources(root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := writeSource(filepath.Join(outDir, "schema_sensitive_fields.go"), fieldsFile(resources)); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeSource(filepath.Join(outDir, "redact_models_test.go"), modelsTestFile(resources)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readResources returns the resources with a generated model, sorted by directory.
+func readResources(root string) ([]resource, error) {
+	models, err := filepath.Glob(filepath.Join(root, "*", "cmd", "resource", "model.go"))
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]resource, 0, len(models))
+	for _, model := range models {
+		dir := filepath.Base(filepath.Dir(filepath.Dir(filepath.Dir(model))))
+		writeOnly, err := readWriteOnly(filepath.Join(root, dir))
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource{Dir: dir, WriteOnly: writeOnly})
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Dir < resources[j].Dir })
+	return resources, nil
+}
+
+// readWriteOnly returns the field names of the write-only properties declared in the resource schema.
+// Nested properties, e.g. /properties/Authentication/Password, are reduced to the field name.
+func readWriteOnly(dir string) ([]string, error) {
+	schemas, err := filepath.Glob(filepath.Join(dir, "mongodb-atlas-*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(schemas) != 1 {
+		return nil, fmt.Errorf("expected one schema in %s, found %d", dir, len(schemas))
+	}
+	data, err := os.ReadFile(schemas[0])
+	if err != nil {
+		return nil, err
+	}
+	var s schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("invalid schema %s: %w", schemas[0], err)
+	}
+	fields := make([]string, 0, len(s.WriteOnlyProperties))
+	for _, p := range s.WriteOnlyProperties {
+		name := p[strings.LastIndex(p, "/")+1:]
+		if name != "" && !contains(fields, name) {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func fieldsFile(resources []resource) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package logger\n\n")
+	b.WriteString("// schemaSensitiveFields are the writeOnlyProperties of the resource schemas, by resource directory.\n")
+	b.WriteString("var schemaSensitiveFields = map[string][]string{\n")
+	for _, r := range resources {
+		if len(r.WriteOnly) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "%q: {%s},\n", r.Dir, quoted(r.WriteOnly))
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+func modelsTestFile(resources []resource) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package logger_test\n\nimport (\n")
+	for _, r := range resources {
+		fmt.Fprintf(&b, "%s %q\n", alias(r.Dir), modulePath+"/"+r.Dir+"/cmd/resource")
+	}
+	b.WriteString(")\n\n")
+	b.WriteString("// resourceModels has a model of every resource, by resource directory.\n")
+	b.WriteString("var resourceModels = map[string]any{\n")
+	for _, r := range resources {
+		fmt.Fprintf(&b, "%q: &%s.Model{},\n", r.Dir, alias(r.Dir))
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+func writeSource(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", path, err)
+	}
+	return os.WriteFile(path, formatted, 0o600)
+}
+
+func alias(dir string) string {
+	return strings.ReplaceAll(dir, "-", "")
+}
+
+func quoted(values []string) string {
+	q := make([]string, len(values))
+	for i, v := range values {
+		q[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(q, ", ")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
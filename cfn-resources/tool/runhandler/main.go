@@ -0,0 +1,230 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// runhandler invokes a handler of a resource locally, without SAM, Docker or a registered type, with the model
+// properties of a JSON or YAML file:
+//
+//	go run ./tool/runhandler -resource cluster -action create -model cluster.yaml
+//
+// Like CloudFormation, it calls the handler again with the callback context and model of each IN_PROGRESS event,
+// waiting the callback delay unless -no-wait is set, and prints every event to stdout. The handler logs go to
+// stderr, at the level of LOG_LEVEL. The Atlas credentials are read from the MONGODB_ATLAS_* environment variables,
+// or from a profile file with -profiles, e.g. {"default": {"PublicKey": "...", "PrivateKey": "..."}}.
+//
+// It exits with status 1 if the handler fails.
+package main
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/encoding"
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+	"gopkg.in/yaml.v3"
+)
+
+var actions = []string{"create", "read", "update", "delete", "list"}
+
+func main() {
+	resource := flag.String("resource", "", "resource directory, e.g. cluster or database-user")
+	action := flag.String("action", "", "handler to invoke: "+strings.Join(actions, ", "))
+	modelFile := flag.String("model", "", "JSON or YAML file of the model properties")
+	prevFile := flag.String("prev", "", "JSON or YAML file of the previous model properties, for update")
+	profiles := flag.String("profiles", "", "JSON file of the profiles, the MONGODB_ATLAS_* environment variables are used otherwise")
+	typeConfig := flag.String("type-config", "{}", "JSON type configuration of the resource, e.g. {\"AdoptIfExists\": true}")
+	region := flag.String("region", "us-east-1", "AWS region of the request")
+	noWait := flag.Bool("no-wait", false, "call the handler again without waiting the callback delay")
+	token := flag.String("client-request-token", "", "client request token logged by the handler, a random one by default")
+	flag.Parse()
+	// handlerkit routes the standard library logger to the handler logs, the tool messages go to stderr as is.
+	log.SetOutput(os.Stderr)
+	log.SetFlags(log.LstdFlags)
+
+	h, ok := resources[*resource]
+	if !ok || !slices.Contains(actions, *action) || *modelFile == "" {
+		names := make([]string, 0, len(resources))
+		for name := range resources {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		log.Fatalf("usage: runhandler -resource name -action %s -model file [-prev file]\nresources: %s",
+			strings.Join(actions, "|"), strings.Join(names, ", "))
+	}
+
+	body, err := readModel(*modelFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var prevBody []byte
+	if *prevFile != "" {
+		if prevBody, err = readModel(*prevFile); err != nil {
+			log.Fatal(err)
+		}
+	}
+	config, err := localTypeConfig(*typeConfig, *profiles)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            aws.Config{Region: region},
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *token == "" {
+		*token = randomToken()
+	}
+	// Like CloudFormation, the same token is used for all the callbacks of the operation.
+	handlerkit.SetClientRequestToken(*token)
+
+	reqCtx := handler.RequestContext{Region: *region}
+	var callbackContext map[string]any
+	for {
+		req := handler.NewRequest("", callbackContext, reqCtx, sess, prevBody, body, config)
+		event := invoke(h, *action, req)
+		out, err := json.MarshalIndent(event, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(out))
+
+		switch event.OperationStatus {
+		case handler.InProgress:
+		case handler.Failed:
+			os.Exit(1)
+		default:
+			return
+		}
+		if !*noWait {
+			log.Printf("waiting %ds before the next callback", event.CallbackDelaySeconds)
+			time.Sleep(time.Duration(event.CallbackDelaySeconds) * time.Second)
+		}
+		// CloudFormation passes the callback context and the stringified model of the event.
+		if callbackContext, err = roundTrip(event.CallbackContext); err != nil {
+			log.Fatal(err)
+		}
+		if event.ResourceModel != nil {
+			if body, err = encoding.Marshal(event.ResourceModel); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+}
+
+// randomToken returns a token in the format of the CloudFormation client request tokens.
+func randomToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// invoke calls the method of the handler for the action.
+func invoke(h cfn.Handler, action string, req handler.Request) handler.ProgressEvent {
+	switch action {
+	case "create":
+		return h.Create(req)
+	case "read":
+		return h.Read(req)
+	case "update":
+		return h.Update(req)
+	case "delete":
+		return h.Delete(req)
+	default:
+		return h.List(req)
+	}
+}
+
+// readModel returns the JSON of the model properties in the JSON or YAML file, with the scalars as strings like
+// CloudFormation sends them, e.g. an unquoted ID made of digits is kept as is.
+func readModel(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid model %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("invalid model %s: expected an object of properties", path)
+	}
+	return json.Marshal(properties(doc.Content[0]))
+}
+
+// properties returns the value of the node with its scalars as strings.
+func properties(node *yaml.Node) any {
+	switch node.Kind {
+	case yaml.MappingNode:
+		m := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			m[node.Content[i].Value] = properties(node.Content[i+1])
+		}
+		return m
+	case yaml.SequenceNode:
+		s := make([]any, len(node.Content))
+		for i, item := range node.Content {
+			s[i] = properties(item)
+		}
+		return s
+	case yaml.AliasNode:
+		return properties(node.Alias)
+	default:
+		if node.Tag == "!!null" {
+			return nil
+		}
+		return node.Value
+	}
+}
+
+// localTypeConfig returns the type configuration reading the profiles from the file, or from the environment
+// variables if there is none.
+func localTypeConfig(typeConfig, profiles string) ([]byte, error) {
+	config := map[string]any{}
+	if err := json.Unmarshal([]byte(typeConfig), &config); err != nil {
+		return nil, fmt.Errorf("invalid type configuration: %w", err)
+	}
+	config["ProfileBackend"] = profile.BackendEnvironment
+	if profiles != "" {
+		config["ProfileBackend"] = profile.BackendFile
+		config["ProfileFile"] = profiles
+	}
+	return json.Marshal(config)
+}
+
+func roundTrip(callbackContext map[string]any) (map[string]any, error) {
+	if callbackContext == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(callbackContext)
+	if err != nil {
+		return nil, err
+	}
+	var decoded map[string]any
+	err = json.Unmarshal(data, &decoded)
+	return decoded, err
+}
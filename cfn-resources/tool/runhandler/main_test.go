@@ -0,0 +1,96 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEveryResourceIsRegistered(t *testing.T) {
+	models, err := filepath.Glob(filepath.Join("..", "..", "*", "cmd", "resource", "model.go"))
+	require.NoError(t, err)
+	require.NotEmpty(t, models)
+	for _, model := range models {
+		dir := filepath.Base(filepath.Dir(filepath.Dir(filepath.Dir(model))))
+		assert.Contains(t, resources, dir, "add the resource to resources.go")
+	}
+}
+
+// TestTypeNames checks the type name logged by each resource is the one of its schema, e.g. mongodb-atlas-private-endpoint-aws
+// for MongoDB::Atlas::PrivateEndpointAWS, so the log records of a resource aren't mistaken for another one.
+func TestTypeNames(t *testing.T) {
+	typeName := regexp.MustCompile(`(?m)^\tTypeName: "([^"]+)",$`)
+	files, err := filepath.Glob(filepath.Join("..", "..", "*", "cmd", "resource", "resource.go"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		dir := filepath.Dir(filepath.Dir(filepath.Dir(file)))
+		src, err := os.ReadFile(file)
+		require.NoError(t, err)
+		match := typeName.FindSubmatch(src)
+		require.NotNil(t, match, "%s has no handlerkit TypeName", file)
+
+		schemas, err := filepath.Glob(filepath.Join(dir, "mongodb-atlas-*.json"))
+		require.NoError(t, err)
+		require.Len(t, schemas, 1, dir)
+		data, err := os.ReadFile(schemas[0])
+		require.NoError(t, err)
+		var schema struct {
+			TypeName string `json:"typeName"`
+		}
+		require.NoError(t, json.Unmarshal(data, &schema))
+
+		want := strings.ToLower(strings.TrimPrefix(schema.TypeName, "MongoDB::Atlas::"))
+		got := strings.ReplaceAll(strings.TrimPrefix(string(match[1]), "mongodb-atlas-"), "-", "")
+		assert.Equal(t, "mongodb-atlas-"+filepath.Base(dir), string(match[1]), file)
+		assert.Equal(t, want, got, "%s doesn't match %s", match[1], schema.TypeName)
+	}
+}
+
+func TestReadModel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "model.yaml")
+	yamlModel := `
+ProjectId: 650000000000000000000001
+Paused: false
+Labels:
+  - Key: env
+    Value: test
+ReplicationSpecs:
+  - NumShards: 1
+    ZoneName: ~
+`
+	require.NoError(t, os.WriteFile(path, []byte(yamlModel), 0o600))
+	body, err := readModel(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"ProjectId": "650000000000000000000001",
+		"Paused": "false",
+		"Labels": [{"Key": "env", "Value": "test"}],
+		"ReplicationSpecs": [{"NumShards": "1", "ZoneName": null}]
+	}`, string(body))
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"ProjectId": "p", "Paused": true}`), 0o600))
+	body, err = readModel(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ProjectId": "p", "Paused": "true"}`, string(body))
+}
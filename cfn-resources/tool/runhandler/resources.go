@@ -0,0 +1,120 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
+
+	accesslistapikey "github.com/mongodb/mongodbatlas-cloudformation-resources/access-list-api-key/cmd/resource"
+	alertconfiguration "github.com/mongodb/mongodbatlas-cloudformation-resources/alert-configuration/cmd/resource"
+	apikey "github.com/mongodb/mongodbatlas-cloudformation-resources/api-key/cmd/resource"
+	auditing "github.com/mongodb/mongodbatlas-cloudformation-resources/auditing/cmd/resource"
+	cloudbackuprestorejobs "github.com/mongodb/mongodbatlas-cloudformation-resources/cloud-backup-restore-jobs/cmd/resource"
+	cloudbackupschedule "github.com/mongodb/mongodbatlas-cloudformation-resources/cloud-backup-schedule/cmd/resource"
+	cloudbackupsnapshotexportbucket "github.com/mongodb/mongodbatlas-cloudformation-resources/cloud-backup-snapshot-export-bucket/cmd/resource"
+	cloudbackupsnapshot "github.com/mongodb/mongodbatlas-cloudformation-resources/cloud-backup-snapshot/cmd/resource"
+	clusteroutagesimulation "github.com/mongodb/mongodbatlas-cloudformation-resources/cluster-outage-simulation/cmd/resource"
+	cluster "github.com/mongodb/mongodbatlas-cloudformation-resources/cluster/cmd/resource"
+	customdbrole "github.com/mongodb/mongodbatlas-cloudformation-resources/custom-db-role/cmd/resource"
+	customdnsconfigurationclusteraws "github.com/mongodb/mongodbatlas-cloudformation-resources/custom-dns-configuration-cluster-aws/cmd/resource"
+	datalakepipeline "github.com/mongodb/mongodbatlas-cloudformation-resources/data-lake-pipeline/cmd/resource"
+	databaseuser "github.com/mongodb/mongodbatlas-cloudformation-resources/database-user/cmd/resource"
+	datalakes "github.com/mongodb/mongodbatlas-cloudformation-resources/datalakes/cmd/resource"
+	encryptionatrest "github.com/mongodb/mongodbatlas-cloudformation-resources/encryption-at-rest/cmd/resource"
+	federateddatabaseinstance "github.com/mongodb/mongodbatlas-cloudformation-resources/federated-database-instance/cmd/resource"
+	federatedquerylimit "github.com/mongodb/mongodbatlas-cloudformation-resources/federated-query-limit/cmd/resource"
+	federatedsettingsorgrolemapping "github.com/mongodb/mongodbatlas-cloudformation-resources/federated-settings-org-role-mapping/cmd/resource"
+	globalclusterconfig "github.com/mongodb/mongodbatlas-cloudformation-resources/global-cluster-config/cmd/resource"
+	ldapconfiguration "github.com/mongodb/mongodbatlas-cloudformation-resources/ldap-configuration/cmd/resource"
+	ldapverify "github.com/mongodb/mongodbatlas-cloudformation-resources/ldap-verify/cmd/resource"
+	maintenancewindow "github.com/mongodb/mongodbatlas-cloudformation-resources/maintenance-window/cmd/resource"
+	networkcontainer "github.com/mongodb/mongodbatlas-cloudformation-resources/network-container/cmd/resource"
+	networkpeering "github.com/mongodb/mongodbatlas-cloudformation-resources/network-peering/cmd/resource"
+	onlinearchive "github.com/mongodb/mongodbatlas-cloudformation-resources/online-archive/cmd/resource"
+	orginvitation "github.com/mongodb/mongodbatlas-cloudformation-resources/org-invitation/cmd/resource"
+	organization "github.com/mongodb/mongodbatlas-cloudformation-resources/organization/cmd/resource"
+	privateendpointadl "github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint-adl/cmd/resource"
+	privateendpointaws "github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint-aws/cmd/resource"
+	privateendpointregionalmode "github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint-regional-mode/cmd/resource"
+	privateendpointservice "github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint-service/cmd/resource"
+	privateendpoint "github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint/cmd/resource"
+	privatelinkendpointservicedatafederationonlinearchive "github.com/mongodb/mongodbatlas-cloudformation-resources/privatelink-endpoint-service-data-federation-online-archive/cmd/resource"
+	projectinvitation "github.com/mongodb/mongodbatlas-cloudformation-resources/project-invitation/cmd/resource"
+	projectipaccesslist "github.com/mongodb/mongodbatlas-cloudformation-resources/project-ip-access-list/cmd/resource"
+	project "github.com/mongodb/mongodbatlas-cloudformation-resources/project/cmd/resource"
+	resourcepolicy "github.com/mongodb/mongodbatlas-cloudformation-resources/resource-policy/cmd/resource"
+	searchdeployment "github.com/mongodb/mongodbatlas-cloudformation-resources/search-deployment/cmd/resource"
+	searchindex "github.com/mongodb/mongodbatlas-cloudformation-resources/search-index/cmd/resource"
+	serverlessinstance "github.com/mongodb/mongodbatlas-cloudformation-resources/serverless-instance/cmd/resource"
+	serverlessprivateendpoint "github.com/mongodb/mongodbatlas-cloudformation-resources/serverless-private-endpoint/cmd/resource"
+	streamconnection "github.com/mongodb/mongodbatlas-cloudformation-resources/stream-connection/cmd/resource"
+	streaminstance "github.com/mongodb/mongodbatlas-cloudformation-resources/stream-instance/cmd/resource"
+	teams "github.com/mongodb/mongodbatlas-cloudformation-resources/teams/cmd/resource"
+	thirdpartyintegration "github.com/mongodb/mongodbatlas-cloudformation-resources/third-party-integration/cmd/resource"
+	trigger "github.com/mongodb/mongodbatlas-cloudformation-resources/trigger/cmd/resource"
+	x509authenticationdatabaseuser "github.com/mongodb/mongodbatlas-cloudformation-resources/x509-authentication-database-user/cmd/resource"
+)
+
+// resources are the handlers of every resource, by resource directory.
+var resources = map[string]cfn.Handler{
+	"access-list-api-key":                  accesslistapikey.Handler(),
+	"alert-configuration":                  alertconfiguration.Handler(),
+	"api-key":                              apikey.Handler(),
+	"auditing":                             auditing.Handler(),
+	"cloud-backup-restore-jobs":            cloudbackuprestorejobs.Handler(),
+	"cloud-backup-schedule":                cloudbackupschedule.Handler(),
+	"cloud-backup-snapshot-export-bucket":  cloudbackupsnapshotexportbucket.Handler(),
+	"cloud-backup-snapshot":                cloudbackupsnapshot.Handler(),
+	"cluster-outage-simulation":            clusteroutagesimulation.Handler(),
+	"cluster":                              cluster.Handler(),
+	"custom-db-role":                       customdbrole.Handler(),
+	"custom-dns-configuration-cluster-aws": customdnsconfigurationclusteraws.Handler(),
+	"data-lake-pipeline":                   datalakepipeline.Handler(),
+	"database-user":                        databaseuser.Handler(),
+	"datalakes":                            datalakes.Handler(),
+	"encryption-at-rest":                   encryptionatrest.Handler(),
+	"federated-database-instance":          federateddatabaseinstance.Handler(),
+	"federated-query-limit":                federatedquerylimit.Handler(),
+	"federated-settings-org-role-mapping":  federatedsettingsorgrolemapping.Handler(),
+	"global-cluster-config":                globalclusterconfig.Handler(),
+	"ldap-configuration":                   ldapconfiguration.Handler(),
+	"ldap-verify":                          ldapverify.Handler(),
+	"maintenance-window":                   maintenancewindow.Handler(),
+	"network-container":                    networkcontainer.Handler(),
+	"network-peering":                      networkpeering.Handler(),
+	"online-archive":                       onlinearchive.Handler(),
+	"org-invitation":                       orginvitation.Handler(),
+	"organization":                         organization.Handler(),
+	"private-endpoint-adl":                 privateendpointadl.Handler(),
+	"private-endpoint-aws":                 privateendpointaws.Handler(),
+	"private-endpoint-regional-mode":       privateendpointregionalmode.Handler(),
+	"private-endpoint-service":             privateendpointservice.Handler(),
+	"private-endpoint":                     privateendpoint.Handler(),
+	"privatelink-endpoint-service-data-federation-online-archive": privatelinkendpointservicedatafederationonlinearchive.Handler(),
+	"project-invitation":                projectinvitation.Handler(),
+	"project-ip-access-list":            projectipaccesslist.Handler(),
+	"project":                           project.Handler(),
+	"resource-policy":                   resourcepolicy.Handler(),
+	"search-deployment":                 searchdeployment.Handler(),
+	"search-index":                      searchindex.Handler(),
+	"serverless-instance":               serverlessinstance.Handler(),
+	"serverless-private-endpoint":       serverlessprivateendpoint.Handler(),
+	"stream-connection":                 streamconnection.Handler(),
+	"stream-instance":                   streaminstance.Handler(),
+	"teams":                             teams.Handler(),
+	"third-party-integration":           thirdpartyintegration.Handler(),
+	"trigger":                           trigger.Handler(),
+	"x509-authentication-database-user": x509authenticationdatabaseuser.Handler(),
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// schema-generator copies the schema of every resource with a generated model to util/validator/schemas,
+// named by resource directory, e.g. cluster.json, so they can be embedded by the validator.
+// The schemas are compacted, their content is not changed.
+//
+// It's run with `go generate ./util/validator` from cfn-resources.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	root, outDir := ".", filepath.Join("util", "validator", "schemas")
+	if _, err := os.Stat(filepath.Join(root, "go.mod")); err != nil {
+		// invoked by go generate from util/validator
+		root, outDir = filepath.Join("..", ".."), "schemas"
+	}
+
+	models, err := filepath.Glob(filepath.Join(root, "*", "cmd", "resource", "model.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	old, err := filepath.Glob(filepath.Join(outDir, "*.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range old {
+		if err := os.Remove(f); err != nil {
+			log.Fatal(err)
+		}
+	}
+	for _, model := range models {
+		dir := filepath.Base(filepath.Dir(filepath.Dir(filepath.Dir(model))))
+		if err := copySchema(filepath.Join(root, dir), filepath.Join(outDir, dir+".json")); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func copySchema(dir, path string) error {
+	schemas, err := filepath.Glob(filepath.Join(dir, "mongodb-atlas-*.json"))
+	if err != nil {
+		return err
+	}
+	if len(schemas) != 1 {
+		return fmt.Errorf("expected one schema in %s, found %d", dir, len(schemas))
+	}
+	data, err := os.ReadFile(schemas[0])
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	if err := json.Compact(&b, data); err != nil {
+		return fmt.Errorf("invalid schema %s: %w", schemas[0], err)
+	}
+	b.WriteByte('\n')
+	return os.WriteFile(path, b.Bytes(), 0o600)
+}
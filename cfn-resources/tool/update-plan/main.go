@@ -0,0 +1,90 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// update-plan prints the Atlas API calls the Update handler of a resource makes to go from a previous to a current
+// model, given as the JSON files of their properties, e.g. taken from the template before and after a change:
+//
+//	go run ./tool/update-plan -resource cluster -prev prev.json -current current.json
+//
+// It exits with status 2 if one of the calls is disruptive, so it can guard a deployment pipeline.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+
+	apikey "github.com/mongodb/mongodbatlas-cloudformation-resources/api-key/cmd/resource"
+	cluster "github.com/mongodb/mongodbatlas-cloudformation-resources/cluster/cmd/resource"
+	project "github.com/mongodb/mongodbatlas-cloudformation-resources/project/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/plan"
+)
+
+var planners = map[string]func(prev, current []byte) (*plan.Plan, error){
+	"api-key": planner(apikey.PlanUpdate),
+	"cluster": planner(cluster.PlanUpdate),
+	"project": planner(project.PlanUpdate),
+}
+
+func main() {
+	resource := flag.String("resource", "", "resource type: api-key, cluster or project")
+	prevFile := flag.String("prev", "", "JSON file of the properties of the previous model")
+	currentFile := flag.String("current", "", "JSON file of the properties of the current model")
+	flag.Parse()
+
+	planUpdate, ok := planners[*resource]
+	if !ok || *prevFile == "" || *currentFile == "" {
+		names := make([]string, 0, len(planners))
+		for name := range planners {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		log.Fatalf("usage: update-plan -resource %v -prev file -current file", names)
+	}
+
+	prev, err := os.ReadFile(*prevFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	current, err := os.ReadFile(*currentFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	p, err := planUpdate(prev, current)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(p)
+	if p.Disruptive() {
+		os.Exit(2)
+	}
+}
+
+// planner decodes the models of a resource for its PlanUpdate function.
+func planner[M any](planUpdate func(prevModel, currentModel *M) *plan.Plan) func(prev, current []byte) (*plan.Plan, error) {
+	return func(prev, current []byte) (*plan.Plan, error) {
+		prevModel, currentModel := new(M), new(M)
+		if err := json.Unmarshal(prev, prevModel); err != nil {
+			return nil, fmt.Errorf("previous model: %w", err)
+		}
+		if err := json.Unmarshal(current, currentModel); err != nil {
+			return nil, fmt.Errorf("current model: %w", err)
+		}
+		return planUpdate(prevModel, currentModel), nil
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"go.mongodb.org/realm/realm"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/testutil/fakeatlas"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/trigger/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+)
+
+func TestReadFromIdentifier(t *testing.T) {
+	s := fakeatlas.New(t)
+	s.Stub("GET /api/admin/v3.0/groups/{groupId}/apps/{appId}/triggers/{triggerId}", realm.EventTrigger{
+		ID:           "650000000000000000000003",
+		Name:         "trigger",
+		Type:         "DATABASE",
+		FunctionID:   "650000000000000000000004",
+		FunctionName: "onChange",
+		Disabled:     aws.Bool(false),
+		Config: realm.EventTriggerConfig{
+			OperationTypes:           []string{"INSERT", "UPDATE"},
+			ServiceID:                "650000000000000000000005",
+			Database:                 "store",
+			Collection:               "orders",
+			Match:                    map[string]any{"fullDocument.status": "active"},
+			FullDocument:             aws.Bool(true),
+			FullDocumentBeforeChange: aws.Bool(false),
+			Unordered:                aws.Bool(false),
+		},
+		EventProcessors: map[string]any{
+			"AWS_EVENTBRIDGE": map[string]any{
+				"config": map[string]any{"account_id": "123456789012", "region": "us-east-1", "extended_json_enabled": true},
+			},
+		},
+	})
+	testutil.Test(t, testutil.TestCase{
+		Name:           "trigger",
+		Schema:         "../../mongodb-atlas-trigger.json",
+		RequestContext: s.RequestContext(),
+		TestHandler:    handlerkit.NewHandler(handlerkit.Funcs[resource.Model]{Read: resource.Read}),
+		Steps: []testutil.TestStep{{
+			Operation: testutil.OperationRead,
+			Config: `{"Profile": "default", "ProjectId": "650000000000000000000001", "AppId": "650000000000000000000002",
+				"Id": "650000000000000000000003", "Name": "trigger", "Type": "DATABASE", "Disabled": false,
+				"FunctionId": "650000000000000000000004", "FunctionName": "onChange",
+				"DatabaseTrigger": {"ServiceId": "650000000000000000000005", "Database": "store", "Collection": "orders",
+					"OperationTypes": ["INSERT", "UPDATE"], "Match": "{\"fullDocument.status\":\"active\"}",
+					"FullDocument": true, "FullDocumentBeforeChange": false, "Unordered": false, "SkipCatchupEvents": true},
+				"EventProcessors": {"AWSEVENTBRIDGE": {"AWSConfig": {"AccountId": "123456789012", "Region": "us-east-1",
+					"ExtendedJsonEnabled": true}}}}`,
+		}},
+	})
+}
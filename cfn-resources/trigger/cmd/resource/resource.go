@@ -19,14 +19,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
@@ -47,21 +47,32 @@ var UpdateRequiredFields = []string{constants.ProjectID, constants.AppID, consta
 var DeleteRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID}
 var ListRequiredFields = []string{constants.ProjectID, constants.AppID}
 
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
+var kit = handlerkit.Resource[Model]{
+	TypeName: "mongodb-atlas-trigger",
+	Profile:  func(m *Model) **string { return &m.Profile },
+	// the triggers are managed with the Realm client, GetRealmClient is called by each operation
+	Create: handlerkit.Operation[Model]{Run: create, RequiredFields: CreateRequiredFields, SkipClient: true},
+	// a missing Id is reported as not found before the required fields are validated
+	Read:   handlerkit.Operation[Model]{Run: read, SkipClient: true},
+	Update: handlerkit.Operation[Model]{Run: update, SkipClient: true},
+	Delete: handlerkit.Operation[Model]{Run: deleteResource, SkipClient: true},
+	List:   handlerkit.Operation[Model]{Run: list, RequiredFields: ListRequiredFields, SkipClient: true},
 }
 
-func setup() {
-	util.SetupLogger("trigger")
-}
+var (
+	Create = kit.Func(constants.CREATE)
+	Read   = kit.Func(constants.READ)
+	Update = kit.Func(constants.UPDATE)
+	Delete = kit.Func(constants.DELETE)
+	List   = kit.Func(constants.LIST)
+)
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-	if errEvent := validateModel(CreateRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-	setProfileIfAbsent(currentModel)
+// Handler returns the cfn.Handler of the resource, started by cmd/main.go.
+func Handler() cfn.Handler {
+	return kit.Handler()
+}
 
+func create(req handler.Request, _ *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	ctx := context.Background()
 	client, err := util.GetRealmClient(ctx, req, currentModel.Profile)
 	if err != nil {
@@ -77,7 +88,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	et, resp, err := client.EventTriggers.Create(ctx, *currentModel.ProjectId, *currentModel.AppId, eventTrigger)
 	if err != nil {
 		_, _ = logger.Warnf("error in creating event trigger %v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), resp.Response), nil
+		return progressevents.GetFailedEventByError(err, resp.Response), nil
 	}
 	currentModel.Id = &et.ID
 
@@ -87,16 +98,15 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+func read(req handler.Request, _ *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.Id == nil {
 		err := errors.New("no Id found in currentModel")
 		return progressevents.GetFailedEventByCode(err.Error(),
 			cloudformation.HandlerErrorCodeNotFound), nil
 	}
-	if errEvent := validateModel(ReadRequiredFields, currentModel); errEvent != nil {
+	if errEvent := validator.ValidateModel(ReadRequiredFields, currentModel); errEvent != nil {
 		return *errEvent, nil
 	}
-	setProfileIfAbsent(currentModel)
 
 	ctx := context.Background()
 	client, err := util.GetRealmClient(ctx, req, currentModel.Profile)
@@ -108,9 +118,12 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	trigger, resp, err := client.EventTriggers.Get(ctx, *currentModel.ProjectId, *currentModel.AppId, *currentModel.Id)
 	if err != nil {
 		_, _ = logger.Warnf("error in getting event trigger %v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), resp.Response), nil
+		return progressevents.GetFailedEventByError(err, resp.Response), nil
+	}
+	if err := currentModel.completeByTrigger(trigger); err != nil {
+		return progressevents.GetFailedEventByCode(fmt.Sprintf("Error reading event trigger : %s", err.Error()),
+			cloudformation.HandlerErrorCodeInternalFailure), nil
 	}
-	currentModel.Id = &trigger.ID
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -118,16 +131,15 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+func update(req handler.Request, _ *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.Id == nil {
 		err := errors.New("no Id found in currentModel")
 		return progressevents.GetFailedEventByCode(err.Error(),
 			cloudformation.HandlerErrorCodeNotFound), nil
 	}
-	if errEvent := validateModel(UpdateRequiredFields, currentModel); errEvent != nil {
+	if errEvent := validator.ValidateModel(UpdateRequiredFields, currentModel); errEvent != nil {
 		return *errEvent, nil
 	}
-	setProfileIfAbsent(currentModel)
 
 	ctx := context.Background()
 	client, err := util.GetRealmClient(ctx, req, currentModel.Profile)
@@ -144,7 +156,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	_, resp, err := client.EventTriggers.Update(ctx, *currentModel.ProjectId, *currentModel.AppId, *currentModel.Id, eventTrigger)
 	if err != nil {
 		_, _ = logger.Warnf("error in updating event trigger %v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), resp.Response), nil
+		return progressevents.GetFailedEventByError(err, resp.Response), nil
 	}
 
 	return handler.ProgressEvent{
@@ -153,16 +165,15 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+func deleteResource(req handler.Request, _ *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	if currentModel.Id == nil {
 		err := errors.New("no Id found in currentModel")
 		return progressevents.GetFailedEventByCode(err.Error(),
 			cloudformation.HandlerErrorCodeNotFound), nil
 	}
-	if errEvent := validateModel(DeleteRequiredFields, currentModel); errEvent != nil {
+	if errEvent := validator.ValidateModel(DeleteRequiredFields, currentModel); errEvent != nil {
 		return *errEvent, nil
 	}
-	setProfileIfAbsent(currentModel)
 
 	ctx := context.Background()
 	client, err := util.GetRealmClient(ctx, req, currentModel.Profile)
@@ -174,7 +185,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	resp, err := client.EventTriggers.Delete(ctx, *currentModel.ProjectId, *currentModel.AppId, *currentModel.Id)
 	if err != nil {
 		_, _ = logger.Warnf("error in deleting event trigger %v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), resp.Response), nil
+		return progressevents.GetFailedEventByError(err, resp.Response), nil
 	}
 
 	return handler.ProgressEvent{
@@ -182,12 +193,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}, nil
 }
 
-func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	if errEvent := validateModel(ListRequiredFields, currentModel); errEvent != nil {
-		return *errEvent, nil
-	}
-	setProfileIfAbsent(currentModel)
-
+func list(req handler.Request, _ *util.MongoDBClient, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	ctx := context.Background()
 	client, err := util.GetRealmClient(ctx, req, currentModel.Profile)
 	if err != nil {
@@ -198,7 +204,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	triggers, resp, err := client.EventTriggers.List(ctx, *currentModel.ProjectId, *currentModel.AppId)
 	if err != nil {
 		_, _ = logger.Warnf("error in listing event trigger %v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), resp.Response), nil
+		return progressevents.GetFailedEventByError(err, resp.Response), nil
 	}
 
 	return handler.ProgressEvent{
@@ -207,12 +213,6 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func setProfileIfAbsent(model *Model) {
-	if model.Profile == nil || *model.Profile == "" {
-		model.Profile = aws.String(profile.DefaultProfile)
-	}
-}
-
 func newEventTrigger(model *Model) (*realm.EventTriggerRequest, error) {
 	et := realm.EventTriggerRequest{Disabled: model.Disabled}
 	if model.Name != nil {
@@ -323,7 +323,7 @@ func newEventProcessor(model *Model, et realm.EventTriggerRequest) (realm.EventT
 	var inInterface map[string]interface{}
 	inrec, err := json.Marshal(ep)
 	if err != nil {
-		log.Printf("error in marshal %v", err)
+		_, _ = logger.Errorf("error in marshal %v", err)
 		return et, err
 	}
 	err = json.Unmarshal(inrec, &inInterface)
@@ -335,6 +335,87 @@ func newEventProcessor(model *Model, et realm.EventTriggerRequest) (realm.EventT
 	return et, nil
 }
 
+// completeByTrigger sets the properties of the model from the trigger read from App Services.
+func (model *Model) completeByTrigger(trigger *realm.EventTrigger) error {
+	model.Id = &trigger.ID
+	model.Name = util.StringPtr(trigger.Name)
+	model.Type = util.StringPtr(trigger.Type)
+	model.Disabled = trigger.Disabled
+	model.FunctionId = util.StringPtr(trigger.FunctionID)
+	model.FunctionName = util.StringPtr(trigger.FunctionName)
+
+	conf := trigger.Config
+	switch trigger.Type {
+	case string(DATABASE):
+		match, err := marshalConfigJSON(conf.Match)
+		if err != nil {
+			return err
+		}
+		project, err := marshalConfigJSON(conf.Project)
+		if err != nil {
+			return err
+		}
+		model.DatabaseTrigger = &DatabaseConfig{
+			ServiceId:                util.StringPtr(conf.ServiceID),
+			Database:                 util.StringPtr(conf.Database),
+			Collection:               util.StringPtr(conf.Collection),
+			OperationTypes:           conf.OperationTypes,
+			Match:                    match,
+			Project:                  project,
+			FullDocument:             conf.FullDocument,
+			FullDocumentBeforeChange: conf.FullDocumentBeforeChange,
+			Unordered:                conf.Unordered,
+		}
+	case string(AUTHENTICATION):
+		model.AuthTrigger = &AuthConfig{
+			OperationType: util.StringPtr(conf.OperationType),
+			Providers:     conf.Providers,
+		}
+	case string(SCHEDULED):
+		model.ScheduleTrigger = &ScheduleConfig{Schedule: util.StringPtr(conf.Schedule)}
+	}
+
+	if len(trigger.EventProcessors) == 0 {
+		model.EventProcessors = nil
+		return nil
+	}
+	data, err := json.Marshal(trigger.EventProcessors)
+	if err != nil {
+		return err
+	}
+	ep := EventProcess{}
+	if err := json.Unmarshal(data, &ep); err != nil {
+		return err
+	}
+	model.EventProcessors = &Event{}
+	if ep.FUNCTION != nil && ep.FUNCTION.FuncConf != nil {
+		model.EventProcessors.FUNCTION = &FUNCTION{FuncConfig: &FuncConfig{
+			FunctionId:   ep.FUNCTION.FuncConf.FunctionID,
+			FunctionName: ep.FUNCTION.FuncConf.FunctionName,
+		}}
+	}
+	if ep.AWSEVENTBRIDGE != nil && ep.AWSEVENTBRIDGE.AWSConfig != nil {
+		model.EventProcessors.AWSEVENTBRIDGE = &AWSEVENTBRIDGE{AWSConfig: &AWSConfig{
+			AccountId:           ep.AWSEVENTBRIDGE.AWSConfig.AccountID,
+			Region:              ep.AWSEVENTBRIDGE.AWSConfig.Region,
+			ExtendedJsonEnabled: ep.AWSEVENTBRIDGE.AWSConfig.ExtendedJSONEnabled,
+		}}
+	}
+	return nil
+}
+
+// marshalConfigJSON returns the JSON string of a match or project expression of a database trigger, nil if not set.
+func marshalConfigJSON(v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return aws.String(string(data)), nil
+}
+
 // EventProcess These structs are created because the client has generic map for event processor
 // and cfn generate doesn't support tags
 type EventProcess struct {
@@ -0,0 +1,74 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth authenticates Atlas and Realm clients with Atlas service accounts,
+// using the OAuth2 client credentials flow.
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	realmAuth "go.mongodb.org/realm/auth"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	DefaultBaseURL = "https://cloud.mongodb.com"
+	TokenPath      = "/api/oauth/token"
+)
+
+// NewServiceAccountTokenSource returns a token source requesting access tokens for the service account
+// to the Atlas token endpoint of baseURL (DefaultBaseURL if empty). Tokens are cached and only requested
+// again shortly before they expire. httpClient, if not nil, is used for the token requests.
+func NewServiceAccountTokenSource(ctx context.Context, baseURL, clientID, clientSecret string, httpClient *http.Client) oauth2.TokenSource {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	config := clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     strings.TrimSuffix(baseURL, "/") + TokenPath,
+		AuthStyle:    oauth2.AuthStyleInHeader,
+	}
+	if httpClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+	}
+	return config.TokenSource(ctx)
+}
+
+// NewTransport returns a transport adding the service account access token to the requests sent through base.
+func NewTransport(base http.RoundTripper, src oauth2.TokenSource) http.RoundTripper {
+	return &oauth2.Transport{Base: base, Source: src}
+}
+
+// RealmTokenSource adapts a service account token source to the Realm client, so the Realm admin API
+// is called with the same access token as the Atlas API.
+func RealmTokenSource(src oauth2.TokenSource) realmAuth.TokenSource {
+	return realmTokenSource{src: src}
+}
+
+type realmTokenSource struct {
+	src oauth2.TokenSource
+}
+
+func (s realmTokenSource) Token() (*realmAuth.Token, error) {
+	t, err := s.src.Token()
+	if err != nil {
+		return nil, err
+	}
+	return &realmAuth.Token{AccessToken: t.AccessToken}, nil
+}
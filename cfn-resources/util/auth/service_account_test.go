@@ -0,0 +1,108 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenServer emulates the Atlas token endpoint and an API endpoint requiring the issued token.
+func tokenServer(t *testing.T, expiresIn int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var issued atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc(auth.TokenPath, func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "mdb_sa_id" || secret != "mdb_sa_sk" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Method != http.MethodPost || r.FormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		n := issued.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, n, expiresIn)
+	})
+	mux.HandleFunc("/api/atlas/v2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, r.Header.Get("Authorization"))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, &issued
+}
+
+func TestServiceAccountTokenIsCached(t *testing.T) {
+	srv, issued := tokenServer(t, 3600)
+	src := auth.NewServiceAccountTokenSource(context.Background(), srv.URL+"/", "mdb_sa_id", "mdb_sa_sk", srv.Client())
+
+	for range 3 {
+		token, err := src.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "token-1", token.AccessToken)
+	}
+	assert.Equal(t, int32(1), issued.Load())
+}
+
+func TestServiceAccountTokenIsRefreshed(t *testing.T) {
+	// tokens expiring in less than the refresh margin are requested again on every use.
+	srv, issued := tokenServer(t, 1)
+	src := auth.NewServiceAccountTokenSource(context.Background(), srv.URL, "mdb_sa_id", "mdb_sa_sk", nil)
+
+	first, err := src.Token()
+	require.NoError(t, err)
+	second, err := src.Token()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.AccessToken, second.AccessToken)
+	assert.Equal(t, int32(2), issued.Load())
+}
+
+func TestServiceAccountInvalidCredentials(t *testing.T) {
+	srv, _ := tokenServer(t, 3600)
+	src := auth.NewServiceAccountTokenSource(context.Background(), srv.URL, "mdb_sa_id", "wrong", nil)
+
+	_, err := src.Token()
+	require.Error(t, err)
+}
+
+func TestTransportAndRealmTokenSource(t *testing.T) {
+	srv, issued := tokenServer(t, 3600)
+	src := auth.NewServiceAccountTokenSource(context.Background(), srv.URL, "mdb_sa_id", "mdb_sa_sk", nil)
+
+	client := &http.Client{Transport: auth.NewTransport(http.DefaultTransport, src)}
+	resp, err := client.Get(srv.URL + "/api/atlas/v2")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-1", string(body))
+
+	realmToken, err := auth.RealmTokenSource(src).Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", realmToken.AccessToken)
+	assert.Equal(t, int32(1), issued.Load(), "Atlas and Realm share the cached token")
+}
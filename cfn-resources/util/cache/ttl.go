@@ -0,0 +1,112 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache keeps values between invocations of a warm Lambda.
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+type entry[V any] struct {
+	expires time.Time
+	value   V
+}
+
+// TTL is a map whose entries expire after a fixed duration, safe for concurrent use.
+// A TTL with a non positive duration doesn't cache anything.
+type TTL[K comparable, V any] struct {
+	entries map[K]entry[V]
+	now     func() time.Time
+	mu      sync.Mutex
+	ttl     time.Duration
+}
+
+func NewTTL[K comparable, V any](ttl time.Duration) *TTL[K, V] {
+	return &TTL[K, V]{
+		entries: make(map[K]entry[V]),
+		now:     time.Now,
+		ttl:     ttl,
+	}
+}
+
+// SetClock replaces the function used to expire the entries, meant for tests.
+func (c *TTL[K, V]) SetClock(now func() time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// Get returns the value of the key if present and not expired.
+func (c *TTL[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok || !c.now().Before(e.expires) {
+		delete(c.entries, key)
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Set stores the value for the key, expiring after the cache TTL.
+func (c *TTL[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ttl <= 0 {
+		return
+	}
+	c.entries[key] = entry[V]{value: value, expires: c.now().Add(c.ttl)}
+}
+
+// GetOrLoad returns the cached value of the key, calling load and caching its result if not present.
+// Errors are not cached. Concurrent calls for a missing key may call load more than once.
+func (c *TTL[K, V]) GetOrLoad(key K, load func() (V, error)) (V, error) {
+	if v, ok := c.Get(key); ok {
+		return v, nil
+	}
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+	c.Set(key, v)
+	return v, nil
+}
+
+// Delete removes the key.
+func (c *TTL[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// DeleteFunc removes the keys for which del returns true.
+func (c *TTL[K, V]) DeleteFunc(del func(key K, value V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.entries {
+		if del(k, e.value) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// Len returns the number of entries, including the expired ones not removed yet.
+func (c *TTL[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTTLExpires(t *testing.T) {
+	now := time.Now()
+	c := cache.NewTTL[string, int](time.Minute)
+	c.SetClock(func() time.Time { return now })
+
+	c.Set("a", 1)
+	v, ok := c.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Zero(t, c.Len())
+}
+
+func TestTTLGetOrLoad(t *testing.T) {
+	c := cache.NewTTL[string, int](time.Minute)
+	loads := 0
+	load := func() (int, error) {
+		loads++
+		return loads, nil
+	}
+
+	for range 3 {
+		v, err := c.GetOrLoad("a", load)
+		require.NoError(t, err)
+		assert.Equal(t, 1, v)
+	}
+	assert.Equal(t, 1, loads)
+
+	c.Delete("a")
+	v, err := c.GetOrLoad("a", load)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+}
+
+func TestTTLGetOrLoadDoesNotCacheErrors(t *testing.T) {
+	c := cache.NewTTL[string, int](time.Minute)
+	_, err := c.GetOrLoad("a", func() (int, error) { return 0, errors.New("throttled") })
+	require.Error(t, err)
+	assert.Zero(t, c.Len())
+}
+
+func TestTTLDisabled(t *testing.T) {
+	c := cache.NewTTL[string, int](0)
+	c.Set("a", 1)
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+}
+
+func TestTTLDeleteFunc(t *testing.T) {
+	c := cache.NewTTL[string, int](time.Minute)
+	c.Set("secret@v1", 1)
+	c.Set("secret@v2", 2)
+	c.Set("other@v1", 3)
+	c.DeleteFunc(func(_ string, v int) bool { return v < 3 })
+	assert.Equal(t, 1, c.Len())
+	_, ok := c.Get("other@v1")
+	assert.True(t, ok)
+}
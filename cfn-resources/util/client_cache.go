@@ -0,0 +1,57 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"strings"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/cache"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/transport"
+)
+
+type cachedClient struct {
+	client *MongoDBClient
+	budget *transport.Budget
+}
+
+// atlasClients caches the Atlas clients while the Lambda is warm, by profile secret and secret version,
+// so a new secret version gets a new client. Clients are removed when Atlas rejects their credentials.
+var atlasClients = cache.NewTTL[string, cachedClient](profile.CacheTTL())
+
+func cachedAtlasClient(key string) (*MongoDBClient, bool) {
+	if key == "" {
+		return nil, false
+	}
+	cached, ok := atlasClients.Get(key)
+	if !ok {
+		return nil, false
+	}
+	// the retry budget is per invocation
+	cached.budget.Reset()
+	return cached.client, true
+}
+
+func cacheAtlasClient(key string, client *MongoDBClient, budget *transport.Budget) {
+	if key == "" {
+		return
+	}
+	// clients of previous versions of the secret won't be used anymore
+	secret, _, _ := strings.Cut(key, "@")
+	atlasClients.DeleteFunc(func(k string, _ cachedClient) bool {
+		return strings.HasPrefix(k, secret+"@")
+	})
+	atlasClients.Set(key, cachedClient{client: client, budget: budget})
+}
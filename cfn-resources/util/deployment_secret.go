@@ -16,35 +16,76 @@ package util
 
 import (
 	"encoding/json"
-	"log"
-	"os"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/rs/xid"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 )
 
 type DeploymentSecret struct {
-	ResourceID *ResourceIdentifier `json:"ResourceID"`
-	Properties *map[string]string  `json:"Properties"`
-	PublicKey  string              `json:"PublicKey"`
-	PrivateKey string              `json:"PrivateKey"`
+	ResourceID *URN               `json:"ResourceID"`
+	Properties *map[string]string `json:"Properties"`
+	PublicKey  string             `json:"PublicKey"`
+	PrivateKey string             `json:"PrivateKey"`
+}
+
+// legacyResourceID is the ResourceID of the deployment secrets written before the URNs: the object of the resource,
+// nested in the objects containing it.
+type legacyResourceID struct {
+	Parent       *legacyResourceID
+	ResourceType string
+	ResourceID   string
 }
 
-func CreateDeploymentSecret(req *handler.Request, cfnID *ResourceIdentifier, publicKey, privateKey string, properties map[string]string) (*string, error) {
+func (r *legacyResourceID) urn() URN {
+	if r.Parent == nil {
+		return NewURN(r.ResourceType, r.ResourceID)
+	}
+	return r.Parent.urn().Child(r.ResourceType, r.ResourceID)
+}
+
+// UnmarshalJSON reads the ResourceID as a URN, or as the object of the secrets written before the URNs.
+func (s *DeploymentSecret) UnmarshalJSON(data []byte) error {
+	type deploymentSecret DeploymentSecret
+	var secret struct {
+		deploymentSecret
+		ResourceID json.RawMessage `json:"ResourceID"`
+	}
+	if err := json.Unmarshal(data, &secret); err != nil {
+		return err
+	}
+	*s = DeploymentSecret(secret.deploymentSecret)
+	if len(secret.ResourceID) == 0 || string(secret.ResourceID) == "null" {
+		return nil
+	}
+	var urn URN
+	if secret.ResourceID[0] == '"' {
+		if err := json.Unmarshal(secret.ResourceID, &urn); err != nil {
+			return err
+		}
+	} else {
+		var legacy legacyResourceID
+		if err := json.Unmarshal(secret.ResourceID, &legacy); err != nil {
+			return err
+		}
+		urn = legacy.urn()
+	}
+	s.ResourceID = &urn
+	return nil
+}
+
+func CreateDeploymentSecret(req *handler.Request, cfnID *URN, publicKey, privateKey string, properties map[string]string) (*string, error) {
 	deploySecret := &DeploymentSecret{
 		PublicKey:  publicKey,
 		PrivateKey: privateKey,
 		ResourceID: cfnID,
 		Properties: &properties,
 	}
-	log.Printf("deploySecret: %v", deploySecret)
+	_, _ = logger.Debugf("deploySecret: %+v", deploySecret)
 	deploySecretString, _ := json.Marshal(deploySecret)
-	log.Printf("deploySecretString: %s", deploySecretString)
-
-	log.Println("===============================================")
-	log.Printf("%+v", os.Environ())
-	log.Println("===============================================")
 
 	// sess := credentials.SessionFromCredentialsProvider(creds)
 	// create a new secret from this struct with the json string
@@ -52,9 +93,10 @@ func CreateDeploymentSecret(req *handler.Request, cfnID *ResourceIdentifier, pub
 	// Create service client value configured for credentials
 	// from assumed role.
 	svc := secretsmanager.New(req.Session)
+	// the URN has characters not allowed in a secret name, the secret is named after a unique ID
 	input := &secretsmanager.CreateSecretInput{
 		Description:  aws.String("MongoDB Atlas Quickstart Deployment Secret"),
-		Name:         aws.String(cfnID.String()),
+		Name:         aws.String("cfn/atlas/deployment/" + xid.New().String()),
 		SecretString: aws.String(string(deploySecretString)),
 	}
 
@@ -62,10 +104,10 @@ func CreateDeploymentSecret(req *handler.Request, cfnID *ResourceIdentifier, pub
 	if err != nil {
 		// Print the error, cast err to awserr. Error to get the Code and
 		// Message from an error.
-		log.Printf("error create secret: %+v", err.Error())
+		_, _ = logger.Errorf("error create secret: %+v", err.Error())
 		return nil, err
 	}
-	log.Printf("Created secret result:%+v", result)
+	_, _ = logger.Debugf("Created secret result:%+v", result)
 	return result.Name, nil
 }
 
@@ -73,14 +115,14 @@ func GetAPIKeyFromDeploymentSecret(req *handler.Request, secretName string) (Dep
 	sm := secretsmanager.New(req.Session)
 	output, err := sm.GetSecretValue(&secretsmanager.GetSecretValueInput{SecretId: &secretName})
 	if err != nil {
-		log.Printf("Error --- %v", err.Error())
+		_, _ = logger.Errorf("Error --- %v", err.Error())
 		return DeploymentSecret{}, err
 	}
 
 	var key DeploymentSecret
 	err = json.Unmarshal([]byte(*output.SecretString), &key)
 	if err != nil {
-		log.Printf("Error --- %v", err.Error())
+		_, _ = logger.Errorf("Error --- %v", err.Error())
 		return key, err
 	}
 
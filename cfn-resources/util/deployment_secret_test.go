@@ -0,0 +1,65 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentSecretUnmarshal(t *testing.T) {
+	urn := util.ClusterURN("5f0000000000000000000001", "cluster")
+	testCases := map[string]struct {
+		secret   string
+		expected *util.URN
+	}{
+		"urn": {
+			secret:   `{"ResourceID": "atlas:project/5f0000000000000000000001/cluster/cluster", "PublicKey": "public", "PrivateKey": "private"}`,
+			expected: &urn,
+		},
+		"legacy": {
+			secret: `{"ResourceID": {"ServiceName": "mongodb", "DeploymentID": "cbs4ug2", "ResourceType": "cluster", "ResourceID": "cluster",
+				"Parent": {"ResourceType": "project", "ResourceID": "5f0000000000000000000001"}}, "PublicKey": "public", "PrivateKey": "private"}`,
+			expected: &urn,
+		},
+		"no resource": {
+			secret: `{"ResourceID": null, "PublicKey": "public", "PrivateKey": "private"}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var secret util.DeploymentSecret
+			require.NoError(t, json.Unmarshal([]byte(tc.secret), &secret))
+			assert.Equal(t, tc.expected, secret.ResourceID)
+			assert.Equal(t, "public", secret.PublicKey)
+			assert.Equal(t, "private", secret.PrivateKey)
+		})
+	}
+}
+
+func TestDeploymentSecretRoundTrip(t *testing.T) {
+	urn := util.DatabaseUserURN("5f0000000000000000000001", "admin", "user/name")
+	data, err := json.Marshal(util.DeploymentSecret{ResourceID: &urn, Properties: &map[string]string{"key": "value"}})
+	require.NoError(t, err)
+
+	var secret util.DeploymentSecret
+	require.NoError(t, json.Unmarshal(data, &secret))
+	assert.Equal(t, &urn, secret.ResourceID)
+	assert.Equal(t, map[string]string{"key": "value"}, *secret.Properties)
+}
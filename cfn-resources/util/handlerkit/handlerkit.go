@@ -0,0 +1,260 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package handlerkit contains the boilerplate shared by all the resource handlers: logger setup, profile defaulting,
+// required fields validation, Atlas client construction and conversion of panics into failed progress events.
+// Resources declare their typed CRUDL actions once in a Resource, expose the functions returned by Func and the
+// cfn.Handler returned by Handler, which their cmd/main.go starts with cfn.Start and tool/runhandler invokes.
+package handlerkit
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn"
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+// clientRequestToken is logged with the requests, see SetClientRequestToken.
+var clientRequestToken string
+
+func init() {
+	// cfn.Start and the plugin log through the standard library logger, they're routed to util/logger
+	// so their lines are JSON records with the correlation fields too.
+	log.SetFlags(0)
+	log.SetOutput(logger.Writer(logger.InfoLevel))
+}
+
+// SetClientRequestToken sets the client request token logged with the following requests. The Go plugin doesn't
+// forward the token in handler.Request, so it's only logged when the caller invoking the handlers knows it, e.g. runhandler.
+func SetClientRequestToken(token string) {
+	clientRequestToken = token
+}
+
+// Func is the signature of the CRUDL functions exposed by the resource packages.
+type Func[M any] func(req handler.Request, prevModel, currentModel *M) (handler.ProgressEvent, error)
+
+// Action is the resource specific part of a handler. It receives the models already validated
+// and, unless the operation sets SkipClient, an Atlas client for the model profile.
+type Action[M any] func(req handler.Request, client *util.MongoDBClient, prevModel, currentModel *M) (handler.ProgressEvent, error)
+
+// Operation describes one of the CRUDL handlers of a resource.
+type Operation[M any] struct {
+	Run            Action[M]
+	RequiredFields []string
+	// SkipClient avoids creating the Atlas client for operations that do not call Atlas or that create their own client.
+	SkipClient bool
+}
+
+// Resource groups the operations of a CloudFormation resource with its typed model M.
+// Operations without Run are reported as not implemented.
+type Resource[M any] struct {
+	// Profile returns the address of the Profile field of the model, it's used to default the profile name.
+	Profile func(model *M) **string
+	// NewClient creates the Atlas client for the profile, it defaults to util.NewAtlasClient.
+	NewClient func(req *handler.Request, profileName *string) (*util.MongoDBClient, *handler.ProgressEvent)
+	// TypeName is used as logger prefix, e.g. mongodb-atlas-cluster.
+	TypeName string
+	// Identify sets the identifying properties missing from the model from its URN, e.g. to read, delete or import
+	// a resource with several identifying properties by a single string. It's called before the required fields are
+	// validated, in every operation but Create and List.
+	Identify func(model *M) error
+	Create   Operation[M]
+	Read     Operation[M]
+	Update   Operation[M]
+	Delete   Operation[M]
+	List     Operation[M]
+}
+
+// Func returns the handler function of the given operation. The returned function has an unnamed type
+// so it can be assigned to the Create, Read, Update, Delete and List variables of the resource package.
+func (r *Resource[M]) Func(action constants.CfnFunctions) func(req handler.Request, prevModel, currentModel *M) (handler.ProgressEvent, error) {
+	return func(req handler.Request, prevModel, currentModel *M) (handler.ProgressEvent, error) {
+		return r.handle(action, req, prevModel, currentModel)
+	}
+}
+
+// Handler returns a cfn.Handler running the resource operations, which can be used by cfn.Start or tools invoking handlers directly.
+func (r *Resource[M]) Handler() cfn.Handler {
+	return NewHandler(Funcs[M]{
+		Create: r.Func(constants.CREATE),
+		Read:   r.Func(constants.READ),
+		Update: r.Func(constants.UPDATE),
+		Delete: r.Func(constants.DELETE),
+		List:   r.Func(constants.LIST),
+	})
+}
+
+func (r *Resource[M]) operation(action constants.CfnFunctions) Operation[M] {
+	switch action {
+	case constants.CREATE:
+		return r.Create
+	case constants.READ:
+		return r.Read
+	case constants.UPDATE:
+		return r.Update
+	case constants.DELETE:
+		return r.Delete
+	case constants.LIST:
+		return r.List
+	default:
+		return Operation[M]{}
+	}
+}
+
+func (r *Resource[M]) handle(action constants.CfnFunctions, req handler.Request, prevModel, currentModel *M) (event handler.ProgressEvent, err error) {
+	defer recoverPanic(&event, &err)
+
+	op := r.operation(action)
+	if op.Run == nil {
+		return handler.ProgressEvent{}, fmt.Errorf("not implemented: %s", action)
+	}
+
+	if r.TypeName != "" {
+		util.SetupLogger(r.TypeName)
+	}
+	logger.SetAction(string(action))
+	logger.SetRequest(req.LogicalResourceID, req.RequestContext.StackID)
+	logger.SetClientRequestToken(clientRequestToken)
+
+	profileName := aws.String(profile.DefaultProfile)
+	if r.Profile != nil {
+		p := r.Profile(currentModel)
+		util.SetDefaultProfileIfNotDefined(p)
+		profileName = *p
+	}
+
+	if r.Identify != nil && action != constants.CREATE && action != constants.LIST {
+		if err := r.Identify(currentModel); err != nil {
+			_, _ = logger.Warnf("%s - invalid identifier: %v", action, err)
+			return handler.ProgressEvent{
+				OperationStatus:  handler.Failed,
+				Message:          err.Error(),
+				HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest,
+			}, nil
+		}
+	}
+
+	if errEvent := validator.ValidateModel(op.RequiredFields, currentModel); errEvent != nil {
+		_, _ = logger.Warnf("%s - validation error: %s", action, errEvent.Message)
+		return *errEvent, nil
+	}
+
+	var client *util.MongoDBClient
+	if !op.SkipClient {
+		newClient := r.NewClient
+		if newClient == nil {
+			newClient = util.NewAtlasClient
+		}
+		var peErr *handler.ProgressEvent
+		if client, peErr = newClient(&req, profileName); peErr != nil {
+			return *peErr, nil
+		}
+	}
+
+	return op.Run(req, client, prevModel, currentModel)
+}
+
+// Funcs are the CRUDL functions of a resource package, nil functions are reported as not implemented.
+type Funcs[M any] struct {
+	Create Func[M]
+	Read   Func[M]
+	Update Func[M]
+	Delete Func[M]
+	List   Func[M]
+}
+
+// NewHandler returns a cfn.Handler that unmarshals the request models into M and calls the resource functions.
+func NewHandler[M any](funcs Funcs[M]) cfn.Handler {
+	return &typedHandler[M]{funcs: funcs}
+}
+
+type typedHandler[M any] struct {
+	funcs Funcs[M]
+}
+
+func (h *typedHandler[M]) Create(req handler.Request) handler.ProgressEvent {
+	return Wrap(req, h.funcs.Create)
+}
+
+func (h *typedHandler[M]) Read(req handler.Request) handler.ProgressEvent {
+	return Wrap(req, h.funcs.Read)
+}
+
+func (h *typedHandler[M]) Update(req handler.Request) handler.ProgressEvent {
+	return Wrap(req, h.funcs.Update)
+}
+
+func (h *typedHandler[M]) Delete(req handler.Request) handler.ProgressEvent {
+	return Wrap(req, h.funcs.Delete)
+}
+
+func (h *typedHandler[M]) List(req handler.Request) handler.ProgressEvent {
+	return Wrap(req, h.funcs.List)
+}
+
+// Wrap populates the previous and current models from the request, calls f and converts
+// returned errors and panics into failed progress events.
+func Wrap[M any](req handler.Request, f Func[M]) (response handler.ProgressEvent) {
+	var panicErr error
+	defer recoverPanic(&response, &panicErr)
+
+	if f == nil {
+		return handler.NewFailedEvent(errors.New("not implemented"))
+	}
+
+	prevModel := new(M)
+	if err := req.UnmarshalPrevious(prevModel); err != nil {
+		_, _ = logger.Errorf("Error unmarshaling prev model: %v", err)
+		return handler.NewFailedEvent(err)
+	}
+
+	currentModel := new(M)
+	if err := req.Unmarshal(currentModel); err != nil {
+		_, _ = logger.Errorf("Error unmarshaling model: %v", err)
+		return handler.NewFailedEvent(err)
+	}
+
+	response, err := f(req, prevModel, currentModel)
+	if err != nil {
+		_, _ = logger.Errorf("Error returned from handler function: %v", err)
+		return handler.NewFailedEvent(err)
+	}
+
+	return response
+}
+
+// recoverPanic must be deferred, it converts a panic into a failed progress event.
+func recoverPanic(event *handler.ProgressEvent, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	panicErr, ok := r.(error)
+	if !ok {
+		panicErr = fmt.Errorf("%v", r)
+	}
+	_, _ = logger.Errorf("Trapped error in handler: %v", panicErr)
+	*event = handler.NewFailedEvent(panicErr)
+	*err = nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlerkit_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/handlerkit"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type model struct {
+	Profile   *string `json:",omitempty"`
+	ProjectId *string `json:",omitempty"` //nolint:revive,stylecheck // same name as generated models
+	Name      *string `json:",omitempty"`
+}
+
+func newResource(clientProfiles *[]string) *handlerkit.Resource[model] {
+	return &handlerkit.Resource[model]{
+		Profile: func(m *model) **string { return &m.Profile },
+		NewClient: func(_ *handler.Request, profileName *string) (*util.MongoDBClient, *handler.ProgressEvent) {
+			*clientProfiles = append(*clientProfiles, *profileName)
+			return &util.MongoDBClient{}, nil
+		},
+		Create: handlerkit.Operation[model]{
+			RequiredFields: []string{constants.ProjectID},
+			Run: func(_ handler.Request, client *util.MongoDBClient, _, currentModel *model) (handler.ProgressEvent, error) {
+				if client == nil {
+					return handler.ProgressEvent{}, errors.New("missing client")
+				}
+				return handler.ProgressEvent{OperationStatus: handler.Success, ResourceModel: currentModel}, nil
+			},
+		},
+		Read: handlerkit.Operation[model]{
+			SkipClient: true,
+			Run: func(_ handler.Request, _ *util.MongoDBClient, _, _ *model) (handler.ProgressEvent, error) {
+				panic("unexpected state")
+			},
+		},
+		Update: handlerkit.Operation[model]{
+			Run: func(_ handler.Request, _ *util.MongoDBClient, _, _ *model) (handler.ProgressEvent, error) {
+				return handler.ProgressEvent{}, errors.New("update failed")
+			},
+		},
+	}
+}
+
+func TestFuncDefaultsProfileAndCreatesClient(t *testing.T) {
+	var profiles []string
+	create := newResource(&profiles).Func(constants.CREATE)
+
+	m := &model{ProjectId: aws.String("projectId")}
+	event, err := create(handler.Request{}, &model{}, m)
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus)
+	assert.Equal(t, profile.DefaultProfile, *m.Profile)
+
+	_, err = create(handler.Request{}, &model{}, &model{ProjectId: aws.String("projectId"), Profile: aws.String("custom")})
+	require.NoError(t, err)
+	assert.Equal(t, []string{profile.DefaultProfile, "custom"}, profiles)
+}
+
+func TestFuncValidatesRequiredFields(t *testing.T) {
+	var profiles []string
+	event, err := newResource(&profiles).Func(constants.CREATE)(handler.Request{}, &model{}, &model{})
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeInvalidRequest, event.HandlerErrorCode)
+	assert.Contains(t, event.Message, constants.ProjectID)
+	assert.Empty(t, profiles, "client must not be created for invalid models")
+}
+
+func TestFuncIdentifiesModel(t *testing.T) {
+	var profiles []string
+	r := newResource(&profiles)
+	r.Identify = func(m *model) error {
+		if m.ProjectId != nil {
+			return nil
+		}
+		urn, err := util.ParseURN(aws.StringValue(m.Name))
+		if err != nil {
+			return err
+		}
+		ids, err := urn.IDs(util.URNProject)
+		if err != nil {
+			return err
+		}
+		m.ProjectId = &ids[0]
+		return nil
+	}
+	r.Delete = handlerkit.Operation[model]{
+		RequiredFields: []string{constants.ProjectID},
+		Run: func(_ handler.Request, _ *util.MongoDBClient, _, currentModel *model) (handler.ProgressEvent, error) {
+			return handler.ProgressEvent{OperationStatus: handler.Success, ResourceModel: currentModel}, nil
+		},
+	}
+
+	m := &model{Name: aws.String(util.ProjectURN("projectId").String())}
+	event, err := r.Func(constants.DELETE)(handler.Request{}, &model{}, m)
+	require.NoError(t, err)
+	assert.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	assert.Equal(t, "projectId", aws.StringValue(m.ProjectId))
+
+	event, err = r.Func(constants.DELETE)(handler.Request{}, &model{}, &model{Name: aws.String("projectId")})
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeInvalidRequest, event.HandlerErrorCode)
+
+	event, err = r.Func(constants.CREATE)(handler.Request{}, &model{}, &model{Name: aws.String("projectId")})
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Contains(t, event.Message, constants.ProjectID, "create doesn't identify the model")
+	assert.Len(t, profiles, 1)
+}
+
+func TestFuncRecoversPanics(t *testing.T) {
+	var profiles []string
+	event, err := newResource(&profiles).Func(constants.READ)(handler.Request{}, &model{}, &model{})
+	require.NoError(t, err)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Contains(t, event.Message, "unexpected state")
+	assert.Empty(t, profiles, "read skips the client")
+}
+
+func TestFuncNotImplemented(t *testing.T) {
+	var profiles []string
+	_, err := newResource(&profiles).Func(constants.LIST)(handler.Request{}, &model{}, &model{})
+	require.EqualError(t, err, "not implemented: LIST")
+}
+
+func TestFuncSetsLogFields(t *testing.T) {
+	var fields logger.Fields
+	r := handlerkit.Resource[model]{
+		TypeName: "mongodb-atlas-test",
+		Read: handlerkit.Operation[model]{
+			SkipClient: true,
+			Run: func(_ handler.Request, _ *util.MongoDBClient, _, _ *model) (handler.ProgressEvent, error) {
+				fields = logger.Default().Fields()
+				return handler.ProgressEvent{OperationStatus: handler.Success}, nil
+			},
+		},
+	}
+	t.Cleanup(func() {
+		logger.ResetFields("")
+		handlerkit.SetClientRequestToken("")
+	})
+
+	logger.SetAtlasRequestID("previous-invocation")
+	handlerkit.SetClientRequestToken("token")
+	req := handler.NewRequest("MyProject", nil, handler.RequestContext{StackID: "arn:stack"}, nil, nil, nil, nil)
+	_, err := r.Func(constants.READ)(req, &model{}, &model{})
+	require.NoError(t, err)
+	assert.Equal(t, logger.Fields{
+		TypeName:           "mongodb-atlas-test",
+		Action:             string(constants.READ),
+		LogicalResourceID:  "MyProject",
+		StackID:            "arn:stack",
+		ClientRequestToken: "token",
+	}, fields)
+}
+
+func TestHandlerUnmarshalsModels(t *testing.T) {
+	var profiles []string
+	h := newResource(&profiles).Handler()
+
+	req := handler.NewRequest("id", nil, handler.RequestContext{}, nil, nil, []byte(`{"ProjectId":"p1","Name":"n"}`), nil)
+	event := h.Create(req)
+	require.Equal(t, handler.Success, event.OperationStatus, event.Message)
+	m, ok := event.ResourceModel.(*model)
+	require.True(t, ok)
+	assert.Equal(t, "p1", *m.ProjectId)
+	assert.Equal(t, "n", *m.Name)
+
+	event = h.Update(req)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Contains(t, event.Message, "update failed")
+
+	event = h.Read(req)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+
+	event = h.Delete(handler.NewRequest("id", nil, handler.RequestContext{}, nil, nil, nil, nil))
+	assert.Equal(t, handler.Failed, event.OperationStatus, "empty body can not be unmarshaled")
+}
+
+func TestNewHandlerWithNilFuncs(t *testing.T) {
+	h := handlerkit.NewHandler(handlerkit.Funcs[model]{})
+	event := h.List(handler.NewRequest("id", nil, handler.RequestContext{}, nil, nil, []byte(`{}`), nil))
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Contains(t, event.Message, "not implemented")
+}
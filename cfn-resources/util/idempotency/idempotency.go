@@ -0,0 +1,194 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package idempotency makes the Create handlers resolve a retried request to the Atlas object created by a previous
+// attempt, instead of failing with AlreadyExists or creating a duplicate.
+//
+// CloudFormation sends a client request token with every request, but the Go plugin doesn't give it to the handlers,
+// so the binding of a Create is named after the stack and the logical ID of the resource, and it records a digest of
+// the desired properties, which are the same for every attempt of a Create. A replacement has different create-only
+// properties, so it doesn't resolve to the replaced object. Without the request token, a Create whose attempts all
+// crashed leaves a binding that a later Create of the same resource with the same properties resolves to, if the
+// object is still in Atlas. The Delete handlers clear the binding of their resource with Clear.
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+)
+
+// ParameterPrefix is the prefix of the SSM parameters holding the Atlas ID created for each resource.
+const ParameterPrefix = "/cfn/atlas/idempotency/"
+
+// Store records the ID of the Atlas object created for each token, after the digest of the properties it was created
+// with and a space.
+type Store interface {
+	// Get returns the value recorded for the token, empty if there is none.
+	Get(token string) (string, error)
+	Put(token, value string) error
+	Delete(token string) error
+}
+
+// NewStore returns the store used by the handlers, the SSM Parameter Store of the account of the stack.
+var NewStore = func(req *handler.Request) Store {
+	return &ParameterStore{Session: req.Session}
+}
+
+// ParameterStore records the IDs in SSM String parameters named ParameterPrefix followed by the token.
+type ParameterStore struct {
+	Session *session.Session
+}
+
+func (s *ParameterStore) Get(token string) (string, error) {
+	resp, err := ssm.New(s.Session).GetParameter(&ssm.GetParameterInput{Name: aws.String(ParameterPrefix + token)})
+	if isParameterNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(resp.Parameter.Value), nil
+}
+
+func (s *ParameterStore) Put(token, value string) error {
+	_, err := ssm.New(s.Session).PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(ParameterPrefix + token),
+		Value:       aws.String(value),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Description: aws.String("Atlas ID created by a CloudFormation Create request"),
+		Overwrite:   aws.Bool(true),
+	})
+	return err
+}
+
+func (s *ParameterStore) Delete(token string) error {
+	_, err := ssm.New(s.Session).DeleteParameter(&ssm.DeleteParameterInput{Name: aws.String(ParameterPrefix + token)})
+	if isParameterNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func isParameterNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == ssm.ErrCodeParameterNotFound
+}
+
+// Token returns the token naming the binding of the resource of the request, empty if the request isn't part of a
+// stack, e.g. when testing the handlers locally.
+func Token(req *handler.Request) string {
+	if req.RequestContext.StackID == "" || req.LogicalResourceID == "" {
+		return ""
+	}
+	return digest([]byte(req.RequestContext.StackID), []byte(req.LogicalResourceID))
+}
+
+// Properties returns the digest of the desired properties of the model recorded with the ID.
+func Properties(model any) string {
+	properties, err := json.Marshal(model)
+	if err != nil {
+		return ""
+	}
+	return digest(properties)
+}
+
+func digest(parts ...[]byte) string {
+	h := sha256.New()
+	for i, part := range parts {
+		if i > 0 {
+			h.Write([]byte{0})
+		}
+		h.Write(part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Binding binds a Create request to the ID of the Atlas object it created. The store is best effort: when it can't
+// be read or written, e.g. the handler isn't allowed to use SSM, the Create behaves as without a binding.
+type Binding struct {
+	Store Store
+	Token string
+	// Properties is the digest of the desired properties, a binding recorded for other properties isn't resumed.
+	Properties string
+}
+
+// New returns the binding of the Create request of the model, to create before the model is modified by the handler.
+func New(req *handler.Request, model any) *Binding {
+	return &Binding{Store: NewStore(req), Token: Token(req), Properties: Properties(model)}
+}
+
+// Clear removes the binding of the resource of the request, it's meant for the Delete handlers.
+func Clear(req *handler.Request) {
+	token := Token(req)
+	if token == "" {
+		return
+	}
+	if err := NewStore(req).Delete(token); err != nil {
+		_, _ = logger.Warnf("idempotency: error removing the ID created for the resource: %v", err)
+	}
+}
+
+// Create returns the ID of the object created by a previous attempt of the request if exists reports that it's still
+// in Atlas, with resumed set. Otherwise it calls create and records the ID of the new object before returning it,
+// so that an attempt interrupted by a crash or a timeout after create resolves to the same object.
+func (b *Binding) Create(exists func(id string) (bool, error), create func() (string, error)) (id string, resumed bool, err error) {
+	if b.Token != "" {
+		value, err := b.Store.Get(b.Token)
+		if err != nil {
+			_, _ = logger.Warnf("idempotency: error reading the ID created by a previous attempt: %v", err)
+		}
+		if properties, id, _ := strings.Cut(value, " "); id != "" && properties == b.Properties {
+			found, err := exists(id)
+			if err != nil {
+				return "", false, err
+			}
+			if found {
+				_, _ = logger.Debugf("idempotency: resuming the Create of %s", id)
+				return id, true, nil
+			}
+		}
+	}
+
+	id, err = create()
+	if err != nil {
+		return "", false, err
+	}
+	if b.Token != "" {
+		if err := b.Store.Put(b.Token, b.Properties+" "+id); err != nil {
+			_, _ = logger.Warnf("idempotency: error recording the ID %s: %v", id, err)
+		}
+	}
+	return id, false, nil
+}
+
+// Done removes the binding once the Create returned, successfully or not: CloudFormation only retries the attempts
+// that didn't return, e.g. because of a crash or a timeout. It's meant to be deferred right after New.
+func (b *Binding) Done() {
+	if b.Token == "" {
+		return
+	}
+	if err := b.Store.Delete(b.Token); err != nil {
+		_, _ = logger.Warnf("idempotency: error removing the ID created by the request: %v", err)
+	}
+}
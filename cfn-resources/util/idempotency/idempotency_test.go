@@ -0,0 +1,189 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idempotency_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/idempotency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	err error
+	ids map[string]string
+}
+
+func (s *memoryStore) Get(token string) (string, error) {
+	return s.ids[token], s.err
+}
+
+func (s *memoryStore) Put(token, id string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.ids[token] = id
+	return nil
+}
+
+func (s *memoryStore) Delete(token string) error {
+	delete(s.ids, token)
+	return s.err
+}
+
+// fakeAtlas creates objects without a natural key, like API keys, so a retried create makes a duplicate.
+type fakeAtlas struct {
+	objects map[string]bool
+	created int
+}
+
+func (a *fakeAtlas) create() (string, error) {
+	a.created++
+	id := fmt.Sprintf("id%d", a.created)
+	a.objects[id] = true
+	return id, nil
+}
+
+func (a *fakeAtlas) exists(id string) (bool, error) {
+	return a.objects[id], nil
+}
+
+type model struct {
+	Name *string `json:",omitempty"`
+}
+
+func newRequest(logicalID string) *handler.Request {
+	return &handler.Request{
+		LogicalResourceID: logicalID,
+		RequestContext:    handler.RequestContext{StackID: "arn:aws:cloudformation:us-east-1:123456789012:stack/test/1"},
+	}
+}
+
+func TestToken(t *testing.T) {
+	token := idempotency.Token(newRequest("Project"))
+	assert.Len(t, token, 64)
+	assert.Equal(t, token, idempotency.Token(newRequest("Project")), "a retried request must have the same token")
+	assert.NotEqual(t, token, idempotency.Token(newRequest("OtherProject")))
+	assert.Empty(t, idempotency.Token(&handler.Request{LogicalResourceID: "Project"}))
+}
+
+func TestProperties(t *testing.T) {
+	name, other := "test", "other"
+	properties := idempotency.Properties(&model{Name: &name})
+	assert.Len(t, properties, 64)
+	assert.Equal(t, properties, idempotency.Properties(&model{Name: &name}))
+	assert.NotEqual(t, properties, idempotency.Properties(&model{Name: &other}), "a replacement must have other properties")
+}
+
+func TestCreateAfterCrash(t *testing.T) {
+	store := &memoryStore{ids: map[string]string{}}
+	atlas := &fakeAtlas{objects: map[string]bool{}}
+	binding := &idempotency.Binding{Store: store, Token: "token", Properties: "properties"}
+
+	// the first attempt creates the object then crashes, e.g. it times out before returning its event
+	id, resumed, err := binding.Create(atlas.exists, atlas.create)
+	require.NoError(t, err)
+	assert.False(t, resumed)
+	assert.Equal(t, "id1", id)
+
+	// the retried attempt resolves to the same object and completes
+	id, resumed, err = binding.Create(atlas.exists, atlas.create)
+	require.NoError(t, err)
+	assert.True(t, resumed)
+	assert.Equal(t, "id1", id)
+	assert.Equal(t, 1, atlas.created, "the object must not be created twice")
+
+	binding.Done()
+	assert.Empty(t, store.ids)
+}
+
+func TestCreateObjectGone(t *testing.T) {
+	store := &memoryStore{ids: map[string]string{"token": "properties deleted"}}
+	atlas := &fakeAtlas{objects: map[string]bool{}}
+	binding := &idempotency.Binding{Store: store, Token: "token", Properties: "properties"}
+
+	id, resumed, err := binding.Create(atlas.exists, atlas.create)
+	require.NoError(t, err)
+	assert.False(t, resumed)
+	assert.Equal(t, "id1", id)
+	assert.Equal(t, "properties id1", store.ids["token"])
+}
+
+func TestCreateOtherProperties(t *testing.T) {
+	store := &memoryStore{ids: map[string]string{"token": "replaced id0"}}
+	atlas := &fakeAtlas{objects: map[string]bool{"id0": true}}
+	binding := &idempotency.Binding{Store: store, Token: "token", Properties: "properties"}
+
+	id, resumed, err := binding.Create(atlas.exists, atlas.create)
+	require.NoError(t, err)
+	assert.False(t, resumed, "the object created with other properties must not be resumed")
+	assert.Equal(t, "id1", id)
+}
+
+func TestClear(t *testing.T) {
+	store := &memoryStore{ids: map[string]string{}}
+	newStore := idempotency.NewStore
+	idempotency.NewStore = func(*handler.Request) idempotency.Store { return store }
+	t.Cleanup(func() { idempotency.NewStore = newStore })
+	req := newRequest("Project")
+	store.ids[idempotency.Token(req)] = "properties id1"
+	store.ids["other"] = "properties id2"
+
+	idempotency.Clear(req)
+	assert.Equal(t, map[string]string{"other": "properties id2"}, store.ids)
+}
+
+func TestCreateWithoutToken(t *testing.T) {
+	store := &memoryStore{ids: map[string]string{}}
+	atlas := &fakeAtlas{objects: map[string]bool{}}
+	binding := &idempotency.Binding{Store: store}
+
+	for range 2 {
+		_, resumed, err := binding.Create(atlas.exists, atlas.create)
+		require.NoError(t, err)
+		assert.False(t, resumed)
+	}
+	assert.Equal(t, 2, atlas.created)
+	assert.Empty(t, store.ids)
+}
+
+func TestCreateStoreError(t *testing.T) {
+	store := &memoryStore{ids: map[string]string{}, err: errors.New("AccessDeniedException")}
+	atlas := &fakeAtlas{objects: map[string]bool{}}
+	binding := &idempotency.Binding{Store: store, Token: "token", Properties: "properties"}
+
+	id, resumed, err := binding.Create(atlas.exists, atlas.create)
+	require.NoError(t, err, "the store is best effort")
+	assert.False(t, resumed)
+	assert.Equal(t, "id1", id)
+}
+
+func TestCreateErrors(t *testing.T) {
+	store := &memoryStore{ids: map[string]string{"token": "properties id1"}}
+	binding := &idempotency.Binding{Store: store, Token: "token", Properties: "properties"}
+	atlasErr := errors.New("atlas error")
+
+	_, _, err := binding.Create(func(string) (bool, error) { return false, atlasErr }, nil)
+	require.ErrorIs(t, err, atlasErr)
+
+	store.ids = map[string]string{}
+	_, _, err = binding.Create(nil, func() (string, error) { return "", atlasErr })
+	require.ErrorIs(t, err, atlasErr)
+	assert.Empty(t, store.ids)
+}
@@ -1,17 +1,3 @@
-// Copyright 2023 MongoDB Inc
-//
-// Licensed under the Apache License, Version 2.0 (the "License");
-// you may not use this file except in compliance with the License.
-// You may obtain a copy of the License at
-//
-//      http://www.apache.org/licenses/LICENSE-2.0
-//
-// Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
-// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-// See the License for the specific language governing permissions and
-// limitations under the License.
-
 // Copyright 2022 MongoDB Inc
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
@@ -29,91 +15,244 @@
 package logger
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"strings"
+	"sync"
+	"time"
 )
 
 type Level int
 
 const (
 	NoneLevel Level = iota
+	ErrorLevel
 	WarningLevel
+	InfoLevel
 	DebugLevel
 )
 
+var levelNames = map[Level]string{
+	NoneLevel:    "none",
+	ErrorLevel:   "error",
+	WarningLevel: "warning",
+	InfoLevel:    "info",
+	DebugLevel:   "debug",
+}
+
+func (l Level) String() string {
+	if name, ok := levelNames[l]; ok {
+		return name
+	}
+	return fmt.Sprintf("level(%d)", int(l))
+}
+
+// ParseLevel returns the level with the given name (none, error, warning, info or debug), case insensitive.
+func ParseLevel(name string) (Level, bool) {
+	for level, levelName := range levelNames {
+		if strings.EqualFold(name, levelName) {
+			return level, true
+		}
+	}
+	return NoneLevel, false
+}
+
+// Fields are the correlation identifiers added to every log record,
+// so handler log lines can be matched with CloudFormation stack events and Atlas support requests.
+type Fields struct {
+	TypeName           string `json:"typeName,omitempty"`
+	Action             string `json:"action,omitempty"`
+	LogicalResourceID  string `json:"logicalResourceId,omitempty"`
+	StackID            string `json:"stackId,omitempty"`
+	ClientRequestToken string `json:"clientRequestToken,omitempty"`
+	AtlasRequestID     string `json:"atlasRequestId,omitempty"`
+}
+
+// record is a single JSON log line.
+type record struct {
+	Time    string `json:"time"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+	Fields
+}
+
 type Logger struct {
-	w     io.Writer
-	level Level
+	w      io.Writer
+	now    func() time.Time
+	fields Fields
+	mu     sync.Mutex
+	level  Level
 }
 
 func New(w io.Writer, l Level) *Logger {
 	return &Logger{
 		level: l,
 		w:     w,
+		now:   time.Now,
 	}
 }
 
 func (l *Logger) SetOutput(w io.Writer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 	l.w = w
 }
 
 func (l *Logger) SetLevel(level Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 	l.level = level
 }
 
 func (l *Logger) Level() Level {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 	return l.level
 }
 
+// SetClock replaces the function used to timestamp the records, meant for tests.
+func (l *Logger) SetClock(now func() time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.now = now
+}
+
+// Fields returns the correlation fields currently added to the records.
+func (l *Logger) Fields() Fields {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.fields
+}
+
+// SetFields replaces all the correlation fields.
+func (l *Logger) SetFields(f Fields) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.fields = f
+}
+
+// UpdateFields changes some of the correlation fields, keeping the rest.
+func (l *Logger) UpdateFields(update func(f *Fields)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	update(&l.fields)
+}
+
 func (l *Logger) IsDebugLevel() bool {
-	return l.level >= DebugLevel
+	return l.Level() >= DebugLevel
+}
+
+func (l *Logger) IsInfoLevel() bool {
+	return l.Level() >= InfoLevel
 }
 
 func (l *Logger) IsWarningLevel() bool {
-	return l.level >= WarningLevel
+	return l.Level() >= WarningLevel
 }
 
-func (l Logger) Debug(a ...any) (int, error) {
-	if !l.IsDebugLevel() {
-		return 0, nil
-	}
-	return fmt.Fprint(l.w, a...)
+func (l *Logger) IsErrorLevel() bool {
+	return l.Level() >= ErrorLevel
 }
 
-func (l Logger) Debugln(a ...any) (int, error) {
-	if !l.IsDebugLevel() {
+// print formats the arguments with their sensitive values redacted and emits a JSON record,
+// if the logger level allows it. The format functions match fmt.Sprint, fmt.Sprintln and fmt.Sprintf.
+func (l *Logger) print(level Level, format func(a []any) string, a []any) (int, error) {
+	if l.Level() < level {
 		return 0, nil
 	}
-	return fmt.Fprintln(l.w, a...)
+	return l.write(level, format(redactArgs(a)))
 }
 
-func (l Logger) Debugf(format string, a ...any) (int, error) {
-	if !l.IsDebugLevel() {
+func (l *Logger) write(level Level, msg string) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.level < level || l.w == nil {
 		return 0, nil
 	}
-	return fmt.Fprintf(l.w, format, a...)
+	b, err := json.Marshal(record{
+		Time:    l.now().UTC().Format(time.RFC3339Nano),
+		Level:   level.String(),
+		Message: strings.TrimRight(msg, "\n"),
+		Fields:  l.fields,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return l.w.Write(append(b, '\n'))
 }
 
-func (l Logger) Warning(a ...any) (int, error) {
-	if !l.IsWarningLevel() {
-		return 0, nil
-	}
-	return fmt.Fprint(l.w, a...)
+// levelWriter writes each line as a record of the level.
+type levelWriter struct {
+	l     *Logger
+	level Level
 }
 
-func (l Logger) Warningln(a ...any) (int, error) {
-	if !l.IsWarningLevel() {
-		return 0, nil
+func (w levelWriter) Write(p []byte) (int, error) {
+	if _, err := w.l.print(w.level, sprint, []any{string(p)}); err != nil {
+		return 0, err
 	}
-	return fmt.Fprintln(l.w, a...)
+	return len(p), nil
 }
 
-func (l Logger) Warningf(format string, a ...any) (int, error) {
-	if !l.IsWarningLevel() {
-		return 0, nil
+// Writer returns a writer emitting what is written as records of the level, e.g. to route the standard library logger.
+func (l *Logger) Writer(level Level) io.Writer {
+	return levelWriter{l: l, level: level}
+}
+
+func sprint(a []any) string {
+	return fmt.Sprint(a...)
+}
+
+func sprintln(a []any) string {
+	return fmt.Sprintln(a...)
+}
+
+func sprintf(format string) func(a []any) string {
+	return func(a []any) string {
+		return fmt.Sprintf(format, a...)
 	}
-	return fmt.Fprintf(l.w, format, a...)
+}
+
+func (l *Logger) Debug(a ...any) (int, error) {
+	return l.print(DebugLevel, sprint, a)
+}
+
+func (l *Logger) Debugln(a ...any) (int, error) {
+	return l.print(DebugLevel, sprintln, a)
+}
+
+func (l *Logger) Debugf(format string, a ...any) (int, error) {
+	return l.print(DebugLevel, sprintf(format), a)
+}
+
+func (l *Logger) Info(a ...any) (int, error) {
+	return l.print(InfoLevel, sprint, a)
+}
+
+func (l *Logger) Infof(format string, a ...any) (int, error) {
+	return l.print(InfoLevel, sprintf(format), a)
+}
+
+func (l *Logger) Warning(a ...any) (int, error) {
+	return l.print(WarningLevel, sprint, a)
+}
+
+func (l *Logger) Warningln(a ...any) (int, error) {
+	return l.print(WarningLevel, sprintln, a)
+}
+
+func (l *Logger) Warningf(format string, a ...any) (int, error) {
+	return l.print(WarningLevel, sprintf(format), a)
+}
+
+func (l *Logger) Error(a ...any) (int, error) {
+	return l.print(ErrorLevel, sprint, a)
+}
+
+func (l *Logger) Errorf(format string, a ...any) (int, error) {
+	return l.print(ErrorLevel, sprintf(format), a)
 }
 
 var std = New(os.Stderr, WarningLevel)
@@ -130,14 +269,52 @@ func IsDebugLevel() bool {
 	return std.IsDebugLevel()
 }
 
+func IsInfoLevel() bool {
+	return std.IsInfoLevel()
+}
+
 func IsWarningLevel() bool {
 	return std.IsWarningLevel()
 }
 
+// Writer returns a writer emitting records of the level with the package logger.
+func Writer(level Level) io.Writer {
+	return std.Writer(level)
+}
+
 func Default() *Logger {
 	return std
 }
 
+// ResetFields removes the correlation fields of a previous invocation and sets the resource type name, e.g. mongodb-atlas-cluster.
+func ResetFields(typeName string) {
+	std.SetFields(Fields{TypeName: typeName})
+}
+
+// SetAction sets the handler action (CREATE, READ, UPDATE, DELETE or LIST) being run.
+func SetAction(action string) {
+	std.UpdateFields(func(f *Fields) { f.Action = action })
+}
+
+// SetRequest sets the identifiers of the CloudFormation request being handled.
+func SetRequest(logicalResourceID, stackID string) {
+	std.UpdateFields(func(f *Fields) {
+		f.LogicalResourceID = logicalResourceID
+		f.StackID = stackID
+	})
+}
+
+// SetClientRequestToken sets the client request token. The Go plugin doesn't forward it in handler.Request,
+// so it's only present when the caller invoking the handler knows it.
+func SetClientRequestToken(token string) {
+	std.UpdateFields(func(f *Fields) { f.ClientRequestToken = token })
+}
+
+// SetAtlasRequestID sets the request ID of the last Atlas API response.
+func SetAtlasRequestID(id string) {
+	std.UpdateFields(func(f *Fields) { f.AtlasRequestID = id })
+}
+
 func Debug(a ...any) (int, error) {
 	return std.Debug(a...)
 }
@@ -150,6 +327,14 @@ func Debugf(format string, a ...any) (int, error) {
 	return std.Debugf(format, a...)
 }
 
+func Info(a ...any) (int, error) {
+	return std.Info(a...)
+}
+
+func Infof(format string, a ...any) (int, error) {
+	return std.Infof(format, a...)
+}
+
 func Warn(a ...any) (int, error) {
 	return std.Warning(a...)
 }
@@ -161,3 +346,11 @@ func Warnln(a ...any) (int, error) {
 func Warnf(format string, a ...any) (int, error) {
 	return std.Warningf(format, a...)
 }
+
+func Error(a ...any) (int, error) {
+	return std.Error(a...)
+}
+
+func Errorf(format string, a ...any) (int, error) {
+	return std.Errorf(format, a...)
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func records(t *testing.T, buf *bytes.Buffer) []map[string]string {
+	t.Helper()
+	var result []map[string]string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var r map[string]string
+		require.NoError(t, json.Unmarshal([]byte(line), &r), line)
+		result = append(result, r)
+	}
+	return result
+}
+
+func TestLoggerWritesJSONRecordsWithFields(t *testing.T) {
+	var buf bytes.Buffer
+	l := logger.New(&buf, logger.DebugLevel)
+	l.SetClock(func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) })
+	l.SetFields(logger.Fields{TypeName: "mongodb-atlas-cluster", Action: "CREATE", StackID: "stack"})
+	l.UpdateFields(func(f *logger.Fields) { f.AtlasRequestID = "req-1" })
+
+	_, err := l.Warningf("cluster %s is %s\n", "c1", "IDLE")
+	require.NoError(t, err)
+
+	got := records(t, &buf)
+	require.Len(t, got, 1)
+	assert.Equal(t, map[string]string{
+		"time":           "2024-01-02T03:04:05Z",
+		"level":          "warning",
+		"message":        "cluster c1 is IDLE",
+		"typeName":       "mongodb-atlas-cluster",
+		"action":         "CREATE",
+		"stackId":        "stack",
+		"atlasRequestId": "req-1",
+	}, got[0])
+}
+
+func TestLoggerLevels(t *testing.T) {
+	testCases := map[logger.Level][]string{
+		logger.NoneLevel:    nil,
+		logger.ErrorLevel:   {"error"},
+		logger.WarningLevel: {"error", "warning"},
+		logger.InfoLevel:    {"error", "warning", "info"},
+		logger.DebugLevel:   {"error", "warning", "info", "debug"},
+	}
+	for level, expected := range testCases {
+		t.Run(level.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			l := logger.New(&buf, level)
+			_, _ = l.Error("e")
+			_, _ = l.Warning("w")
+			_, _ = l.Info("i")
+			_, _ = l.Debug("d")
+
+			var levels []string
+			for _, r := range records(t, &buf) {
+				levels = append(levels, r["level"])
+			}
+			assert.Equal(t, expected, levels)
+		})
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	l := logger.New(&buf, logger.InfoLevel)
+	l.SetFields(logger.Fields{TypeName: "mongodb-atlas-cluster"})
+	stdlog := log.New(l.Writer(logger.InfoLevel), "", 0)
+	stdlog.Printf("Handler received the %s action", "CREATE")
+	log.New(l.Writer(logger.DebugLevel), "", 0).Print("hidden")
+
+	got := records(t, &buf)
+	require.Len(t, got, 1)
+	assert.Equal(t, "info", got[0]["level"])
+	assert.Equal(t, "Handler received the CREATE action", got[0]["message"])
+	assert.Equal(t, "mongodb-atlas-cluster", got[0]["typeName"])
+}
+
+func TestParseLevel(t *testing.T) {
+	level, ok := logger.ParseLevel("DEBUG")
+	assert.True(t, ok)
+	assert.Equal(t, logger.DebugLevel, level)
+
+	level, ok = logger.ParseLevel("info")
+	assert.True(t, ok)
+	assert.Equal(t, logger.InfoLevel, level)
+
+	_, ok = logger.ParseLevel("verbose")
+	assert.False(t, ok)
+}
+
+func TestPackageFieldSetters(t *testing.T) {
+	var buf bytes.Buffer
+	logger.SetOutput(&buf)
+	logger.SetLevel(logger.InfoLevel)
+	t.Cleanup(func() {
+		logger.ResetFields("")
+		logger.SetLevel(logger.WarningLevel)
+		logger.SetOutput(os.Stderr)
+	})
+
+	logger.SetAtlasRequestID("previous-invocation")
+	logger.ResetFields("mongodb-atlas-project")
+	logger.SetAction("READ")
+	logger.SetRequest("MyProject", "arn:stack")
+	logger.SetClientRequestToken("token")
+	_, _ = logger.Infof("reading %s", "project")
+
+	got := records(t, &buf)
+	require.Len(t, got, 1)
+	assert.Equal(t, "mongodb-atlas-project", got[0]["typeName"])
+	assert.Equal(t, "READ", got[0]["action"])
+	assert.Equal(t, "MyProject", got[0]["logicalResourceId"])
+	assert.Equal(t, "arn:stack", got[0]["stackId"])
+	assert.Equal(t, "token", got[0]["clientRequestToken"])
+	assert.NotContains(t, got[0], "atlasRequestId")
+}
@@ -0,0 +1,213 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+//go:generate go run ../../tool/redaction-generator
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const (
+	// RedactedValue replaces the sensitive values in the log records.
+	RedactedValue = "********"
+
+	modulePath       = "github.com/mongodb/mongodbatlas-cloudformation-resources/"
+	maxRedactedDepth = 32
+)
+
+// sensitiveNameSuffixes mask struct fields and map keys ending with them (case insensitive) in any type,
+// including the Atlas SDK and AWS SDK types, whether or not they are declared as write-only in a schema.
+var sensitiveNameSuffixes = []string{
+	"password",
+	"secret",
+	"privatekey",
+	"apikey",
+	"apitoken",
+	"servicekey",
+	"routingkey",
+	"accesstoken",
+	"webhookurl",
+}
+
+// IsSensitiveName reports if a field or key with this name holds a credential.
+func IsSensitiveName(name string) bool {
+	name = strings.ToLower(name)
+	for _, suffix := range sensitiveNameSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact returns a copy of v where the string values of sensitive fields are replaced by RedactedValue.
+// Sensitive fields are the write-only properties of the resource schemas, for the models of each resource,
+// and the fields matching IsSensitiveName, for any type. v itself is never modified.
+func Redact(v any) any {
+	if v == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return redactValue(rv, 0).Interface()
+	default:
+		return v
+	}
+}
+
+func redactArgs(a []any) []any {
+	redacted := make([]any, len(a))
+	for i := range a {
+		redacted[i] = Redact(a[i])
+	}
+	return redacted
+}
+
+func redactValue(v reflect.Value, depth int) reflect.Value {
+	if depth > maxRedactedDepth {
+		return v
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		n := reflect.New(v.Type().Elem())
+		n.Elem().Set(redactValue(v.Elem(), depth+1))
+		return n
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		n := reflect.New(v.Type()).Elem()
+		n.Set(redactValue(v.Elem(), depth+1))
+		return n
+	case reflect.Struct:
+		return redactStruct(v, depth)
+	case reflect.Slice:
+		if v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8 {
+			return v
+		}
+		n := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			n.Index(i).Set(redactValue(v.Index(i), depth+1))
+		}
+		return n
+	case reflect.Array:
+		n := reflect.New(v.Type()).Elem()
+		for i := range v.Len() {
+			n.Index(i).Set(redactValue(v.Index(i), depth+1))
+		}
+		return n
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		n := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key, value := iter.Key(), iter.Value()
+			if key.Kind() == reflect.String && IsSensitiveName(key.String()) && isMaskable(value) {
+				n.SetMapIndex(key, masked(value))
+				continue
+			}
+			n.SetMapIndex(key, redactValue(value, depth+1))
+		}
+		return n
+	default:
+		return v
+	}
+}
+
+func redactStruct(v reflect.Value, depth int) reflect.Value {
+	t := v.Type()
+	n := reflect.New(t).Elem()
+	n.Set(v)
+	writeOnly := schemaSensitiveFields[resourceDir(t.PkgPath())]
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		field := v.Field(i)
+		if (IsSensitiveName(sf.Name) || slices.Contains(writeOnly, sf.Name)) && isMaskable(field) {
+			n.Field(i).Set(masked(field))
+			continue
+		}
+		n.Field(i).Set(redactValue(field, depth+1))
+	}
+	return n
+}
+
+// resourceDir returns the resource directory of the package, e.g. cluster for the models in cluster/cmd/resource.
+func resourceDir(pkgPath string) string {
+	dir, ok := strings.CutPrefix(pkgPath, modulePath)
+	if !ok {
+		return ""
+	}
+	dir, _, _ = strings.Cut(dir, "/")
+	return dir
+}
+
+// isMaskable reports if the value is a string, or a pointer, interface or slice of strings.
+func isMaskable(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.String:
+		return true
+	case reflect.Pointer, reflect.Slice:
+		return v.Type().Elem().Kind() == reflect.String
+	case reflect.Interface:
+		return !v.IsNil() && v.Elem().Kind() == reflect.String
+	default:
+		return false
+	}
+}
+
+// masked returns a copy of a maskable value with its non-empty strings replaced by RedactedValue.
+func masked(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.String:
+		if v.Len() == 0 {
+			return v
+		}
+		return reflect.ValueOf(RedactedValue).Convert(v.Type())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		n := reflect.New(v.Type().Elem())
+		n.Elem().Set(masked(v.Elem()))
+		return n
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		n := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			n.Index(i).Set(masked(v.Index(i)))
+		}
+		return n
+	case reflect.Interface:
+		n := reflect.New(v.Type()).Elem()
+		n.Set(masked(v.Elem()))
+		return n
+	default:
+		return v
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type credentials struct {
+	PublicKey  *string
+	PrivateKey *string
+}
+
+type config struct {
+	Extra       map[string]any
+	Credentials *credentials
+	Name        string
+	Password    string
+	Tokens      []string
+	Secrets     []*credentials
+}
+
+func TestRedact(t *testing.T) {
+	original := &config{
+		Name:        "name",
+		Password:    "pass",
+		Credentials: &credentials{PublicKey: aws("public"), PrivateKey: aws("private")},
+		Secrets:     []*credentials{{PrivateKey: aws("private2")}},
+		Extra:       map[string]any{"bindPassword": "ldap", "user": "admin"},
+	}
+
+	redacted, ok := logger.Redact(original).(*config)
+	require.True(t, ok)
+
+	assert.Equal(t, "name", redacted.Name)
+	assert.Equal(t, logger.RedactedValue, redacted.Password)
+	assert.Equal(t, "public", *redacted.Credentials.PublicKey)
+	assert.Equal(t, logger.RedactedValue, *redacted.Credentials.PrivateKey)
+	assert.Equal(t, logger.RedactedValue, *redacted.Secrets[0].PrivateKey)
+	assert.Equal(t, logger.RedactedValue, redacted.Extra["bindPassword"])
+	assert.Equal(t, "admin", redacted.Extra["user"])
+
+	assert.Equal(t, "pass", original.Password, "original value must not be modified")
+	assert.Equal(t, "private", *original.Credentials.PrivateKey, "original value must not be modified")
+	assert.Equal(t, "ldap", original.Extra["bindPassword"], "original value must not be modified")
+
+	assert.Equal(t, "plain", logger.Redact("plain"))
+	assert.Nil(t, logger.Redact(nil))
+}
+
+func TestLoggerRedactsArguments(t *testing.T) {
+	var buf bytes.Buffer
+	l := logger.New(&buf, logger.DebugLevel)
+
+	_, _ = l.Debugf("currentModel: %+v", &config{Name: "cluster0", Password: "hunter2"})
+	_, _ = l.Warning(map[string]string{"ApiKey": "key"})
+
+	assert.NotContains(t, buf.String(), "hunter2")
+	assert.NotContains(t, buf.String(), `"key"`)
+	assert.Contains(t, buf.String(), "cluster0")
+	assert.Contains(t, buf.String(), logger.RedactedValue)
+}
+
+// TestRedactModels feeds the model of every resource through the logger, checking that the values of
+// the write-only properties declared in its schema and of the fields with sensitive names are masked.
+func TestRedactModels(t *testing.T) {
+	require.NotEmpty(t, resourceModels)
+	for dir, model := range resourceModels {
+		t.Run(dir, func(t *testing.T) {
+			writeOnly := schemaWriteOnly(t, dir)
+			values := map[string]string{}
+			fill(reflect.ValueOf(model), values, 0)
+			require.NotEmpty(t, values)
+
+			var buf bytes.Buffer
+			l := logger.New(&buf, logger.DebugLevel)
+			_, _ = l.Debugf("currentModel: %+v", model)
+			_, _ = l.Debugf("currentModel: %+v", reflect.ValueOf(model).Elem().Interface())
+			_, _ = l.Debug(model)
+			redacted, err := json.Marshal(logger.Redact(model))
+			require.NoError(t, err)
+
+			for value, field := range values {
+				if logger.IsSensitiveName(field) || writeOnly[field] {
+					assert.NotContains(t, buf.String(), value, "field %s must be redacted", field)
+					assert.NotContains(t, string(redacted), value, "field %s must be redacted", field)
+				} else {
+					assert.Contains(t, string(redacted), value, "field %s must not be redacted", field)
+				}
+			}
+		})
+	}
+}
+
+// schemaWriteOnly reads the write-only field names from the resource schema.
+func schemaWriteOnly(t *testing.T, dir string) map[string]bool {
+	t.Helper()
+	schemas, err := filepath.Glob(filepath.Join("..", "..", dir, "mongodb-atlas-*.json"))
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+	data, err := os.ReadFile(schemas[0])
+	require.NoError(t, err)
+	var schema struct {
+		WriteOnlyProperties []string `json:"writeOnlyProperties"`
+	}
+	require.NoError(t, json.Unmarshal(data, &schema))
+	fields := map[string]bool{}
+	for _, p := range schema.WriteOnlyProperties {
+		fields[p[strings.LastIndex(p, "/")+1:]] = true
+	}
+	return fields
+}
+
+// fill sets a unique value in every string field reachable from v, recording which field each value belongs to.
+func fill(v reflect.Value, values map[string]string, depth int) {
+	if depth > 5 {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		fill(v.Elem(), values, depth+1)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			sf := v.Type().Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+			f := v.Field(i)
+			switch {
+			case f.Kind() == reflect.String:
+				f.SetString(fillValue(sf.Name, values))
+			case f.Kind() == reflect.Pointer && f.Type().Elem().Kind() == reflect.String:
+				s := fillValue(sf.Name, values)
+				f.Set(reflect.ValueOf(&s))
+			case f.Kind() == reflect.Slice && f.Type().Elem().Kind() == reflect.String:
+				f.Set(reflect.ValueOf([]string{fillValue(sf.Name, values)}).Convert(f.Type()))
+			case f.Kind() == reflect.Slice:
+				f.Set(reflect.MakeSlice(f.Type(), 1, 1))
+				fill(f.Index(0), values, depth+1)
+			default:
+				fill(f, values, depth+1)
+			}
+		}
+	}
+}
+
+func fillValue(field string, values map[string]string) string {
+	value := fmt.Sprintf("[%s.%d]", field, len(values))
+	values[value] = field
+	return value
+}
+
+func aws(s string) *string {
+	return &s
+}
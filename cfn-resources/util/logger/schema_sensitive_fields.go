@@ -0,0 +1,47 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by tool/redaction-generator. DO NOT EDIT.
+
+package logger
+
+// schemaSensitiveFields are the writeOnlyProperties of the resource schemas, by resource directory.
+var schemaSensitiveFields = map[string][]string{
+	"api-key":                           {"AwsSecretName"},
+	"cloud-backup-restore-jobs":         {"EnableSynchronousCreation", "SynchronousCreationOptions"},
+	"cloud-backup-schedule":             {"DeleteCopiedBackups", "UpdateSnapshots"},
+	"cloud-backup-snapshot":             {"RetentionInDays", "TimeoutOptions"},
+	"cluster":                           {"TimeoutOptions"},
+	"database-user":                     {"Password"},
+	"datalakes":                         {"SkipRoleValidation"},
+	"federated-database-instance":       {"SkipRoleValidation", "TestS3Bucket"},
+	"global-cluster-config":             {"RemoveAllZoneMapping"},
+	"ldap-configuration":                {"BindPassword"},
+	"ldap-verify":                       {"BindPassword"},
+	"maintenance-window":                {"StartASAP"},
+	"network-peering":                   {"TimeoutOptions"},
+	"online-archive":                    {"TimeoutOptions"},
+	"organization":                      {"APIKey", "FederatedSettingsId"},
+	"private-endpoint":                  {"PrivateEndpoints", "TimeoutOptions"},
+	"private-endpoint-aws":              {"EnforceConnectionSuccess"},
+	"project":                           {"ProjectApiKeys"},
+	"search-deployment":                 {"TimeoutOptions"},
+	"serverless-instance":               {"TimeoutOptions"},
+	"serverless-private-endpoint":       {"AwsPrivateEndpointConfigurationProperties", "CreateAndAssignAWSPrivateEndpoint"},
+	"stream-connection":                 {"Password"},
+	"teams":                             {"ProjectId", "RoleNames"},
+	"third-party-integration":           {"ApiKey", "ApiToken", "ChannelName", "Enabled", "MicrosoftTeamsWebhookUrl", "Password", "Region", "RoutingKey", "Scheme", "Secret", "ServiceDiscovery", "ServiceKey", "TeamName", "Url", "UserName"},
+	"trigger":                           {"SkipCatchupEvents", "SkipcatchupEvents", "TolerateResumeErrors"},
+	"x509-authentication-database-user": {"MonthsUntilExpiration"},
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+)
+
+// PageToken is the content of the opaque NextToken returned by List handlers.
+// Atlas paginated endpoints use 1-based page numbers. Cursor is reserved for the endpoints using cursors,
+// which ListPage doesn't page through yet, so a token must still carry a page number.
+type PageToken struct {
+	Cursor  string `json:"c,omitempty"`
+	PageNum int    `json:"p,omitempty"`
+}
+
+// PageFetcher calls a paginated Atlas list endpoint, returning the results of the requested page
+// and the total count of items (requested with IncludeCount).
+type PageFetcher[T any] func(pageNum, itemsPerPage int) (results []T, totalCount int, resp *http.Response, err error)
+
+// EncodeNextToken returns the opaque NextToken for the given page, an empty token means there are no more pages.
+func EncodeNextToken(t PageToken) string {
+	if t.PageNum == 0 && t.Cursor == "" {
+		return ""
+	}
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeNextToken parses a NextToken created by EncodeNextToken. An empty token points to the first page.
+func DecodeNextToken(token string) (PageToken, error) {
+	if token == "" {
+		return PageToken{PageNum: 1}, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return PageToken{}, fmt.Errorf("invalid NextToken %q: %w", token, err)
+	}
+	var t PageToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return PageToken{}, fmt.Errorf("invalid NextToken %q: %w", token, err)
+	}
+	if t.PageNum <= 0 {
+		return PageToken{}, fmt.Errorf("invalid NextToken %q", token)
+	}
+	return t, nil
+}
+
+// ListPage fetches the page pointed by the request NextToken (or the first page if not present)
+// and returns its results together with the NextToken of the following page, empty if it was the last one.
+// itemsPerPage falls back to constants.DefaultListItemsPerPage when not positive.
+func ListPage[T any](req *handler.Request, itemsPerPage int, fetch PageFetcher[T]) (results []T, nextToken string, peErr *handler.ProgressEvent) {
+	token, err := DecodeNextToken(req.RequestContext.NextToken)
+	if err != nil {
+		pe := progressevent.GetFailedEventByCode(err.Error(), cloudformation.HandlerErrorCodeInvalidRequest)
+		return nil, "", &pe
+	}
+	if itemsPerPage <= 0 {
+		itemsPerPage = constants.DefaultListItemsPerPage
+	}
+
+	results, totalCount, resp, err := fetch(token.PageNum, itemsPerPage)
+	if err != nil {
+		pe := progressevent.GetFailedEventByError(err, resp)
+		return nil, "", &pe
+	}
+
+	if token.PageNum*itemsPerPage < totalCount {
+		nextToken = EncodeNextToken(PageToken{PageNum: token.PageNum + 1})
+	}
+	return results, nextToken, nil
+}
+
+// ListAllPages fetches every page of a paginated Atlas list endpoint. It is meant for resources that aggregate
+// all the Atlas items into a single CloudFormation model, List handlers should use ListPage instead.
+func ListAllPages[T any](itemsPerPage int, fetch PageFetcher[T]) ([]T, *http.Response, error) {
+	if itemsPerPage <= 0 {
+		itemsPerPage = constants.DefaultListItemsPerPage
+	}
+	accumulated := make([]T, 0)
+	for pageNum := 1; ; pageNum++ {
+		results, totalCount, resp, err := fetch(pageNum, itemsPerPage)
+		if err != nil {
+			return nil, resp, err
+		}
+		accumulated = append(accumulated, results...)
+		if len(results) == 0 || pageNum*itemsPerPage >= totalCount {
+			return accumulated, resp, nil
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePages simulates an Atlas paginated endpoint holding total items.
+func fakePages(total int, requested *[]int) util.PageFetcher[int] {
+	return func(pageNum, itemsPerPage int) ([]int, int, *http.Response, error) {
+		*requested = append(*requested, pageNum)
+		results := make([]int, 0, itemsPerPage)
+		for i := (pageNum - 1) * itemsPerPage; i < total && i < pageNum*itemsPerPage; i++ {
+			results = append(results, i)
+		}
+		return results, total, &http.Response{StatusCode: http.StatusOK}, nil
+	}
+}
+
+func TestNextTokenRoundTrip(t *testing.T) {
+	for _, token := range []util.PageToken{{PageNum: 1}, {PageNum: 42}, {Cursor: "abc+/=", PageNum: 2}} {
+		encoded := util.EncodeNextToken(token)
+		assert.NotEmpty(t, encoded)
+		decoded, err := util.DecodeNextToken(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, token, decoded)
+	}
+	assert.Empty(t, util.EncodeNextToken(util.PageToken{}))
+}
+
+func TestDecodeNextToken(t *testing.T) {
+	first, err := util.DecodeNextToken("")
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.PageNum)
+
+	invalid := []string{
+		"not base64!",
+		"bm90IGpzb24",
+		util.EncodeNextToken(util.PageToken{PageNum: -1}),
+		util.EncodeNextToken(util.PageToken{Cursor: "abc"}),
+	}
+	for _, invalid := range invalid {
+		_, err := util.DecodeNextToken(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestListPageFollowsNextToken(t *testing.T) {
+	var requested []int
+	var all []int
+	req := handler.Request{}
+	for {
+		results, nextToken, peErr := util.ListPage(&req, 10, fakePages(25, &requested))
+		require.Nil(t, peErr)
+		all = append(all, results...)
+		if nextToken == "" {
+			break
+		}
+		req.RequestContext.NextToken = nextToken
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, requested)
+	assert.Len(t, all, 25)
+	assert.Equal(t, 24, all[24])
+}
+
+func TestListPageLastPage(t *testing.T) {
+	var requested []int
+	req := handler.Request{}
+	results, nextToken, peErr := util.ListPage(&req, 0, fakePages(100, &requested))
+	require.Nil(t, peErr)
+	assert.Len(t, results, 100)
+	assert.Empty(t, nextToken)
+}
+
+func TestListPageErrors(t *testing.T) {
+	for _, token := range []string{"???", util.EncodeNextToken(util.PageToken{Cursor: "abc"})} {
+		req := handler.Request{RequestContext: handler.RequestContext{NextToken: token}}
+		_, _, peErr := util.ListPage(&req, 10, func(int, int) ([]int, int, *http.Response, error) {
+			t.Fatal("fetch must not be called with an invalid token")
+			return nil, 0, nil, nil
+		})
+		require.NotNil(t, peErr, token)
+		assert.Equal(t, cloudformation.HandlerErrorCodeInvalidRequest, peErr.HandlerErrorCode)
+	}
+
+	req := handler.Request{}
+	_, _, peErr := util.ListPage(&req, 10, func(int, int) ([]int, int, *http.Response, error) {
+		return nil, 0, &http.Response{StatusCode: http.StatusNotFound}, errors.New("project not found")
+	})
+	require.NotNil(t, peErr)
+	assert.Equal(t, handler.Failed, peErr.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeNotFound, peErr.HandlerErrorCode)
+}
+
+func TestListAllPages(t *testing.T) {
+	var requested []int
+	all, _, err := util.ListAllPages(10, fakePages(31, &requested))
+	require.NoError(t, err)
+	assert.Len(t, all, 31)
+	assert.Equal(t, []int{1, 2, 3, 4}, requested)
+}
@@ -0,0 +1,199 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plan describes the Atlas API calls an Update handler makes for the difference between the previous and
+// the current model of a resource, flagging the disruptive ones, e.g. a rolling restart of a cluster or a revoked
+// access, so that the reviewers of a change set can tell what an update does in Atlas.
+//
+// The plan is reported instead of running the Update when the PlanOnly flag of the type configuration is set, and
+// by the tool/update-plan command from two model files.
+// A plan-only Update succeeds without calling Atlas when Atlas already has the current model, e.g. in the rollback
+// of a planned Update.
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// Call is an Atlas API call made by an Update.
+type Call struct {
+	// Operation is the Atlas API operation, e.g. ClustersApi.UpdateCluster.
+	Operation   string
+	Description string
+	// Disruptive calls can interrupt the clients of the resource or revoke an access.
+	Disruptive bool
+}
+
+// Plan is the list of the Atlas API calls made by an Update, in order.
+type Plan struct {
+	Calls []Call
+}
+
+// Add appends a call to the plan.
+func (p *Plan) Add(operation string, disruptive bool, format string, a ...any) {
+	p.Calls = append(p.Calls, Call{Operation: operation, Description: fmt.Sprintf(format, a...), Disruptive: disruptive})
+}
+
+// Disruptive reports if one of the calls of the plan is disruptive.
+func (p *Plan) Disruptive() bool {
+	return slices.ContainsFunc(p.Calls, func(c Call) bool { return c.Disruptive })
+}
+
+// String returns the plan with a call per line.
+func (p *Plan) String() string {
+	if len(p.Calls) == 0 {
+		return "No Atlas API call"
+	}
+	lines := make([]string, 0, len(p.Calls))
+	for _, c := range p.Calls {
+		line := fmt.Sprintf("%s: %s", c.Operation, c.Description)
+		if c.Disruptive {
+			line += " (disruptive)"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Event returns the Failed event of an Update in plan-only mode, reporting the plan. Nothing is changed in Atlas and
+// the failure makes CloudFormation roll the stack back.
+func (p *Plan) Event() handler.ProgressEvent {
+	summary := "no disruptive change"
+	if p.Disruptive() {
+		summary = "DISRUPTIVE changes"
+	}
+	return handler.ProgressEvent{
+		OperationStatus:  handler.Failed,
+		Message:          fmt.Sprintf("Plan only, nothing was changed in Atlas (%s):\n%s", summary, p),
+		HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest,
+	}
+}
+
+// OnlyEvent returns the event of an Update in plan-only mode. When applied, i.e. Atlas already has the current model,
+// the Update succeeds without calling Atlas: this is the case when the models are unchanged, or when CloudFormation
+// rolls back a planned Update, which changed nothing. Otherwise it fails with the plan, see Event.
+func (p *Plan) OnlyEvent(applied bool, currentModel any) handler.ProgressEvent {
+	if !applied {
+		return p.Event()
+	}
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Plan only, Atlas already has the current model",
+		ResourceModel:   currentModel,
+	}
+}
+
+// TypePlanOnly reports if the PlanOnly flag of the type configuration of the request is set.
+func TypePlanOnly(req *handler.Request) bool {
+	config := struct {
+		PlanOnly *bool `json:",omitempty"`
+	}{}
+	if err := req.UnmarshalTypeConfig(&config); err != nil {
+		return false
+	}
+	return aws.BoolValue(config.PlanOnly)
+}
+
+// RemovedRoles returns the roles of prev which aren't in current.
+func RemovedRoles(prev, current []string) []string {
+	return slices.DeleteFunc(slices.Clone(prev), func(role string) bool { return slices.Contains(current, role) })
+}
+
+// Revoking describes the removed roles of a call, empty if none.
+func Revoking(removed []string) string {
+	if len(removed) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", revoking %v", removed)
+}
+
+// ChangedProperties returns the sorted names of the properties that differ between the models, except the ignored ones.
+func ChangedProperties(prevModel, currentModel any, ignored ...string) []string {
+	prev, current := properties(prevModel), properties(currentModel)
+	var changed []string
+	for name := range current {
+		if !reflect.DeepEqual(prev[name], current[name]) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range prev {
+		if _, ok := current[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	changed = slices.DeleteFunc(changed, func(name string) bool { return slices.Contains(ignored, name) })
+	slices.Sort(changed)
+	return changed
+}
+
+// Applied reports if the model read from Atlas has every property set in the current model. The properties the read
+// model doesn't have, e.g. the write-only ones, are compared with the previous model instead. A list is applied when it
+// has the same length and each of its items is applied.
+func Applied(atlasModel, prevModel, currentModel any) bool {
+	return contains(properties(atlasModel), properties(prevModel), properties(currentModel))
+}
+
+func contains(actual, prev, desired any) bool {
+	if actual == nil {
+		return reflect.DeepEqual(prev, desired)
+	}
+	switch desired := desired.(type) {
+	case map[string]any:
+		actual, ok := actual.(map[string]any)
+		if !ok {
+			return false
+		}
+		prev, _ := prev.(map[string]any)
+		for name, value := range desired {
+			if !contains(actual[name], prev[name], value) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		actual, ok := actual.([]any)
+		if !ok || len(actual) != len(desired) {
+			return false
+		}
+		prev, _ := prev.([]any)
+		for i := range desired {
+			var prevItem any
+			if i < len(prev) {
+				prevItem = prev[i]
+			}
+			if !contains(actual[i], prevItem, desired[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(actual, desired)
+	}
+}
+
+func properties(model any) map[string]any {
+	m := map[string]any{}
+	if b, err := json.Marshal(model); err == nil {
+		_ = json.Unmarshal(b, &m)
+	}
+	return m
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan_test
+
+import (
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/plan"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypePlanOnly(t *testing.T) {
+	testCases := map[string]struct {
+		typeConfig string
+		expected   bool
+	}{
+		"noTypeConfig":  {typeConfig: "", expected: false},
+		"notSet":        {typeConfig: `{"ProfileBackend":"Environment"}`, expected: false},
+		"disabled":      {typeConfig: `{"PlanOnly":"false"}`, expected: false},
+		"enabled":       {typeConfig: `{"PlanOnly":"true"}`, expected: true},
+		"enabledNative": {typeConfig: `{"PlanOnly":true}`, expected: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := handler.NewRequest("", nil, handler.RequestContext{}, nil, nil, nil, []byte(tc.typeConfig))
+			assert.Equal(t, tc.expected, plan.TypePlanOnly(&req))
+		})
+	}
+}
+
+func TestChangedProperties(t *testing.T) {
+	type model struct {
+		Name  *string  `json:",omitempty"`
+		Roles []string `json:",omitempty"`
+		Size  *int     `json:",omitempty"`
+	}
+	name, other, size := "a", "b", 10
+	testCases := map[string]struct {
+		prev, current model
+		ignored       []string
+		expected      []string
+	}{
+		"unchanged":  {prev: model{Name: &name}, current: model{Name: &name}, expected: nil},
+		"changed":    {prev: model{Name: &name, Roles: []string{"r1"}}, current: model{Name: &other, Roles: []string{"r2"}}, expected: []string{"Name", "Roles"}},
+		"added":      {prev: model{Name: &name}, current: model{Name: &name, Size: &size}, expected: []string{"Size"}},
+		"removed":    {prev: model{Name: &name, Size: &size}, current: model{Name: &name}, expected: []string{"Size"}},
+		"ignored":    {prev: model{Name: &name, Size: &size}, current: model{Name: &other}, ignored: []string{"Size"}, expected: []string{"Name"}},
+		"emptyModel": {prev: model{}, current: model{}, expected: nil},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, plan.ChangedProperties(tc.prev, tc.current, tc.ignored...))
+		})
+	}
+}
+
+func TestRemovedRoles(t *testing.T) {
+	assert.Equal(t, []string{"r1"}, plan.RemovedRoles([]string{"r1", "r2"}, []string{"r2", "r3"}))
+	assert.Empty(t, plan.RemovedRoles([]string{"r1"}, []string{"r1", "r2"}))
+	assert.Empty(t, plan.Revoking(nil))
+	assert.Equal(t, ", revoking [r1]", plan.Revoking([]string{"r1"}))
+}
+
+func TestPlanEvent(t *testing.T) {
+	p := new(plan.Plan)
+	assert.Equal(t, "No Atlas API call", p.String())
+	assert.False(t, p.Disruptive())
+
+	p.Add("ClustersApi.UpdateCluster", false, "update %s", "DiskSizeGB")
+	p.Add("ClustersApi.UpdateCluster", true, "pause the cluster")
+	assert.True(t, p.Disruptive())
+
+	event := p.Event()
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeInvalidRequest, event.HandlerErrorCode)
+	assert.Equal(t, "Plan only, nothing was changed in Atlas (DISRUPTIVE changes):\n"+
+		"ClustersApi.UpdateCluster: update DiskSizeGB\n"+
+		"ClustersApi.UpdateCluster: pause the cluster (disruptive)", event.Message)
+}
+
+func TestApplied(t *testing.T) {
+	type item struct {
+		Key   *string `json:",omitempty"`
+		Value *string `json:",omitempty"`
+	}
+	type model struct {
+		Name   *string `json:",omitempty"`
+		Secret *string `json:",omitempty"`
+		Items  []item  `json:",omitempty"`
+		ID     *string `json:",omitempty"`
+	}
+	a, b, id := "a", "b", "id"
+	atlas := model{Name: &a, Items: []item{{Key: &a}}, ID: &id}
+	testCases := map[string]struct {
+		prev, current model
+		expected      bool
+	}{
+		"applied":        {current: model{Name: &a, Items: []item{{Key: &a}}}, expected: true},
+		"changedName":    {current: model{Name: &b}, expected: false},
+		"changedItem":    {current: model{Items: []item{{Key: &b}}}, expected: false},
+		"extraItem":      {current: model{Items: []item{{Key: &a}, {Key: &b}}}, expected: false},
+		"notReadSame":    {prev: model{Secret: &a}, current: model{Name: &a, Secret: &a}, expected: true},
+		"notReadChanged": {prev: model{Secret: &a}, current: model{Name: &a, Secret: &b}, expected: false},
+		"notReadInItem":  {prev: model{Items: []item{{Key: &a, Value: &b}}}, current: model{Items: []item{{Key: &a, Value: &b}}}, expected: true},
+		"newInItem":      {prev: model{Items: []item{{Key: &a}}}, current: model{Items: []item{{Key: &a, Value: &b}}}, expected: false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, plan.Applied(atlas, tc.prev, tc.current))
+		})
+	}
+}
+
+func TestPlanOnlyEvent(t *testing.T) {
+	p := new(plan.Plan)
+	p.Add("ClustersApi.UpdateCluster", false, "update %s", "DiskSizeGB")
+
+	assert.Equal(t, handler.Failed, p.OnlyEvent(false, nil).OperationStatus)
+	event := p.OnlyEvent(true, "model")
+	assert.Equal(t, handler.Success, event.OperationStatus)
+	assert.Equal(t, "model", event.ResourceModel)
+}
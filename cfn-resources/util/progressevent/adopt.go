@@ -0,0 +1,45 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progressevent
+
+import (
+	"fmt"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// AdoptedKey is set in the callback context of a Create that adopted an existing object, so that its last event
+// notes the adoption.
+const AdoptedKey = "Adopted"
+
+// TypeAdoptIfExists reports if the AdoptIfExists flag of the type configuration of the request is set. With the flag,
+// a Create finding an object with the same identifier in Atlas adopts it, updating it to match the template, instead
+// of failing with AlreadyExists.
+func TypeAdoptIfExists(req *handler.Request) bool {
+	config := struct {
+		AdoptIfExists *bool `json:",omitempty"`
+	}{}
+	if err := req.UnmarshalTypeConfig(&config); err != nil {
+		return false
+	}
+	return aws.BoolValue(config.AdoptIfExists)
+}
+
+// AdoptedMessage is the message of the Success event of a Create that adopted an existing object, e.g.
+// AdoptedMessage("Cluster", "Cluster0").
+func AdoptedMessage(resource, id string) string {
+	return fmt.Sprintf("Create Complete: the existing %s %s was adopted and updated to match the template", resource, id)
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progressevent_test
+
+import (
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeAdoptIfExists(t *testing.T) {
+	testCases := map[string]struct {
+		typeConfig string
+		expected   bool
+	}{
+		"noTypeConfig":  {typeConfig: "", expected: false},
+		"notSet":        {typeConfig: `{"ProfileBackend":"Environment"}`, expected: false},
+		"disabled":      {typeConfig: `{"AdoptIfExists":"false"}`, expected: false},
+		"enabled":       {typeConfig: `{"AdoptIfExists":"true"}`, expected: true},
+		"enabledNative": {typeConfig: `{"AdoptIfExists":true}`, expected: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := handler.NewRequest("", nil, handler.RequestContext{}, nil, nil, nil, []byte(tc.typeConfig))
+			assert.Equal(t, tc.expected, progressevent.TypeAdoptIfExists(&req))
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progressevent
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	admin20231115002 "go.mongodb.org/atlas-sdk/v20231115002/admin"
+	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+	"go.mongodb.org/atlas-sdk/v20241113002/admin"
+)
+
+// errorCodes maps the Atlas error codes to handler error codes, when the generic rules of HandlerErrorCode don't apply.
+var errorCodes = map[string]string{
+	"ATLAS_GENERAL_ERROR":                           cloudformation.HandlerErrorCodeServiceInternalError,
+	"UNEXPECTED_ERROR":                              cloudformation.HandlerErrorCodeServiceInternalError,
+	"RATE_LIMITED":                                  cloudformation.HandlerErrorCodeThrottling,
+	"TOO_MANY_REQUESTS":                             cloudformation.HandlerErrorCodeThrottling,
+	"NOT_IN_GROUP":                                  cloudformation.HandlerErrorCodeNotFound, // the project was deleted
+	"CLUSTER_ALREADY_REQUESTED_DELETION":            cloudformation.HandlerErrorCodeNotFound,
+	"CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT":        cloudformation.HandlerErrorCodeResourceConflict,
+	"CANNOT_CLOSE_GROUP_ACTIVE_ATLAS_CLUSTERS":      cloudformation.HandlerErrorCodeResourceConflict,
+	"CANNOT_CLOSE_GROUP_ACTIVE_PEERING_CONNECTIONS": cloudformation.HandlerErrorCodeResourceConflict,
+	"CONTAINERS_IN_USE":                             cloudformation.HandlerErrorCodeResourceConflict,
+}
+
+// statusCodes maps the HTTP status of Atlas responses to handler error codes, when the Atlas error code is unknown.
+var statusCodes = map[int]string{
+	http.StatusBadRequest:          cloudformation.HandlerErrorCodeInvalidRequest,
+	http.StatusUnauthorized:        cloudformation.HandlerErrorCodeAccessDenied,
+	http.StatusPaymentRequired:     cloudformation.HandlerErrorCodeAccessDenied,
+	http.StatusForbidden:           cloudformation.HandlerErrorCodeAccessDenied,
+	http.StatusNotFound:            cloudformation.HandlerErrorCodeNotFound,
+	http.StatusConflict:            cloudformation.HandlerErrorCodeResourceConflict,
+	http.StatusTooManyRequests:     cloudformation.HandlerErrorCodeThrottling,
+	http.StatusInternalServerError: cloudformation.HandlerErrorCodeServiceInternalError,
+	http.StatusBadGateway:          cloudformation.HandlerErrorCodeServiceInternalError,
+	http.StatusServiceUnavailable:  cloudformation.HandlerErrorCodeServiceInternalError,
+	http.StatusGatewayTimeout:      cloudformation.HandlerErrorCodeServiceInternalError,
+}
+
+// AtlasError is the error returned by Atlas, whatever the version of the SDK that decoded it.
+type AtlasError struct {
+	ErrorCode string
+	Detail    string
+	Status    int
+}
+
+// AsAtlasError returns the Atlas error wrapped by err, returned by any of the Atlas SDK versions used by the resources.
+func AsAtlasError(err error) (*AtlasError, bool) {
+	if apiError, ok := admin.AsError(err); ok {
+		return &AtlasError{ErrorCode: apiError.GetErrorCode(), Detail: apiError.GetDetail(), Status: apiError.GetError()}, true
+	}
+	if apiError, ok := admin20231115014.AsError(err); ok {
+		return &AtlasError{ErrorCode: apiError.GetErrorCode(), Detail: apiError.GetDetail(), Status: apiError.GetError()}, true
+	}
+	if apiError, ok := admin20231115002.AsError(err); ok {
+		return &AtlasError{ErrorCode: apiError.GetErrorCode(), Detail: apiError.GetDetail(), Status: apiError.GetError()}, true
+	}
+	return nil, false
+}
+
+// IsErrorCode reports if err is an Atlas error with the given error code.
+func IsErrorCode(err error, errorCode string) bool {
+	apiError, ok := AsAtlasError(err)
+	return ok && apiError.ErrorCode == errorCode
+}
+
+// IsNotFound reports if err is an Atlas error for an object that doesn't exist.
+func IsNotFound(err error) bool {
+	apiError, ok := AsAtlasError(err)
+	return ok && HandlerErrorCode(apiError.ErrorCode, apiError.Status) == cloudformation.HandlerErrorCodeNotFound
+}
+
+// IsAlreadyExists reports if err is an Atlas error for an object that already exists.
+func IsAlreadyExists(err error) bool {
+	apiError, ok := AsAtlasError(err)
+	return ok && HandlerErrorCode(apiError.ErrorCode, apiError.Status) == cloudformation.HandlerErrorCodeAlreadyExists
+}
+
+// HandlerErrorCode returns the handler error code for the Atlas error code, or for the HTTP status if the error code
+// is not known. Besides the known codes, *_NOT_FOUND and *_DOES_NOT_EXIST codes are NotFound, DUPLICATE_* and *_ALREADY_EXISTS codes
+// are AlreadyExists, *_LIMIT_EXCEEDED and MAX_* codes are ServiceLimitExceeded, *_IN_PROGRESS codes are
+// ResourceConflict and *_NOT_IDLE and *_NOT_READY codes are NotStabilized.
+func HandlerErrorCode(errorCode string, status int) string {
+	if code, ok := errorCodes[errorCode]; ok {
+		return code
+	}
+	switch {
+	case errorCode == "":
+	case strings.HasSuffix(errorCode, "_NOT_FOUND"), strings.HasSuffix(errorCode, "_DOES_NOT_EXIST"):
+		return cloudformation.HandlerErrorCodeNotFound
+	case strings.Contains(errorCode, "DUPLICATE"), strings.HasSuffix(errorCode, "_ALREADY_EXISTS"):
+		return cloudformation.HandlerErrorCodeAlreadyExists
+	case strings.Contains(errorCode, "LIMIT_EXCEEDED"), strings.HasPrefix(errorCode, "MAX_"):
+		return cloudformation.HandlerErrorCodeServiceLimitExceeded
+	case strings.HasSuffix(errorCode, "_IN_PROGRESS"):
+		return cloudformation.HandlerErrorCodeResourceConflict
+	case strings.HasSuffix(errorCode, "_NOT_IDLE"), strings.HasSuffix(errorCode, "_NOT_READY"):
+		return cloudformation.HandlerErrorCodeNotStabilized
+	}
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+	return cloudformation.HandlerErrorCodeInternalFailure
+}
+
+// GetFailedEventByError returns the failed event for the error of an Atlas call, with the handler error code of
+// its Atlas error code and a message made of the error detail. Errors not returned by Atlas are mapped by the
+// status of the response.
+func GetFailedEventByError(err error, response *http.Response) handler.ProgressEvent {
+	apiError, ok := AsAtlasError(err)
+	if !ok {
+		return GetFailedEventByResponse(err.Error(), response)
+	}
+
+	status := apiError.Status
+	if status == 0 && response != nil {
+		status = response.StatusCode
+	}
+	message := err.Error()
+	if apiError.Detail != "" {
+		message = fmt.Sprintf("%s (%s)", apiError.Detail, apiError.ErrorCode)
+	}
+	return handler.ProgressEvent{
+		OperationStatus:  handler.Failed,
+		Message:          message,
+		HandlerErrorCode: HandlerErrorCode(apiError.ErrorCode, status)}
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progressevent_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	admin20231115002 "go.mongodb.org/atlas-sdk/v20231115002/admin"
+	"go.mongodb.org/atlas-sdk/v20241113002/admin"
+)
+
+func TestHandlerErrorCode(t *testing.T) {
+	testCases := []struct {
+		errorCode string
+		expected  string
+		status    int
+	}{
+		{errorCode: "CLUSTER_NOT_FOUND", status: http.StatusNotFound, expected: cloudformation.HandlerErrorCodeNotFound},
+		{errorCode: "GROUP_NOT_FOUND", status: http.StatusBadRequest, expected: cloudformation.HandlerErrorCodeNotFound},
+		{errorCode: "ATLAS_FTS_DEPLOYMENT_DOES_NOT_EXIST", status: http.StatusBadRequest, expected: cloudformation.HandlerErrorCodeNotFound},
+		{errorCode: "NOT_IN_GROUP", status: http.StatusUnauthorized, expected: cloudformation.HandlerErrorCodeNotFound},
+		{errorCode: "CLUSTER_ALREADY_REQUESTED_DELETION", status: http.StatusBadRequest, expected: cloudformation.HandlerErrorCodeNotFound},
+		{errorCode: "DUPLICATE_CLUSTER_NAME", status: http.StatusBadRequest, expected: cloudformation.HandlerErrorCodeAlreadyExists},
+		{errorCode: "USER_ALREADY_EXISTS", status: http.StatusConflict, expected: cloudformation.HandlerErrorCodeAlreadyExists},
+		{errorCode: "MAX_CLUSTERS_PER_GROUP_EXCEEDED", status: http.StatusBadRequest, expected: cloudformation.HandlerErrorCodeServiceLimitExceeded},
+		{errorCode: "ORG_LIMIT_EXCEEDED", status: http.StatusBadRequest, expected: cloudformation.HandlerErrorCodeServiceLimitExceeded},
+		{errorCode: "CLUSTER_RESTORE_IN_PROGRESS", status: http.StatusConflict, expected: cloudformation.HandlerErrorCodeResourceConflict},
+		{errorCode: "CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT", status: http.StatusConflict, expected: cloudformation.HandlerErrorCodeResourceConflict},
+		{errorCode: "CLUSTER_NOT_IDLE", status: http.StatusConflict, expected: cloudformation.HandlerErrorCodeNotStabilized},
+		{errorCode: "RATE_LIMITED", status: http.StatusTooManyRequests, expected: cloudformation.HandlerErrorCodeThrottling},
+		{errorCode: "UNEXPECTED_ERROR", status: http.StatusInternalServerError, expected: cloudformation.HandlerErrorCodeServiceInternalError},
+		{errorCode: "INVALID_ATTRIBUTE", status: http.StatusBadRequest, expected: cloudformation.HandlerErrorCodeInvalidRequest},
+		{errorCode: "", status: http.StatusBadRequest, expected: cloudformation.HandlerErrorCodeInvalidRequest},
+		{errorCode: "", status: http.StatusUnauthorized, expected: cloudformation.HandlerErrorCodeAccessDenied},
+		{errorCode: "", status: http.StatusForbidden, expected: cloudformation.HandlerErrorCodeAccessDenied},
+		{errorCode: "", status: http.StatusNotFound, expected: cloudformation.HandlerErrorCodeNotFound},
+		{errorCode: "", status: http.StatusConflict, expected: cloudformation.HandlerErrorCodeResourceConflict},
+		{errorCode: "", status: http.StatusTooManyRequests, expected: cloudformation.HandlerErrorCodeThrottling},
+		{errorCode: "", status: http.StatusServiceUnavailable, expected: cloudformation.HandlerErrorCodeServiceInternalError},
+		{errorCode: "", status: 0, expected: cloudformation.HandlerErrorCodeInternalFailure},
+		{errorCode: "UNKNOWN_CODE", status: http.StatusTeapot, expected: cloudformation.HandlerErrorCodeInternalFailure},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s %d", tc.errorCode, tc.status), func(t *testing.T) {
+			assert.Equal(t, tc.expected, progressevent.HandlerErrorCode(tc.errorCode, tc.status))
+		})
+	}
+}
+
+func TestGetFailedEventByError(t *testing.T) {
+	apiError := new(admin.GenericOpenAPIError)
+	apiError.SetError("409 Conflict")
+	apiError.SetModel(admin.ApiError{Error: http.StatusConflict, ErrorCode: "DUPLICATE_CLUSTER_NAME", Detail: admin.PtrString("Cluster test already exists.")})
+
+	oldAPIError := new(admin20231115002.GenericOpenAPIError)
+	oldAPIError.SetError("404 Not Found")
+	oldAPIError.SetModel(admin20231115002.ApiError{Error: admin20231115002.PtrInt(http.StatusNotFound), ErrorCode: admin20231115002.PtrString("GROUP_NOT_FOUND")})
+
+	testCases := map[string]struct {
+		err      error
+		response *http.Response
+		expected handler.ProgressEvent
+	}{
+		"atlasError": {
+			err: apiError,
+			expected: handler.ProgressEvent{OperationStatus: handler.Failed, Message: "Cluster test already exists. (DUPLICATE_CLUSTER_NAME)",
+				HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists},
+		},
+		"wrappedAtlasErrorWithoutDetail": {
+			err: fmt.Errorf("error reading project: %w", oldAPIError),
+			expected: handler.ProgressEvent{OperationStatus: handler.Failed, Message: "error reading project: 404 Not Found",
+				HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound},
+		},
+		"otherError": {
+			err:      errors.New("timeout"),
+			response: &http.Response{StatusCode: http.StatusGatewayTimeout},
+			expected: handler.ProgressEvent{OperationStatus: handler.Failed, Message: "timeout",
+				HandlerErrorCode: cloudformation.HandlerErrorCodeServiceInternalError},
+		},
+		"otherErrorWithoutResponse": {
+			err: errors.New("connection refused"),
+			expected: handler.ProgressEvent{OperationStatus: handler.Failed, Message: "connection refused",
+				HandlerErrorCode: cloudformation.HandlerErrorCodeHandlerInternalFailure},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, progressevent.GetFailedEventByError(tc.err, tc.response))
+		})
+	}
+}
+
+func TestIsErrorCode(t *testing.T) {
+	apiError := new(admin.GenericOpenAPIError)
+	apiError.SetModel(admin.ApiError{ErrorCode: "CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT"})
+
+	assert.True(t, progressevent.IsErrorCode(fmt.Errorf("delete: %w", apiError), "CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT"))
+	assert.False(t, progressevent.IsErrorCode(apiError, "NOT_IN_GROUP"))
+	assert.False(t, progressevent.IsErrorCode(errors.New("CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT"), "CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT"))
+}
+
+func TestIsNotFound(t *testing.T) {
+	notFound := new(admin.GenericOpenAPIError)
+	notFound.SetModel(admin.ApiError{Error: http.StatusNotFound, ErrorCode: "GROUP_NOT_FOUND"})
+	conflict := new(admin.GenericOpenAPIError)
+	conflict.SetModel(admin.ApiError{Error: http.StatusConflict, ErrorCode: "DUPLICATE_CLUSTER_NAME"})
+
+	assert.True(t, progressevent.IsNotFound(fmt.Errorf("get: %w", notFound)))
+	assert.False(t, progressevent.IsNotFound(conflict))
+	assert.False(t, progressevent.IsNotFound(errors.New("404 Not Found")))
+
+	assert.True(t, progressevent.IsAlreadyExists(fmt.Errorf("create: %w", conflict)))
+	assert.False(t, progressevent.IsAlreadyExists(notFound))
+}
@@ -21,21 +21,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
-func getHandlerErrorCode(response *http.Response) string {
-	switch response.StatusCode {
-	case http.StatusBadRequest:
-		return cloudformation.HandlerErrorCodeInvalidRequest
-	case http.StatusNotFound:
-		return cloudformation.HandlerErrorCodeNotFound
-	case http.StatusInternalServerError:
-		return cloudformation.HandlerErrorCodeServiceInternalError
-	case http.StatusPaymentRequired, http.StatusUnauthorized:
-		return cloudformation.HandlerErrorCodeAccessDenied
-	default:
-		return cloudformation.HandlerErrorCodeInternalFailure
-	}
-}
-
+// GetFailedEventByResponse returns the failed event for the HTTP status of the response,
+// GetFailedEventByError is preferred for the errors returned by Atlas as it also uses the Atlas error code.
 func GetFailedEventByResponse(message string, response *http.Response) handler.ProgressEvent {
 	if response == nil {
 		return handler.ProgressEvent{
@@ -44,31 +31,10 @@ func GetFailedEventByResponse(message string, response *http.Response) handler.P
 			HandlerErrorCode: cloudformation.HandlerErrorCodeHandlerInternalFailure}
 	}
 
-	if response.StatusCode == http.StatusConflict {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          message,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeAlreadyExists}
-	}
-
-	if response.StatusCode == http.StatusUnauthorized {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          "Not found",
-			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}
-	}
-
-	if response.StatusCode == http.StatusBadRequest {
-		return handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          message,
-			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}
-	}
-
 	return handler.ProgressEvent{
 		OperationStatus:  handler.Failed,
 		Message:          message,
-		HandlerErrorCode: getHandlerErrorCode(response)}
+		HandlerErrorCode: HandlerErrorCode("", response.StatusCode)}
 }
 
 func GetFailedEventByCode(message, handlerErrorCode string) handler.ProgressEvent {
@@ -0,0 +1,213 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progressevent
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/spf13/cast"
+)
+
+const (
+	// DefaultPollTimeout is below the 120 minutes CloudFormation gives to a handler by default, so that the poller
+	// fails with the last state of the resource instead of CloudFormation failing with a generic timeout.
+	DefaultPollTimeout     = 110 * time.Minute
+	DefaultMinDelaySeconds = 10
+	DefaultMaxDelaySeconds = 60
+)
+
+// Keys of the callback context used by the Poller, the other keys of the context are kept between callbacks.
+const (
+	PollDeadlineKey = "PollDeadline"
+	PollStateKey    = "PollState"
+	PollAttemptKey  = "PollAttempt"
+)
+
+// PollState is the state of a long-running resource, read by its handler on each callback.
+type PollState struct {
+	// Model is returned in the events, it should be nil once the resource is deleted.
+	Model any
+	// Name is the state of the resource in Atlas, e.g. IDLE.
+	Name string
+	// FailureMessage is the reason of the failure reported by Atlas for the resource, if any.
+	FailureMessage string
+}
+
+// Poller waits through CloudFormation callbacks for a long-running resource to reach one of its target states.
+// Its deadline is stored in the callback context on the first poll, and the callback delay doubles from
+// MinDelaySeconds to MaxDelaySeconds while the state of the resource doesn't change.
+type Poller struct {
+	// Resource names the resource in the failure messages, e.g. Cluster.
+	Resource      string
+	TargetStates  []string
+	FailureStates []string
+	// Message of the InProgress events, constants.Pending by default.
+	Message string
+	// Timeout is the time given to reach a target state, DefaultPollTimeout by default.
+	Timeout         time.Duration
+	MinDelaySeconds int64
+	MaxDelaySeconds int64
+	// ReturnSuccessIfTimeOut returns Success with the model instead of failing once the deadline passed.
+	ReturnSuccessIfTimeOut bool
+}
+
+// TimeoutOptions overrides the timeout and callback delays of a Poller, set by the TimeoutOptions property of
+// the resource or of its type configuration.
+type TimeoutOptions struct {
+	TimeOutInSeconds       *int  `json:",omitempty"`
+	CallbackDelaySeconds   *int  `json:",omitempty"`
+	ReturnSuccessIfTimeOut *bool `json:",omitempty"`
+}
+
+// TypeTimeoutOptions returns the TimeoutOptions of the type configuration of the request, nil if it's not set.
+func TypeTimeoutOptions(req *handler.Request) *TimeoutOptions {
+	config := struct {
+		TimeoutOptions *TimeoutOptions `json:",omitempty"`
+	}{}
+	if err := req.UnmarshalTypeConfig(&config); err != nil {
+		return nil
+	}
+	return config.TimeoutOptions
+}
+
+// WithOptions returns a copy of the poller with the options applied, the first options setting a value take
+// precedence, e.g. the options of the resource before the ones of the type configuration.
+// A CallbackDelaySeconds option makes the delay fixed.
+func (p Poller) WithOptions(options ...*TimeoutOptions) *Poller {
+	var timeout, delay, returnSuccess bool
+	for _, o := range options {
+		if o == nil {
+			continue
+		}
+		if o.TimeOutInSeconds != nil && !timeout {
+			timeout = true
+			p.Timeout = time.Duration(*o.TimeOutInSeconds) * time.Second
+		}
+		if o.CallbackDelaySeconds != nil && !delay {
+			delay = true
+			p.MinDelaySeconds = int64(*o.CallbackDelaySeconds)
+			p.MaxDelaySeconds = p.MinDelaySeconds
+		}
+		if o.ReturnSuccessIfTimeOut != nil && !returnSuccess {
+			returnSuccess = true
+			p.ReturnSuccessIfTimeOut = *o.ReturnSuccessIfTimeOut
+		}
+	}
+	return &p
+}
+
+// Poll returns the event for the state read by the handler: Success once the resource reaches a target state,
+// Failed once it reaches a failure state or the deadline passed, and InProgress otherwise. callbackContext is the
+// callback context of the request, its values are kept in the InProgress event.
+func (p *Poller) Poll(callbackContext map[string]any, state PollState) handler.ProgressEvent {
+	if slices.Contains(p.TargetStates, state.Name) {
+		return handler.ProgressEvent{
+			OperationStatus: handler.Success,
+			Message:         constants.Complete,
+			ResourceModel:   state.Model,
+		}
+	}
+	if slices.Contains(p.FailureStates, state.Name) {
+		return p.failed(fmt.Sprintf("%s reached the %s state", p.resource(), state.Name), state)
+	}
+
+	now := time.Now()
+	deadline, err := time.Parse(time.RFC3339, cast.ToString(callbackContext[PollDeadlineKey]))
+	if err != nil {
+		deadline = now.Add(p.timeout())
+	}
+	if now.After(deadline) {
+		message := fmt.Sprintf("%s didn't reach the %s state before %s, it's still %s", p.resource(),
+			strings.Join(p.TargetStates, " or "), deadline.Format(time.RFC3339), state.Name)
+		if p.ReturnSuccessIfTimeOut {
+			return handler.ProgressEvent{
+				OperationStatus: handler.Success,
+				Message:         fmt.Sprintf("%s with timeout: %s", constants.Complete, message),
+				ResourceModel:   state.Model,
+			}
+		}
+		return p.failed(message, state)
+	}
+
+	attempt := 0
+	if previous, ok := callbackContext[PollStateKey]; ok && cast.ToString(previous) == state.Name {
+		attempt = cast.ToInt(callbackContext[PollAttemptKey]) + 1
+	}
+	next := make(map[string]any, len(callbackContext)+3)
+	maps.Copy(next, callbackContext)
+	next[PollDeadlineKey] = deadline.Format(time.RFC3339)
+	next[PollStateKey] = state.Name
+	next[PollAttemptKey] = attempt
+
+	message := p.Message
+	if message == "" {
+		message = constants.Pending
+	}
+	return handler.ProgressEvent{
+		OperationStatus:      handler.InProgress,
+		Message:              message,
+		ResourceModel:        state.Model,
+		CallbackDelaySeconds: p.delay(attempt),
+		CallbackContext:      next,
+	}
+}
+
+func (p *Poller) failed(message string, state PollState) handler.ProgressEvent {
+	if state.FailureMessage != "" {
+		message = fmt.Sprintf("%s: %s", message, state.FailureMessage)
+	}
+	return handler.ProgressEvent{
+		OperationStatus:  handler.Failed,
+		Message:          message,
+		HandlerErrorCode: cloudformation.HandlerErrorCodeNotStabilized,
+	}
+}
+
+// delay returns the callback delay of the attempt, doubled for each previous attempt with the same state.
+func (p *Poller) delay(attempt int) int64 {
+	minDelay, maxDelay := p.MinDelaySeconds, p.MaxDelaySeconds
+	if minDelay <= 0 {
+		minDelay = DefaultMinDelaySeconds
+	}
+	if maxDelay <= 0 {
+		maxDelay = max(DefaultMaxDelaySeconds, minDelay)
+	}
+	delay := minDelay
+	for i := 0; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+func (p *Poller) timeout() time.Duration {
+	if p.Timeout <= 0 {
+		return DefaultPollTimeout
+	}
+	return p.Timeout
+}
+
+func (p *Poller) resource() string {
+	if p.Resource == "" {
+		return "The resource"
+	}
+	return p.Resource
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progressevent_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoller(t *testing.T) {
+	poller := progressevent.Poller{
+		Resource:        "Cluster test",
+		TargetStates:    []string{"IDLE"},
+		FailureStates:   []string{"DELETED"},
+		MinDelaySeconds: 10,
+		MaxDelaySeconds: 40,
+	}
+	model := "model"
+	future := time.Now().Add(time.Hour).Format(time.RFC3339)
+
+	testCases := map[string]struct {
+		callbackContext map[string]any
+		state           progressevent.PollState
+		expected        handler.ProgressEvent
+	}{
+		"targetState": {
+			state:    progressevent.PollState{Name: "IDLE", Model: model},
+			expected: handler.ProgressEvent{OperationStatus: handler.Success, Message: constants.Complete, ResourceModel: model},
+		},
+		"failureState": {
+			state: progressevent.PollState{Name: "DELETED", FailureMessage: "deleted by user"},
+			expected: handler.ProgressEvent{OperationStatus: handler.Failed, Message: "Cluster test reached the DELETED state: deleted by user",
+				HandlerErrorCode: cloudformation.HandlerErrorCodeNotStabilized},
+		},
+		"deadlinePassed": {
+			callbackContext: map[string]any{progressevent.PollDeadlineKey: "2024-01-02T03:04:05Z"},
+			state:           progressevent.PollState{Name: "REPAIRING"},
+			expected: handler.ProgressEvent{OperationStatus: handler.Failed,
+				Message:          "Cluster test didn't reach the IDLE state before 2024-01-02T03:04:05Z, it's still REPAIRING",
+				HandlerErrorCode: cloudformation.HandlerErrorCodeNotStabilized},
+		},
+		"stateChanged": {
+			callbackContext: map[string]any{"id": "1", progressevent.PollDeadlineKey: future, progressevent.PollStateKey: "CREATING", progressevent.PollAttemptKey: 3.0},
+			state:           progressevent.PollState{Name: "UPDATING", Model: model},
+			expected: handler.ProgressEvent{OperationStatus: handler.InProgress, Message: constants.Pending, ResourceModel: model, CallbackDelaySeconds: 10,
+				CallbackContext: map[string]any{"id": "1", progressevent.PollDeadlineKey: future, progressevent.PollStateKey: "UPDATING", progressevent.PollAttemptKey: 0}},
+		},
+		"sameState": {
+			callbackContext: map[string]any{progressevent.PollDeadlineKey: future, progressevent.PollStateKey: "UPDATING", progressevent.PollAttemptKey: 0.0},
+			state:           progressevent.PollState{Name: "UPDATING", Model: model},
+			expected: handler.ProgressEvent{OperationStatus: handler.InProgress, Message: constants.Pending, ResourceModel: model, CallbackDelaySeconds: 20,
+				CallbackContext: map[string]any{progressevent.PollDeadlineKey: future, progressevent.PollStateKey: "UPDATING", progressevent.PollAttemptKey: 1}},
+		},
+		"maxDelay": {
+			callbackContext: map[string]any{progressevent.PollDeadlineKey: future, progressevent.PollStateKey: "UPDATING", progressevent.PollAttemptKey: 5.0},
+			state:           progressevent.PollState{Name: "UPDATING", Model: model},
+			expected: handler.ProgressEvent{OperationStatus: handler.InProgress, Message: constants.Pending, ResourceModel: model, CallbackDelaySeconds: 40,
+				CallbackContext: map[string]any{progressevent.PollDeadlineKey: future, progressevent.PollStateKey: "UPDATING", progressevent.PollAttemptKey: 6}},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, poller.Poll(tc.callbackContext, tc.state))
+		})
+	}
+}
+
+func TestPollerDeadline(t *testing.T) {
+	poller := progressevent.Poller{TargetStates: []string{"IDLE"}, Timeout: 30 * time.Minute}
+	callbackContext := map[string]any{"StateName": "CREATING"}
+
+	event := poller.Poll(callbackContext, progressevent.PollState{Name: "CREATING"})
+	require.Equal(t, handler.InProgress, event.OperationStatus)
+	assert.Equal(t, int64(progressevent.DefaultMinDelaySeconds), event.CallbackDelaySeconds)
+	assert.Equal(t, "CREATING", event.CallbackContext["StateName"])
+	assert.NotContains(t, callbackContext, progressevent.PollDeadlineKey, "the callback context of the request must not be modified")
+
+	deadline, err := time.Parse(time.RFC3339, event.CallbackContext[progressevent.PollDeadlineKey].(string))
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(30*time.Minute), deadline, time.Minute)
+
+	// the deadline is kept by the next callbacks
+	next := poller.Poll(event.CallbackContext, progressevent.PollState{Name: "UPDATING"})
+	assert.Equal(t, event.CallbackContext[progressevent.PollDeadlineKey], next.CallbackContext[progressevent.PollDeadlineKey])
+}
+
+func TestPollerWithOptions(t *testing.T) {
+	poller := progressevent.Poller{TargetStates: []string{"IDLE"}, Timeout: time.Hour, MinDelaySeconds: 10, MaxDelaySeconds: 40}
+	resourceOptions := &progressevent.TimeoutOptions{CallbackDelaySeconds: util.Pointer(15)}
+	typeOptions := &progressevent.TimeoutOptions{
+		TimeOutInSeconds:       util.Pointer(600),
+		CallbackDelaySeconds:   util.Pointer(30),
+		ReturnSuccessIfTimeOut: util.Pointer(true),
+	}
+
+	withOptions := poller.WithOptions(resourceOptions, nil, typeOptions)
+	assert.Equal(t, 10*time.Minute, withOptions.Timeout)
+	assert.Equal(t, int64(15), withOptions.MinDelaySeconds)
+	assert.Equal(t, int64(15), withOptions.MaxDelaySeconds)
+	assert.True(t, withOptions.ReturnSuccessIfTimeOut)
+	assert.Equal(t, time.Hour, poller.Timeout, "the poller must not be modified")
+
+	assert.Equal(t, poller, *poller.WithOptions())
+}
+
+func TestPollerReturnSuccessIfTimeOut(t *testing.T) {
+	poller := progressevent.Poller{Resource: "Cluster test", TargetStates: []string{"IDLE"}, ReturnSuccessIfTimeOut: true}
+	callbackContext := map[string]any{progressevent.PollDeadlineKey: "2024-01-02T03:04:05Z"}
+
+	event := poller.Poll(callbackContext, progressevent.PollState{Name: "UPDATING", Model: "model"})
+	assert.Equal(t, handler.ProgressEvent{OperationStatus: handler.Success, ResourceModel: "model",
+		Message: "Complete with timeout: Cluster test didn't reach the IDLE state before 2024-01-02T03:04:05Z, it's still UPDATING"}, event)
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"crypto/rand"
+	"math/big"
+	"net/url"
+	"strings"
+)
+
+const (
+	passwordLength = 32
+	passwordChars  = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+// DatabaseUserSecret is the secret of a database user whose password is generated by the database-user resource and
+// rotated by the secret rotation Lambda.
+type DatabaseUserSecret struct {
+	// ConnectionStrings maps the names of the clusters the user can access to their SRV connection string with the
+	// credentials of the user.
+	ConnectionStrings map[string]string `json:",omitempty"`
+	ProjectID         string            `json:"ProjectId"`
+	DatabaseName      string
+	Username          string
+	Password          string
+	// Profile is the profile of the resource, used by the rotation Lambda to update the password in Atlas.
+	Profile string
+}
+
+// SetPassword sets the password of the secret, including in its connection strings.
+func (s *DatabaseUserSecret) SetPassword(password string) {
+	s.Password = password
+	for name, connectionString := range s.ConnectionStrings {
+		s.ConnectionStrings[name] = s.withCredentials(connectionString)
+	}
+}
+
+// AddConnectionString adds the SRV connection string of a cluster, without credentials.
+func (s *DatabaseUserSecret) AddConnectionString(clusterName, standardSrv string) {
+	if s.ConnectionStrings == nil {
+		s.ConnectionStrings = map[string]string{}
+	}
+	s.ConnectionStrings[clusterName] = s.withCredentials(standardSrv)
+}
+
+func (s *DatabaseUserSecret) withCredentials(connectionString string) string {
+	u, err := url.Parse(connectionString)
+	if err != nil {
+		return connectionString
+	}
+	u.User = url.UserPassword(s.Username, s.Password)
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	query := u.Query()
+	query.Set("authSource", s.DatabaseName)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// GeneratePassword returns a random password of 32 letters and digits with at least an uppercase letter, a lowercase
+// letter and a digit, meeting the usual password policies without characters to escape in a connection string.
+func GeneratePassword() (string, error) {
+	charCount := big.NewInt(int64(len(passwordChars)))
+	for {
+		var sb strings.Builder
+		for range passwordLength {
+			n, err := rand.Int(rand.Reader, charCount)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteByte(passwordChars[n.Int64()])
+		}
+		password := sb.String()
+		if strings.ContainsAny(password, passwordChars[:26]) && strings.ContainsAny(password, passwordChars[26:52]) &&
+			strings.ContainsAny(password, passwordChars[52:]) {
+			return password, nil
+		}
+	}
+}
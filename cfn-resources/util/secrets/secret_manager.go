@@ -16,12 +16,15 @@ package secrets
 
 import (
 	"encoding/json"
-	"log"
+	"errors"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 )
 
 func Create(req *handler.Request, secretName string, data interface{}, description *string) (name *string, arn *string, err error) {
@@ -43,10 +46,10 @@ func Create(req *handler.Request, secretName string, data interface{}, descripti
 	if err != nil {
 		// Print the error, cast err to awserr. Error to get the Code and
 		// Message from an error.
-		log.Printf("error create secret: %+v", err.Error())
+		_, _ = logger.Errorf("error create secret: %+v", err.Error())
 		return nil, nil, err
 	}
-	log.Printf("Created secret result:%+v", result)
+	_, _ = logger.Debugf("Created secret result:%+v", result)
 	return result.Name, result.ARN, nil
 }
 
@@ -68,29 +71,55 @@ func PutSecret(req *handler.Request, secretName string, data interface{}, descri
 	if err != nil {
 		// Print the error, cast err to awserr. Error to get the Code and
 		// Message from an error.
-		log.Printf("error during put secret: %+v", err.Error())
+		_, _ = logger.Errorf("error during put secret: %+v", err.Error())
 		return nil, nil, err
 	}
-	log.Printf("Created secret result:%+v", result)
+	_, _ = logger.Debugf("Created secret result:%+v", result)
 	return result.Name, result.ARN, nil
 }
 
+// Save creates the secret or, if it already exists, puts the data in a new version of it, and returns its ARN.
+func Save(req *handler.Request, secretName string, data interface{}, description *string) (arn *string, err error) {
+	_, arn, err = Create(req, secretName, data, description)
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) && awsErr.Code() == secretsmanager.ErrCodeResourceExistsException {
+		_, arn, err = PutSecret(req, secretName, data, description)
+	}
+	return arn, err
+}
+
+// IsNotFound reports if err is the error of a secret that doesn't exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException
+}
+
 func Get(req *handler.Request, secretName string) (name *string, arn *string, err error) {
 	sm := secretsmanager.New(req.Session)
 	output, err := sm.GetSecretValue(&secretsmanager.GetSecretValueInput{SecretId: &secretName})
 	if err != nil {
-		log.Printf("Error --- %v", err.Error())
+		_, _ = logger.Errorf("Error --- %v", err.Error())
 		return nil, nil, err
 	}
 
 	return output.SecretString, output.ARN, nil
 }
 
+// Describe returns the ARN of the secret without reading its value.
+func Describe(req *handler.Request, secretName string) (arn *string, err error) {
+	sm := secretsmanager.New(req.Session)
+	output, err := sm.DescribeSecret(&secretsmanager.DescribeSecretInput{SecretId: &secretName})
+	if err != nil {
+		return nil, err
+	}
+	return output.ARN, nil
+}
+
 func Delete(req *handler.Request, secretName string) (err error) {
 	sm := secretsmanager.New(req.Session)
 	_, err = sm.DeleteSecret(&secretsmanager.DeleteSecretInput{SecretId: &secretName, ForceDeleteWithoutRecovery: util.Pointer(true)})
 	if err != nil {
-		log.Printf("error delete secret: %v", err.Error())
+		_, _ = logger.Errorf("error delete secret: %v", err.Error())
 		return err
 	}
 	return nil
@@ -0,0 +1,49 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"net/http"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+)
+
+// AtlasRequestIDHeader is the response header identifying the request in Atlas, it's asked for by Atlas support.
+const AtlasRequestIDHeader = "X-Request-Id"
+
+// RequestIDTransport adds the request ID of each Atlas response to the log records,
+// so the log lines following an Atlas call can be correlated with it.
+type RequestIDTransport struct {
+	Base http.RoundTripper
+}
+
+func NewRequestIDTransport(base http.RoundTripper) *RequestIDTransport {
+	return &RequestIDTransport{Base: base}
+}
+
+func (t *RequestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if resp != nil {
+		if id := resp.Header.Get(AtlasRequestIDHeader); id != "" {
+			logger.SetAtlasRequestID(id)
+		}
+		_, _ = logger.Debugf("%s %s: %d", req.Method, req.URL.Path, resp.StatusCode)
+	}
+	return resp, err
+}
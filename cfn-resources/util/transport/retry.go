@@ -0,0 +1,280 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	DefaultMaxAttempts = 5
+	DefaultBaseDelay   = 1 * time.Second
+	DefaultMaxDelay    = 20 * time.Second
+	DefaultBudgetTries = 10
+	DefaultBudgetWait  = 30 * time.Second
+	maxBodySlurpSize   = 2 << 10
+	retryAfterHeader   = "Retry-After"
+)
+
+// replayablePOSTs lists POST endpoints that Atlas treats as upserts, so sending them twice has the same effect as sending them once.
+var replayablePOSTs = []*regexp.Regexp{
+	regexp.MustCompile(`/api/atlas/v2/groups/[^/]+/accessList$`),
+	regexp.MustCompile(`/api/atlas/v2/orgs/[^/]+/apiKeys/[^/]+/accessList$`),
+	regexp.MustCompile(`/api/admin/v3\.0/auth/providers/mongodb-cloud/login$`),
+	regexp.MustCompile(`/api/oauth/token$`),
+}
+
+// Budget caps the retries done on behalf of a single handler invocation, so a throttled Atlas
+// can not keep a handler sleeping until the CloudFormation handler timeout.
+// A Budget is safe for concurrent use and is meant to be shared by all the clients of one invocation.
+type Budget struct {
+	mu         sync.Mutex
+	waited     time.Duration
+	maxWait    time.Duration
+	maxRetries int
+	remaining  int
+}
+
+func NewBudget(maxRetries int, maxWait time.Duration) *Budget {
+	return &Budget{remaining: maxRetries, maxRetries: maxRetries, maxWait: maxWait}
+}
+
+// NewDefaultBudget returns the budget used by the handlers for each invocation.
+func NewDefaultBudget() *Budget {
+	return NewBudget(DefaultBudgetTries, DefaultBudgetWait)
+}
+
+// Reset makes the whole budget available again, it's called when a client is reused by a new invocation.
+func (b *Budget) Reset() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remaining = b.maxRetries
+	b.waited = 0
+}
+
+// take reserves a retry that will wait for delay, it returns false if the budget is exhausted.
+func (b *Budget) take(delay time.Duration) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.remaining <= 0 || b.waited+delay > b.maxWait {
+		return false
+	}
+	b.remaining--
+	b.waited += delay
+	return true
+}
+
+// RetryTransport is an http.RoundTripper that retries throttled and transient Atlas responses
+// (429, 502, 503, 504 and connection resets) with jittered exponential backoff.
+// It honors the Retry-After header, returning the response when it's longer than MaxDelay, and only replays
+// requests that are safe to send twice.
+type RetryTransport struct {
+	Base        http.RoundTripper
+	Budget      *Budget
+	Sleep       func(ctx context.Context, d time.Duration) error
+	Jitter      func(d time.Duration) time.Duration
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// NewRetryTransport wraps base with the default retry policy, sharing the given budget.
+func NewRetryTransport(base http.RoundTripper, budget *Budget) *RetryTransport {
+	return &RetryTransport{
+		Base:        base,
+		Budget:      budget,
+		MaxAttempts: DefaultMaxAttempts,
+		BaseDelay:   DefaultBaseDelay,
+		MaxDelay:    DefaultMaxDelay,
+	}
+}
+
+// RoundTrip sends the request, and clones of it for the retries, without modifying it: a replayable body without
+// GetBody is buffered for the clones.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	replayable := isReplayable(req)
+	getBody := req.GetBody
+	buffered := replayable && req.Body != nil && req.Body != http.NoBody && getBody == nil
+	if buffered {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		getBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 || buffered {
+			attemptReq = req.Clone(req.Context())
+			if getBody != nil {
+				body, err := getBody()
+				if err != nil {
+					return nil, err
+				}
+				attemptReq.Body, attemptReq.GetBody = body, getBody
+			}
+		}
+
+		resp, err := t.base().RoundTrip(attemptReq)
+		if !replayable || attempt >= t.maxAttempts() || !shouldRetry(req.Context(), resp, err) {
+			return resp, err
+		}
+
+		delay := t.backoff(attempt)
+		if after, ok := retryAfter(resp); ok {
+			// retrying before the Retry-After asked by Atlas would be throttled again
+			if after > t.maxDelay() {
+				return resp, err
+			}
+			delay = after
+		}
+		if !t.Budget.take(delay) {
+			return resp, err
+		}
+		drain(resp)
+
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *RetryTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+func (t *RetryTransport) maxAttempts() int {
+	if t.MaxAttempts > 0 {
+		return t.MaxAttempts
+	}
+	return DefaultMaxAttempts
+}
+
+func (t *RetryTransport) maxDelay() time.Duration {
+	if t.MaxDelay > 0 {
+		return t.MaxDelay
+	}
+	return DefaultMaxDelay
+}
+
+// backoff returns the full-jitter exponential delay for the given (1-based) attempt.
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	base := t.BaseDelay
+	if base <= 0 {
+		base = DefaultBaseDelay
+	}
+	d := base << (attempt - 1)
+	if d <= 0 || d > t.maxDelay() {
+		d = t.maxDelay()
+	}
+	if t.Jitter != nil {
+		return t.Jitter(d)
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1)) //nolint:gosec // jitter does not need a secure source
+}
+
+func (t *RetryTransport) sleep(ctx context.Context, d time.Duration) error {
+	if t.Sleep != nil {
+		return t.Sleep(ctx, d)
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isReplayable reports if the request can be sent more than once without side effects.
+func isReplayable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		for _, r := range replayablePOSTs {
+			if r.MatchString(req.URL.Path) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header, which can be either a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	v := resp.Header.Get(retryAfterHeader)
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// drain reads a bit of the body and closes it so the underlying connection can be reused.
+func drain(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, resp.Body, maxBodySlurpSize)
+	_ = resp.Body.Close()
+}
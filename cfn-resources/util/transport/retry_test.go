@@ -0,0 +1,215 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	delays []time.Duration
+}
+
+func (r *recorder) sleep(_ context.Context, d time.Duration) error {
+	r.delays = append(r.delays, d)
+	return nil
+}
+
+func newTestTransport(budget *transport.Budget, rec *recorder) *transport.RetryTransport {
+	t := transport.NewRetryTransport(http.DefaultTransport, budget)
+	t.Sleep = rec.sleep
+	t.Jitter = func(d time.Duration) time.Duration { return d }
+	return t
+}
+
+// failingServer answers with status for the first failures requests and with 200 afterwards.
+func failingServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32, *[]string) {
+	t.Helper()
+	var calls atomic.Int32
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if calls.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls, &bodies
+}
+
+func TestRetryTransportRetriesIdempotentRequests(t *testing.T) {
+	testCases := map[string]struct {
+		status         int
+		expectedDelays []time.Duration
+	}{
+		"throttled":           {status: http.StatusTooManyRequests, expectedDelays: []time.Duration{time.Second, 2 * time.Second}},
+		"bad gateway":         {status: http.StatusBadGateway, expectedDelays: []time.Duration{time.Second, 2 * time.Second}},
+		"service unavailable": {status: http.StatusServiceUnavailable, expectedDelays: []time.Duration{time.Second, 2 * time.Second}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			srv, calls, _ := failingServer(t, 2, tc.status, nil)
+			rec := &recorder{}
+			client := &http.Client{Transport: newTestTransport(transport.NewDefaultBudget(), rec)}
+
+			resp, err := client.Get(srv.URL + "/api/atlas/v2/groups/123/clusters")
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, int32(3), calls.Load())
+			assert.Equal(t, tc.expectedDelays, rec.delays)
+		})
+	}
+}
+
+func TestRetryTransportHonorsRetryAfter(t *testing.T) {
+	srv, calls, _ := failingServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"7"}})
+	rec := &recorder{}
+	client := &http.Client{Transport: newTestTransport(transport.NewDefaultBudget(), rec)}
+
+	resp, err := client.Get(srv.URL + "/api/atlas/v2/groups/123")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, []time.Duration{7 * time.Second}, rec.delays)
+}
+
+func TestRetryTransportReturnsResponseWhenRetryAfterExceedsMaxDelay(t *testing.T) {
+	srv, calls, _ := failingServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"60"}})
+	rec := &recorder{}
+	client := &http.Client{Transport: newTestTransport(transport.NewBudget(10, time.Hour), rec)}
+
+	resp, err := client.Get(srv.URL + "/api/atlas/v2/groups/123")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Empty(t, rec.delays)
+}
+
+func TestRetryTransportDoesNotReplayUnsafePOST(t *testing.T) {
+	srv, calls, _ := failingServer(t, 1, http.StatusServiceUnavailable, nil)
+	rec := &recorder{}
+	client := &http.Client{Transport: newTestTransport(transport.NewDefaultBudget(), rec)}
+
+	resp, err := client.Post(srv.URL+"/api/atlas/v2/groups/123/clusters", "application/json", strings.NewReader(`{"name":"c"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Empty(t, rec.delays)
+}
+
+func TestRetryTransportReplaysSafePOSTWithBody(t *testing.T) {
+	srv, calls, bodies := failingServer(t, 1, http.StatusTooManyRequests, nil)
+	rec := &recorder{}
+	client := &http.Client{Transport: newTestTransport(transport.NewDefaultBudget(), rec)}
+
+	body := `[{"cidrBlock":"10.0.0.0/24"}]`
+	resp, err := client.Post(srv.URL+"/api/atlas/v2/groups/123/accessList", "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, []string{body, body}, *bodies)
+}
+
+func TestRetryTransportDoesNotModifyRequest(t *testing.T) {
+	srv, calls, bodies := failingServer(t, 1, http.StatusServiceUnavailable, nil)
+	rec := &recorder{}
+	rt := newTestTransport(transport.NewDefaultBudget(), rec)
+
+	body := io.NopCloser(strings.NewReader(`{"name":"cluster"}`))
+	req, err := http.NewRequest(http.MethodPut, srv.URL+"/api/atlas/v2/groups/123/clusters/cluster", body)
+	require.NoError(t, err)
+	req.GetBody = nil
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, []string{`{"name":"cluster"}`, `{"name":"cluster"}`}, *bodies)
+	assert.Equal(t, body, req.Body)
+	assert.Nil(t, req.GetBody)
+}
+
+func TestRetryTransportStopsAfterMaxAttempts(t *testing.T) {
+	srv, calls, _ := failingServer(t, 100, http.StatusServiceUnavailable, nil)
+	rec := &recorder{}
+	client := &http.Client{Transport: newTestTransport(transport.NewBudget(100, time.Hour), rec)}
+
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(transport.DefaultMaxAttempts), calls.Load())
+}
+
+func TestRetryTransportSharesBudget(t *testing.T) {
+	srv, calls, _ := failingServer(t, 100, http.StatusTooManyRequests, nil)
+	rec := &recorder{}
+	budget := transport.NewBudget(3, time.Hour)
+	client := &http.Client{Transport: newTestTransport(budget, rec)}
+
+	for range 2 {
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	}
+
+	// 3 retries are shared between both requests, so 2 initial calls plus 3 retries.
+	assert.Equal(t, int32(5), calls.Load())
+	assert.Len(t, rec.delays, 3)
+}
+
+func TestRetryTransportBudgetLimitsWaitTime(t *testing.T) {
+	srv, calls, _ := failingServer(t, 100, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"15"}})
+	rec := &recorder{}
+	client := &http.Client{Transport: newTestTransport(transport.NewBudget(10, 20*time.Second), rec)}
+
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, []time.Duration{15 * time.Second}, rec.delays)
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import "net/http"
+
+// UnauthorizedTransport calls OnUnauthorized when Atlas answers 401 Unauthorized, which means that the credentials
+// were rejected. It must wrap the authentication transport so the digest challenges are not reported.
+type UnauthorizedTransport struct {
+	Base           http.RoundTripper
+	OnUnauthorized func()
+}
+
+func NewUnauthorizedTransport(base http.RoundTripper, onUnauthorized func()) *UnauthorizedTransport {
+	return &UnauthorizedTransport{Base: base, OnUnauthorized: onUnauthorized}
+}
+
+func (t *UnauthorizedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if resp != nil && resp.StatusCode == http.StatusUnauthorized && t.OnUnauthorized != nil {
+		t.OnUnauthorized()
+	}
+	return resp, err
+}
@@ -0,0 +1,185 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const urnScheme = "atlas:"
+
+// Types of the segments of the URNs built by the constructors below.
+const (
+	URNProject         = "project"
+	URNCluster         = "cluster"
+	URNDatabase        = "database"
+	URNUser            = "user"
+	URNSearchIndex     = "searchIndex"
+	URNEndpointService = "endpointService"
+	URNEndpoint        = "endpoint"
+)
+
+// URNSegment is a type and the ID of an Atlas object of that type.
+type URNSegment struct {
+	Type string
+	ID   string
+}
+
+// URN identifies an Atlas object by the path of the objects containing it, from the outermost, e.g.
+// atlas:project/<ProjectId>/cluster/<ClusterName>. The IDs are path escaped so they can contain any character,
+// including "/", and a URN can nest any number of objects.
+//
+// A URN identifies a resource with several identifying properties by a single string, e.g. to import a database user
+// from atlas:project/<ProjectId>/database/admin/user/<Username>, ParseURN then IDs return the values of the properties.
+type URN struct {
+	segments []URNSegment
+}
+
+// NewURN returns the URN of an object outside of any other, e.g. a project.
+func NewURN(resourceType, id string) URN {
+	return URN{segments: []URNSegment{{Type: resourceType, ID: id}}}
+}
+
+// ProjectURN returns the URN of a project.
+func ProjectURN(projectID string) URN {
+	return NewURN(URNProject, projectID)
+}
+
+// ClusterURN returns the URN of a cluster.
+func ClusterURN(projectID, clusterName string) URN {
+	return ProjectURN(projectID).Child(URNCluster, clusterName)
+}
+
+// DatabaseUserURN returns the URN of a database user, in its authentication database.
+func DatabaseUserURN(projectID, databaseName, username string) URN {
+	return ProjectURN(projectID).Child(URNDatabase, databaseName).Child(URNUser, username)
+}
+
+// SearchIndexURN returns the URN of a search index of a cluster.
+func SearchIndexURN(projectID, clusterName, indexID string) URN {
+	return ClusterURN(projectID, clusterName).Child(URNSearchIndex, indexID)
+}
+
+// EndpointServiceURN returns the URN of a private endpoint service of a project.
+func EndpointServiceURN(projectID, endpointServiceID string) URN {
+	return ProjectURN(projectID).Child(URNEndpointService, endpointServiceID)
+}
+
+// PrivateEndpointURN returns the URN of a private endpoint of a private endpoint service.
+func PrivateEndpointURN(projectID, endpointServiceID, endpointID string) URN {
+	return EndpointServiceURN(projectID, endpointServiceID).Child(URNEndpoint, endpointID)
+}
+
+// Child returns the URN of the object of the given type and ID contained by u.
+func (u URN) Child(resourceType, id string) URN {
+	return URN{segments: append(slices.Clip(u.segments), URNSegment{Type: resourceType, ID: id})}
+}
+
+// Parent returns the URN of the object containing u, false if u is outside of any other.
+func (u URN) Parent() (URN, bool) {
+	if len(u.segments) < 2 {
+		return URN{}, false
+	}
+	return URN{segments: slices.Clip(u.segments[:len(u.segments)-1])}, true
+}
+
+// Segments returns the types and IDs of u, from the outermost object.
+func (u URN) Segments() []URNSegment {
+	return slices.Clone(u.segments)
+}
+
+// Type returns the type of the object identified by u.
+func (u URN) Type() string {
+	if len(u.segments) == 0 {
+		return ""
+	}
+	return u.segments[len(u.segments)-1].Type
+}
+
+// IDs returns the IDs of u if its types are the given ones, in order, e.g. IDs(URNProject, URNCluster) returns the
+// project ID and the name of a cluster URN.
+func (u URN) IDs(types ...string) ([]string, error) {
+	if len(u.segments) != len(types) {
+		return nil, fmt.Errorf("%s isn't the URN of a %s", u, strings.Join(types, "/"))
+	}
+	ids := make([]string, len(types))
+	for i, segment := range u.segments {
+		if segment.Type != types[i] {
+			return nil, fmt.Errorf("%s isn't the URN of a %s", u, strings.Join(types, "/"))
+		}
+		ids[i] = segment.ID
+	}
+	return ids, nil
+}
+
+func (u URN) String() string {
+	var sb strings.Builder
+	sb.WriteString(urnScheme)
+	for i, segment := range u.segments {
+		if i > 0 {
+			sb.WriteByte('/')
+		}
+		sb.WriteString(url.PathEscape(segment.Type))
+		sb.WriteByte('/')
+		sb.WriteString(url.PathEscape(segment.ID))
+	}
+	return sb.String()
+}
+
+// ParseURN parses the string form of a URN. Every type and ID must be non-empty.
+func ParseURN(s string) (URN, error) {
+	path, ok := strings.CutPrefix(s, urnScheme)
+	if !ok {
+		return URN{}, fmt.Errorf("invalid URN %q: it must start with %q", s, urnScheme)
+	}
+	parts := strings.Split(path, "/")
+	if len(parts)%2 != 0 {
+		return URN{}, fmt.Errorf("invalid URN %q: it must be made of type/ID pairs", s)
+	}
+	segments := make([]URNSegment, 0, len(parts)/2)
+	for i := 0; i < len(parts); i += 2 {
+		resourceType, err := url.PathUnescape(parts[i])
+		if err != nil {
+			return URN{}, fmt.Errorf("invalid URN %q: %w", s, err)
+		}
+		id, err := url.PathUnescape(parts[i+1])
+		if err != nil {
+			return URN{}, fmt.Errorf("invalid URN %q: %w", s, err)
+		}
+		if resourceType == "" || id == "" {
+			return URN{}, fmt.Errorf("invalid URN %q: empty type or ID", s)
+		}
+		segments = append(segments, URNSegment{Type: resourceType, ID: id})
+	}
+	return URN{segments: segments}, nil
+}
+
+// MarshalText returns the string form of u, so a URN is a JSON string.
+func (u URN) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText parses the string form of a URN.
+func (u *URN) UnmarshalText(text []byte) error {
+	parsed, err := ParseURN(string(text))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"encoding/json"
+	"testing"
+	"testing/quick"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURNString(t *testing.T) {
+	testCases := map[string]struct {
+		urn      util.URN
+		expected string
+	}{
+		"project":         {urn: util.ProjectURN("p1"), expected: "atlas:project/p1"},
+		"cluster":         {urn: util.ClusterURN("p1", "Cluster0"), expected: "atlas:project/p1/cluster/Cluster0"},
+		"databaseUser":    {urn: util.DatabaseUserURN("p1", "$external", "CN=user,O=org"), expected: "atlas:project/p1/database/$external/user/CN=user%2CO=org"},
+		"escapedSlash":    {urn: util.DatabaseUserURN("p1", "admin", "a/b+c d%"), expected: "atlas:project/p1/database/admin/user/a%2Fb+c%20d%25"},
+		"searchIndex":     {urn: util.SearchIndexURN("p1", "Cluster0", "i1"), expected: "atlas:project/p1/cluster/Cluster0/searchIndex/i1"},
+		"endpointService": {urn: util.EndpointServiceURN("p1", "s1"), expected: "atlas:project/p1/endpointService/s1"},
+		"privateEndpoint": {urn: util.PrivateEndpointURN("p1", "s1", "vpce-1"), expected: "atlas:project/p1/endpointService/s1/endpoint/vpce-1"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.urn.String())
+			parsed, err := util.ParseURN(tc.expected)
+			require.NoError(t, err)
+			assert.Equal(t, tc.urn, parsed)
+		})
+	}
+}
+
+func TestParseURNInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"atlas:",
+		"project/p1",
+		"mongodb+xid+project+p1",
+		"atlas:project",
+		"atlas:project/p1/cluster",
+		"atlas:project//cluster/c1",
+		"atlas:/p1",
+		"atlas:project/%zz",
+	} {
+		t.Run(s, func(t *testing.T) {
+			_, err := util.ParseURN(s)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestURNIDs(t *testing.T) {
+	urn, err := util.ParseURN("atlas:project/p1/database/admin/user/u%2F1")
+	require.NoError(t, err)
+
+	ids, err := urn.IDs(util.URNProject, util.URNDatabase, util.URNUser)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p1", "admin", "u/1"}, ids)
+	assert.Equal(t, util.URNUser, urn.Type())
+
+	_, err = urn.IDs(util.URNProject, util.URNCluster)
+	require.Error(t, err)
+	_, err = urn.IDs(util.URNProject, util.URNCluster, util.URNUser)
+	require.Error(t, err)
+
+	parent, ok := urn.Parent()
+	require.True(t, ok)
+	assert.Equal(t, "atlas:project/p1/database/admin", parent.String())
+	_, ok = util.ProjectURN("p1").Parent()
+	assert.False(t, ok)
+}
+
+func TestURNChildDoesNotShareSegments(t *testing.T) {
+	project := util.ProjectURN("p1")
+	cluster1 := project.Child(util.URNCluster, "c1")
+	cluster2 := project.Child(util.URNCluster, "c2")
+	assert.Equal(t, "atlas:project/p1/cluster/c1", cluster1.String())
+	assert.Equal(t, "atlas:project/p1/cluster/c2", cluster2.String())
+	assert.Equal(t, "atlas:project/p1", project.String())
+}
+
+func TestURNJSON(t *testing.T) {
+	type secret struct {
+		ResourceID *util.URN
+	}
+	urn := util.SearchIndexURN("p1", "Cluster0", "i1")
+	b, err := json.Marshal(secret{ResourceID: &urn})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ResourceID":"atlas:project/p1/cluster/Cluster0/searchIndex/i1"}`, string(b))
+
+	var decoded secret
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, urn, *decoded.ResourceID)
+	require.Error(t, json.Unmarshal([]byte(`{"ResourceID":"project+p1"}`), &decoded))
+}
+
+func TestURNRoundTrip(t *testing.T) {
+	// any non-empty types and IDs, at any depth, are parsed back from the string form
+	roundTrip := func(segments []util.URNSegment) bool {
+		if len(segments) == 0 {
+			return true
+		}
+		for _, s := range segments {
+			if s.Type == "" || s.ID == "" {
+				return true
+			}
+		}
+		urn := util.NewURN(segments[0].Type, segments[0].ID)
+		for _, s := range segments[1:] {
+			urn = urn.Child(s.Type, s.ID)
+		}
+		parsed, err := util.ParseURN(urn.String())
+		return err == nil && assert.ObjectsAreEqual(segments, parsed.Segments()) && parsed.String() == urn.String()
+	}
+	require.NoError(t, quick.Check(roundTrip, &quick.Config{MaxCount: 2000}))
+
+	// the constructors are parsed back to their IDs, whatever the characters of the IDs
+	databaseUser := func(projectID, databaseName, username string) bool {
+		if projectID == "" || databaseName == "" || username == "" {
+			return true
+		}
+		parsed, err := util.ParseURN(util.DatabaseUserURN(projectID, databaseName, username).String())
+		if err != nil {
+			return false
+		}
+		ids, err := parsed.IDs(util.URNProject, util.URNDatabase, util.URNUser)
+		return err == nil && assert.ObjectsAreEqual([]string{projectID, databaseName, username}, ids)
+	}
+	require.NoError(t, quick.Check(databaseUser, &quick.Config{MaxCount: 2000}))
+}
@@ -18,8 +18,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"runtime"
 	"strconv"
@@ -31,6 +31,7 @@ import (
 	"go.mongodb.org/atlas-sdk/v20241113002/admin"
 	realmAuth "go.mongodb.org/realm/auth"
 	"go.mongodb.org/realm/realm"
+	"golang.org/x/oauth2"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/logging"
@@ -41,14 +42,15 @@ import (
 	"github.com/mongodb-forks/digest"
 
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/auth"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/transport"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/version"
 )
 
 const (
 	cfn         = "mongodbatlas-cloudformation-resources"
 	envLogLevel = "LOG_LEVEL"
-	debug       = "debug"
 )
 
 type MongoDBClient struct {
@@ -65,20 +67,42 @@ type Config struct {
 	BaseURL      string
 	RealmBaseURL string
 	DebugClient  bool
+	// WrapTransport wraps the authenticated transport of the Atlas clients if set, e.g. to record or replay the
+	// Atlas calls in tests.
+	WrapTransport func(http.RoundTripper) http.RoundTripper
 }
 
-type AssumeRole struct {
-	Tags              map[string]string
-	RoleARN           string
-	ExternalID        string
-	Policy            string
-	SessionName       string
-	SourceIdentity    string
-	PolicyARNs        []string
-	TransitiveTagKeys []string
-	Duration          time.Duration
+// wrapTransport is the WrapTransport of the clients created from profiles, see UseTransportWrapper.
+var wrapTransport func(http.RoundTripper) http.RoundTripper
+
+// UseTransportWrapper sets the WrapTransport of the Atlas clients created by NewAtlasClient and empties the client
+// cache so that the next clients use it. It returns a function restoring the previous wrapper, it's meant for tests.
+func UseTransportWrapper(wrap func(http.RoundTripper) http.RoundTripper) (restore func()) {
+	previous := wrapTransport
+	wrapTransport = wrap
+	atlasClients.DeleteFunc(func(string, cachedClient) bool { return true })
+	return func() {
+		wrapTransport = previous
+		atlasClients.DeleteFunc(func(string, cachedClient) bool { return true })
+	}
 }
 
+// atlasClient is the client returned by NewAtlasClient instead of the client of the profile, see UseAtlasClient.
+var atlasClient *MongoDBClient
+
+// UseAtlasClient makes NewAtlasClient return the given client whatever the profile, e.g. a client with the mocks of
+// testutil/mocksvc as APIs. It returns a function restoring the clients of the profiles, it's meant for tests.
+func UseAtlasClient(client *MongoDBClient) (restore func()) {
+	previous := atlasClient
+	atlasClient = client
+	return func() {
+		atlasClient = previous
+	}
+}
+
+// AssumeRole is the role assumed to read the profile, see profile.AssumeRole.
+type AssumeRole = profile.AssumeRole
+
 var (
 	toolName        = cfn
 	defaultLogLevel = "warning"
@@ -89,7 +113,7 @@ var (
 // and returns "US_EAST_1" -- i.e. a valid Atlas region
 func EnsureAtlasRegion(region string) string {
 	r := strings.ToUpper(strings.ReplaceAll(region, "-", "_"))
-	log.Printf("EnsureAtlasRegion--- region:%s r:%s", region, r)
+	_, _ = logger.Debugf("EnsureAtlasRegion--- region:%s r:%s", region, r)
 	return r
 }
 
@@ -97,24 +121,53 @@ func EnsureAtlasRegion(region string) string {
 // and returns "us-east-1" -- i.e. a valid AWS region
 func EnsureAWSRegion(region string) string {
 	r := strings.ToLower(strings.ReplaceAll(region, "_", "-"))
-	log.Printf("EnsureAWSRegion--- region:%s r:%s", region, r)
+	_, _ = logger.Debugf("EnsureAWSRegion--- region:%s r:%s", region, r)
 	return r
 }
 
+// realmLoginPath is the path of the login of the API keys in the App Services Admin API.
+const realmLoginPath = "auth/providers/mongodb-cloud/login"
+
 func GetRealmClient(ctx context.Context, req handler.Request, profileName *string) (*realm.Client, error) {
 	p, err := profile.NewProfile(&req, profileName, true)
 	if err != nil {
 		return nil, err
 	}
 
+	budget := transport.NewDefaultBudget()
 	optsRealm := []realm.ClientOpt{realm.SetUserAgent(userAgent)}
-	authConfig := realmAuth.NewConfig(nil)
-	token, err := authConfig.NewTokenFromCredentials(ctx, p.PublicKey, p.PrivateKey)
-	if err != nil {
-		return nil, err
+	// MONGODB_REALM_BASE_URL points the client to another App Services API than the public cloud, e.g. a test server.
+	realmBaseURL := os.Getenv("MONGODB_REALM_BASE_URL")
+	if realmBaseURL != "" {
+		optsRealm = append(optsRealm, realm.SetBaseURL(strings.TrimSuffix(realmBaseURL, "/")+"/"+realm.APIAdminV3Path))
+	}
+
+	// service accounts use their Atlas access token, API keys log in to Realm to get one.
+	var tokenSource realmAuth.TokenSource
+	if p.UseServiceAccount() {
+		tokenSource = auth.RealmTokenSource(newServiceAccountTokenSource(ctx, p, budget))
+	} else {
+		authConfig := realmAuth.NewConfig(&http.Client{Transport: transport.NewRetryTransport(http.DefaultTransport, budget)})
+		if realmBaseURL != "" {
+			authURL, err := url.Parse(strings.TrimSuffix(realmBaseURL, "/") + "/" + realm.APIAdminV3Path + realmLoginPath)
+			if err != nil {
+				return nil, err
+			}
+			authConfig.AuthURL = authURL
+		}
+		token, err := authConfig.NewTokenFromCredentials(ctx, p.PublicKey, p.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		tokenSource = realmAuth.BasicTokenSource(token)
 	}
 
-	clientRealm := realmAuth.NewClient(realmAuth.BasicTokenSource(token))
+	clientRealm := &http.Client{
+		Transport: transport.NewRetryTransport(&realmAuth.Transport{
+			Base:   http.DefaultTransport,
+			Source: tokenSource,
+		}, budget),
+	}
 	realmClient, err := realm.New(clientRealm, optsRealm...)
 	if err != nil {
 		return nil, err
@@ -134,6 +187,9 @@ func NewAtlasClientRemovingProfilePrefix(req *handler.Request, profileName *stri
 }
 
 func newAtlasV2Client(req *handler.Request, profileName *string, profileNamePrefixRequired bool) (*MongoDBClient, *handler.ProgressEvent) {
+	if atlasClient != nil {
+		return atlasClient, nil
+	}
 	prof, err := profile.NewProfile(req, profileName, profileNamePrefixRequired)
 
 	if err != nil {
@@ -143,19 +199,20 @@ func newAtlasV2Client(req *handler.Request, profileName *string, profileNamePref
 			HandlerErrorCode: cloudformation.HandlerErrorCodeNotFound}
 	}
 
-	// setup a transport to handle digest
-	transport := digest.NewTransport(prof.PublicKey, prof.PrivateKey)
-
-	// initialize the client
-	client, err := transport.Client()
-	if err != nil {
-		return nil, &handler.ProgressEvent{
-			OperationStatus:  handler.Failed,
-			Message:          err.Error(),
-			HandlerErrorCode: cloudformation.HandlerErrorCodeInvalidRequest}
+	cacheKey := prof.CacheKey()
+	if cached, ok := cachedAtlasClient(cacheKey); ok {
+		return cached, nil
 	}
 
-	c := Config{BaseURL: prof.BaseURL, DebugClient: prof.UseDebug()}
+	c := Config{BaseURL: prof.BaseURL, DebugClient: prof.UseDebug(), AssumeRole: prof.AssumeRole, WrapTransport: wrapTransport}
+
+	// All SDK clients share the same transport and retry budget for this invocation.
+	budget := transport.NewDefaultBudget()
+	client := &http.Client{Transport: transport.NewUnauthorizedTransport(c.newAtlasTransport(prof, budget), func() {
+		_, _ = logger.Warnf("Atlas rejected the credentials of the profile, removing it from the cache")
+		profile.Invalidate(prof)
+		atlasClients.Delete(cacheKey)
+	})}
 
 	// new V2 version 20231115002 instance
 	sdk20231115002Client, err := c.NewSDKv20231115002Client(client)
@@ -190,10 +247,32 @@ func newAtlasV2Client(req *handler.Request, profileName *string, profileNamePref
 		AtlasSDK:         sdkV2LatestClient,
 		Config:           &c,
 	}
+	cacheAtlasClient(cacheKey, clients, budget)
 
 	return clients, nil
 }
 
+// newAtlasTransport returns a transport authenticating with the profile credentials, digest for API keys
+// or OAuth2 for service accounts, and retrying throttled and transient errors within the budget.
+func (c *Config) newAtlasTransport(prof *profile.Profile, budget *transport.Budget) http.RoundTripper {
+	var authTransport http.RoundTripper
+	if prof.UseServiceAccount() {
+		authTransport = auth.NewTransport(http.DefaultTransport, newServiceAccountTokenSource(context.Background(), prof, budget))
+	} else {
+		authTransport = digest.NewTransport(prof.PublicKey, prof.PrivateKey)
+	}
+	if c.WrapTransport != nil {
+		authTransport = c.WrapTransport(authTransport)
+	}
+	return transport.NewRetryTransport(transport.NewRequestIDTransport(authTransport), budget)
+}
+
+// newServiceAccountTokenSource returns the cached token source of the profile service account.
+func newServiceAccountTokenSource(ctx context.Context, prof *profile.Profile, budget *transport.Budget) oauth2.TokenSource {
+	tokenClient := &http.Client{Transport: transport.NewRetryTransport(http.DefaultTransport, budget)}
+	return auth.NewServiceAccountTokenSource(ctx, prof.BaseURL, prof.NewClientID(), prof.NewClientSecret(), tokenClient)
+}
+
 func (c *Config) NewSDKv20231115002Client(client *http.Client) (*admin20231115002.APIClient, error) {
 	opts := []admin20231115002.ClientModifier{
 		admin20231115002.UseHTTPClient(client),
@@ -251,12 +330,11 @@ func getLogLevel() logger.Level {
 		_, _ = logger.Warnf("getLogLevel() Environment variable %s not found. Set it in template.yaml (defaultLogLevel=%s)", envLogLevel, defaultLogLevel)
 		levelString = defaultLogLevel
 	}
-	switch levelString {
-	case debug:
-		return logger.DebugLevel
-	default:
+	level, ok := logger.ParseLevel(levelString)
+	if !ok {
 		return logger.WarningLevel
 	}
+	return level
 }
 
 // SetupLogger is called by each resource handler to centrally
@@ -266,6 +344,7 @@ func SetupLogger(loggerPrefix string) {
 	logr := logging.New(loggerPrefix)
 	logger.SetOutput(logr.Writer())
 	logger.SetLevel(getLogLevel())
+	logger.ResetFields(loggerPrefix)
 }
 
 func ToStringMapE(ep any) (map[string]any, error) {
@@ -1,4 +1,4 @@
-// Copyright 2023 MongoDB Inc
+// Copyright 2024 MongoDB Inc
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -12,15 +12,11 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package util_test
+package validator
 
-import (
-	"fmt"
-	"testing"
-)
-
-func TestResourceID(t *testing.T) {
-	t.Run("Test1", func(t *testing.T) {
-		fmt.Println("TODO -- NOT IMPLEMENTED YET")
-	})
+// ValidateValue validates the value against the schema, exported for tests.
+func ValidateValue(schema map[string]any, value any) []Violation {
+	v := &schemaValidator{root: schema}
+	v.validate(schema, value, "")
+	return v.violations
 }
@@ -0,0 +1,404 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+//go:generate go run ../../tool/schema-generator
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const modulePath = "github.com/mongodb/mongodbatlas-cloudformation-resources/"
+
+// schemaFiles are the resource schemas by resource directory, e.g. schemas/cluster.json, copied by tool/schema-generator.
+//
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+var (
+	schemasMu sync.Mutex
+	schemas   = map[string]map[string]any{}
+	patterns  = map[string]*regexp.Regexp{}
+)
+
+// Violation is a value of the model not allowed by the schema of its resource.
+type Violation struct {
+	// Path is the JSON path of the value, e.g. ReplicationSpecs[0].RegionConfigs[1].RegionName.
+	Path    string
+	Message string
+}
+
+func (v Violation) String() string {
+	if v.Path == "" {
+		return v.Message
+	}
+	return v.Path + " " + v.Message
+}
+
+// ValidateSchema returns every value of the model not allowed by the schema of its resource: types, enums, patterns,
+// lengths, min and max, required properties of nested objects and oneOf, anyOf and allOf constraints.
+// The required properties of the resource itself are not checked as they depend on the handler action.
+// Only the Model of a resource is validated, nil is returned for any other value.
+func ValidateSchema(model any) ([]Violation, error) {
+	dir := resourceDir(model)
+	if dir == "" {
+		return nil, nil
+	}
+	schema, err := loadSchema(dir)
+	if err != nil || schema == nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	root := make(map[string]any, len(schema))
+	for k, v := range schema {
+		if k != "required" {
+			root[k] = v
+		}
+	}
+	v := &schemaValidator{root: schema}
+	v.validate(root, value, "")
+	return v.violations, nil
+}
+
+// resourceDir returns the resource directory of the model, e.g. cluster for the Model in cluster/cmd/resource.
+func resourceDir(model any) string {
+	t := reflect.TypeOf(model)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Name() != "Model" {
+		return ""
+	}
+	dir, ok := strings.CutPrefix(t.PkgPath(), modulePath)
+	if !ok || !strings.HasSuffix(dir, "/cmd/resource") {
+		return ""
+	}
+	return strings.TrimSuffix(dir, "/cmd/resource")
+}
+
+// loadSchema returns the parsed schema of the resource, nil if there is none.
+func loadSchema(dir string) (map[string]any, error) {
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+	if schema, ok := schemas[dir]; ok {
+		return schema, nil
+	}
+
+	data, err := schemaFiles.ReadFile("schemas/" + dir + ".json")
+	if errors.Is(err, fs.ErrNotExist) {
+		schemas[dir] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("invalid schema of %s: %w", dir, err)
+	}
+	schemas[dir] = schema
+	return schema, nil
+}
+
+// pattern returns the compiled pattern, nil if it's not supported by Go, e.g. it uses lookarounds.
+func pattern(expr string) *regexp.Regexp {
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+	re, ok := patterns[expr]
+	if !ok {
+		re, _ = regexp.Compile(expr)
+		patterns[expr] = re
+	}
+	return re
+}
+
+type schemaValidator struct {
+	root       map[string]any
+	violations []Violation
+}
+
+func (v *schemaValidator) add(path, format string, args ...any) {
+	v.violations = append(v.violations, Violation{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// matches reports if the value is valid for the schema, without recording the violations.
+func (v *schemaValidator) matches(schema, value any, path string) bool {
+	sub := &schemaValidator{root: v.root}
+	sub.validate(schema, value, path)
+	return len(sub.violations) == 0
+}
+
+func (v *schemaValidator) resolve(ref string) (map[string]any, bool) {
+	pointer, ok := strings.CutPrefix(ref, "#/")
+	if !ok {
+		return nil, false
+	}
+	var current any = v.root
+	for _, segment := range strings.Split(pointer, "/") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = m[strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")]
+	}
+	schema, ok := current.(map[string]any)
+	return schema, ok
+}
+
+func (v *schemaValidator) validate(s, value any, path string) {
+	schema, ok := s.(map[string]any)
+	if !ok {
+		return
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		if resolved, ok := v.resolve(ref); ok {
+			v.validate(resolved, value, path)
+		}
+		return
+	}
+
+	if types := schemaTypes(schema["type"]); len(types) > 0 && !hasType(types, value) {
+		v.add(path, "must be of type %s, got %s", strings.Join(types, " or "), typeOf(value))
+		return
+	}
+	if enum, ok := schema["enum"].([]any); ok && !containsValue(enum, value) {
+		v.add(path, "must be one of %s", joinValues(enum))
+	}
+
+	switch val := value.(type) {
+	case string:
+		v.validateString(schema, val, path)
+	case float64:
+		v.validateNumber(schema, val, path)
+	case []any:
+		v.validateArray(schema, val, path)
+	case map[string]any:
+		v.validateObject(schema, val, path)
+	}
+	v.validateCombinations(schema, value, path)
+}
+
+func (v *schemaValidator) validateString(schema map[string]any, value, path string) {
+	length := utf8.RuneCountInString(value)
+	if min, ok := schema["minLength"].(float64); ok && float64(length) < min {
+		v.add(path, "must be at least %v characters long", min)
+	}
+	if max, ok := schema["maxLength"].(float64); ok && float64(length) > max {
+		v.add(path, "must be at most %v characters long", max)
+	}
+	if expr, ok := schema["pattern"].(string); ok {
+		if re := pattern(expr); re != nil && !re.MatchString(value) {
+			v.add(path, "must match the pattern %s", expr)
+		}
+	}
+}
+
+func (v *schemaValidator) validateNumber(schema map[string]any, value float64, path string) {
+	if min, ok := schema["minimum"].(float64); ok && value < min {
+		v.add(path, "must be greater than or equal to %v", min)
+	}
+	if max, ok := schema["maximum"].(float64); ok && value > max {
+		v.add(path, "must be less than or equal to %v", max)
+	}
+	if min, ok := schema["exclusiveMinimum"].(float64); ok && value <= min {
+		v.add(path, "must be greater than %v", min)
+	}
+	if max, ok := schema["exclusiveMaximum"].(float64); ok && value >= max {
+		v.add(path, "must be less than %v", max)
+	}
+}
+
+func (v *schemaValidator) validateArray(schema map[string]any, value []any, path string) {
+	if min, ok := schema["minItems"].(float64); ok && float64(len(value)) < min {
+		v.add(path, "must have at least %v items", min)
+	}
+	if max, ok := schema["maxItems"].(float64); ok && float64(len(value)) > max {
+		v.add(path, "must have at most %v items", max)
+	}
+	if unique, ok := schema["uniqueItems"].(bool); ok && unique {
+		for i := range value {
+			if containsValue(value[:i], value[i]) {
+				v.add(path, "must not have duplicate items")
+				break
+			}
+		}
+	}
+	if items, ok := schema["items"]; ok {
+		for i, item := range value {
+			v.validate(items, item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	}
+}
+
+func (v *schemaValidator) validateObject(schema map[string]any, value map[string]any, path string) {
+	if required, ok := schema["required"].([]any); ok {
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				if _, present := value[name]; !present {
+					v.add(join(path, name), "is required")
+				}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	patternProperties, _ := schema["patternProperties"].(map[string]any)
+	for _, name := range sortedKeys(value) {
+		if p, ok := properties[name]; ok {
+			v.validate(p, value[name], join(path, name))
+			continue
+		}
+		matched := false
+		for expr, p := range patternProperties {
+			if re := pattern(expr); re != nil && re.MatchString(name) {
+				matched = true
+				v.validate(p, value[name], join(path, name))
+			}
+		}
+		if additional, ok := schema["additionalProperties"].(map[string]any); ok && !matched {
+			v.validate(additional, value[name], join(path, name))
+		}
+	}
+}
+
+func (v *schemaValidator) validateCombinations(schema map[string]any, value any, path string) {
+	if allOf, ok := schema["allOf"].([]any); ok {
+		for _, s := range allOf {
+			v.validate(s, value, path)
+		}
+	}
+	if anyOf, ok := schema["anyOf"].([]any); ok {
+		matched := false
+		for _, s := range anyOf {
+			if v.matches(s, value, path) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			v.add(path, "must match at least one of the anyOf schemas")
+		}
+	}
+	if oneOf, ok := schema["oneOf"].([]any); ok {
+		matched := 0
+		for _, s := range oneOf {
+			if v.matches(s, value, path) {
+				matched++
+			}
+		}
+		if matched != 1 {
+			v.add(path, "must match exactly one of the oneOf schemas, it matches %d", matched)
+		}
+	}
+}
+
+func schemaTypes(t any) []string {
+	switch t := t.(type) {
+	case string:
+		return []string{t}
+	case []any:
+		types := make([]string, 0, len(t))
+		for _, s := range t {
+			if s, ok := s.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func hasType(types []string, value any) bool {
+	for _, t := range types {
+		if t == typeOf(value) || (t == "number" && typeOf(value) == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func typeOf(value any) string {
+	switch val := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if val == math.Trunc(val) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func containsValue(values []any, value any) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinValues(values []any) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = fmt.Sprint(v)
+	}
+	return strings.Join(s, ", ")
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	searchindex "github.com/mongodb/mongodbatlas-cloudformation-resources/search-index/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validSearchIndex() *searchindex.Model {
+	return &searchindex.Model{
+		ProjectId:   util.Pointer("64b6c0c9a1b2c3d4e5f60718"),
+		ClusterName: util.Pointer("Cluster0"),
+		Name:        util.Pointer("index"),
+		Synonyms: []searchindex.ApiAtlasFTSSynonymMappingDefinitionView{
+			{Analyzer: util.Pointer("lucene.standard"), Name: util.Pointer("synonyms"), Source: &searchindex.SynonymSource{Collection: util.Pointer("words")}},
+		},
+	}
+}
+
+func TestValidateSchemaValid(t *testing.T) {
+	violations, err := validator.ValidateSchema(validSearchIndex())
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestValidateSchemaViolations(t *testing.T) {
+	model := validSearchIndex()
+	model.ProjectId = util.Pointer("not-a-project-id")
+	model.ClusterName = util.Pointer("-cluster")
+	model.Status = util.Pointer("DONE")
+	model.Synonyms = append(model.Synonyms, searchindex.ApiAtlasFTSSynonymMappingDefinitionView{
+		Name:   util.Pointer("missing"),
+		Source: &searchindex.SynonymSource{},
+	})
+
+	violations, err := validator.ValidateSchema(model)
+	require.NoError(t, err)
+	paths := make([]string, len(violations))
+	for i, v := range violations {
+		paths[i] = v.Path
+	}
+	assert.ElementsMatch(t, []string{
+		"ClusterName",
+		"ProjectId",
+		"ProjectId",
+		"Status",
+		"Synonyms[1].Analyzer",
+		"Synonyms[1].Source.Collection",
+	}, paths)
+	assert.Contains(t, violations, validator.Violation{Path: "Status", Message: "must be one of FAILED, IN_PROGRESS, MIGRATING, STEADY"})
+	assert.Contains(t, violations, validator.Violation{Path: "Synonyms[1].Source.Collection", Message: "is required"})
+}
+
+func TestValidateSchemaIgnoresOtherValues(t *testing.T) {
+	violations, err := validator.ValidateSchema(&searchindex.SynonymSource{})
+	require.NoError(t, err)
+	assert.Nil(t, violations)
+}
+
+func TestValidateModelReportsEveryViolation(t *testing.T) {
+	model := validSearchIndex()
+	model.ProjectId = util.Pointer("64B6C0C9A1B2C3D4E5F60718")
+	model.Name = nil
+
+	event := validator.ValidateModel([]string{"Name", "Database"}, model)
+	require.NotNil(t, event)
+	assert.Equal(t, handler.Failed, event.OperationStatus)
+	assert.Equal(t, cloudformation.HandlerErrorCodeInvalidRequest, string(event.HandlerErrorCode))
+	assert.True(t, strings.HasPrefix(event.Message, "The next fields are required Name Database. The next fields are invalid: "), event.Message)
+	assert.Contains(t, event.Message, "ProjectId must match the pattern ^([a-f0-9]{24})$")
+}
+
+func TestValidateValue(t *testing.T) {
+	schema := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"definitions": {
+			"size": {"type": "integer", "minimum": 1, "maximum": 10}
+		},
+		"type": "object",
+		"properties": {
+			"Size": {"$ref": "#/definitions/size"},
+			"Tags": {"type": "array", "maxItems": 1, "uniqueItems": true, "items": {"type": "string"}},
+			"Endpoint": {
+				"type": "object",
+				"oneOf": [{"required": ["Host"]}, {"required": ["Ip"]}]
+			}
+		}
+	}`), &schema))
+
+	testCases := map[string]struct {
+		value    string
+		messages []string
+	}{
+		"valid":          {value: `{"Size": 5, "Tags": ["a"], "Endpoint": {"Host": "h"}}`},
+		"out of range":   {value: `{"Size": 11}`, messages: []string{"Size must be less than or equal to 10"}},
+		"not integer":    {value: `{"Size": 1.5}`, messages: []string{"Size must be of type integer, got number"}},
+		"too many items": {value: `{"Tags": ["a", "a"]}`, messages: []string{"Tags must have at most 1 items", "Tags must not have duplicate items"}},
+		"wrong item":     {value: `{"Tags": [1]}`, messages: []string{"Tags[0] must be of type string, got integer"}},
+		"oneOf none":     {value: `{"Endpoint": {}}`, messages: []string{"Endpoint must match exactly one of the oneOf schemas, it matches 0"}},
+		"oneOf both":     {value: `{"Endpoint": {"Host": "h", "Ip": "i"}}`, messages: []string{"Endpoint must match exactly one of the oneOf schemas, it matches 2"}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var value any
+			require.NoError(t, json.Unmarshal([]byte(tc.value), &value))
+			violations := validator.ValidateValue(schema, value)
+			messages := make([]string, len(violations))
+			for i, v := range violations {
+				messages[i] = v.String()
+			}
+			assert.ElementsMatch(t, tc.messages, messages)
+		})
+	}
+}
+
+// TestEmbeddedSchemasUpToDate checks that the schemas were copied with `go generate ./util/validator` after any change.
+func TestEmbeddedSchemasUpToDate(t *testing.T) {
+	embedded, err := filepath.Glob(filepath.Join("schemas", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, embedded)
+	for _, path := range embedded {
+		dir := strings.TrimSuffix(filepath.Base(path), ".json")
+		originals, err := filepath.Glob(filepath.Join("..", "..", dir, "mongodb-atlas-*.json"))
+		require.NoError(t, err)
+		require.Len(t, originals, 1, dir)
+
+		original, err := os.ReadFile(originals[0])
+		require.NoError(t, err)
+		var compact bytes.Buffer
+		require.NoError(t, json.Compact(&compact, original))
+		copied, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, compact.String(), strings.TrimSuffix(string(copied), "\n"), "run go generate ./util/validator, %s is outdated", path)
+	}
+}
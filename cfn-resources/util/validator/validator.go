@@ -24,6 +24,8 @@ import (
 	progressevents "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 )
 
+// ValidateModel checks that the fields are set and that the model is valid for the schema of its resource,
+// it returns a single InvalidRequest event with every violation.
 func ValidateModel(fields []string, model interface{}) *handler.ProgressEvent {
 	requiredFields := ""
 
@@ -32,11 +34,30 @@ func ValidateModel(fields []string, model interface{}) *handler.ProgressEvent {
 			requiredFields = fmt.Sprintf("%s %s", requiredFields, field)
 		}
 	}
-	if requiredFields == "" {
+
+	violations, err := ValidateSchema(model)
+	if err != nil {
+		progressEvent := progressevents.GetFailedEventByCode(fmt.Sprintf("Unable to validate the model: %s", err.Error()),
+			cloudformation.HandlerErrorCodeInternalFailure)
+		return &progressEvent
+	}
+
+	var messages []string
+	if requiredFields != "" {
+		messages = append(messages, fmt.Sprintf("The next fields are required%s", requiredFields))
+	}
+	if len(violations) > 0 {
+		invalid := make([]string, len(violations))
+		for i, v := range violations {
+			invalid[i] = v.String()
+		}
+		messages = append(messages, fmt.Sprintf("The next fields are invalid: %s", strings.Join(invalid, "; ")))
+	}
+	if len(messages) == 0 {
 		return nil
 	}
 
-	progressEvent := progressevents.GetFailedEventByCode(fmt.Sprintf("The next fields are required%s", requiredFields),
+	progressEvent := progressevents.GetFailedEventByCode(strings.Join(messages, ". "),
 		cloudformation.HandlerErrorCodeInvalidRequest)
 
 	return &progressEvent
@@ -49,7 +70,6 @@ func fieldIsEmpty(model interface{}, field string) bool {
 		r := reflect.ValueOf(model)
 
 		for _, f := range fields {
-			fmt.Println(f)
 			baseProperty := reflect.Indirect(r).FieldByName(f)
 
 			if baseProperty.IsNil() {